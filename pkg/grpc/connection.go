@@ -18,6 +18,7 @@ func ConnectWithRetry(address string, cfg Config, log logger.Logger) (*grpc.Clie
 
 		conn, err = grpc.NewClient(address,
 			grpc.WithUnaryInterceptor(CorrelationIDClientInterceptor(log)),
+			grpc.WithStreamInterceptor(CorrelationIDStreamClientInterceptor(log)),
 			grpc.WithTransportCredentials(insecure.NewCredentials()),
 		)
 		if err == nil {
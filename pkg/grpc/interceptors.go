@@ -9,6 +9,17 @@ import (
 	"google.golang.org/grpc/metadata"
 )
 
+type (
+	correlatedServerStream struct {
+		grpc.ServerStream
+		ctx context.Context
+	}
+)
+
+func (s *correlatedServerStream) Context() context.Context {
+	return s.ctx
+}
+
 func CorrelationIDServerInterceptor(log logger.Logger) grpc.UnaryServerInterceptor {
 	return func(
 		ctx context.Context,
@@ -16,21 +27,19 @@ func CorrelationIDServerInterceptor(log logger.Logger) grpc.UnaryServerIntercept
 		info *grpc.UnaryServerInfo,
 		handler grpc.UnaryHandler,
 	) (resp interface{}, err error) {
-		md, ok := metadata.FromIncomingContext(ctx)
-		if ok {
-			if ids := md.Get(ctxutil.CorrelationIDKey.String()); len(ids) > 0 {
-				correlationID := ids[0]
-				//nolint:staticcheck
-				ctx = context.WithValue(ctx, ctxutil.CorrelationIDKey.String(), correlationID)
-
-			} else {
-				log.Warnf("correlation-id not found in context")
-			}
-		} else {
-			log.Errorf("Missing gRPC metadata for method %s - potential client misconfiguration", info.FullMethod)
-
-		}
-		return handler(ctx, req)
+		return handler(incomingCorrelationID(ctx, info.FullMethod, log), req)
+	}
+}
+
+func CorrelationIDStreamServerInterceptor(log logger.Logger) grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		ctx := incomingCorrelationID(ss.Context(), info.FullMethod, log)
+		return handler(srv, &correlatedServerStream{ServerStream: ss, ctx: ctx})
 	}
 }
 
@@ -43,13 +52,47 @@ func CorrelationIDClientInterceptor(log logger.Logger) grpc.UnaryClientIntercept
 		invoker grpc.UnaryInvoker,
 		opts ...grpc.CallOption,
 	) error {
-		correlationID := ctxutil.GetCorrelationID(ctx)
-		if correlationID != "" {
-			md := metadata.Pairs(ctxutil.CorrelationIDKey.String(), correlationID)
-			ctx = metadata.NewOutgoingContext(ctx, md)
-		} else {
-			log.Warnf("correlation-id not found in context")
-		}
-		return invoker(ctx, method, req, reply, cc, opts...)
+		return invoker(outgoingCorrelationID(ctx, log), method, req, reply, cc, opts...)
+	}
+}
+
+func CorrelationIDStreamClientInterceptor(log logger.Logger) grpc.StreamClientInterceptor {
+	return func(
+		ctx context.Context,
+		desc *grpc.StreamDesc,
+		cc *grpc.ClientConn,
+		method string,
+		streamer grpc.Streamer,
+		opts ...grpc.CallOption,
+	) (grpc.ClientStream, error) {
+		return streamer(outgoingCorrelationID(ctx, log), desc, cc, method, opts...)
+	}
+}
+
+func incomingCorrelationID(ctx context.Context, method string, log logger.Logger) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		log.Errorf("Missing gRPC metadata for method %s - potential client misconfiguration", method)
+		return ctx
 	}
+
+	ids := md.Get(ctxutil.CorrelationIDKey.String())
+	if len(ids) == 0 {
+		log.Warnf("correlation-id not found in context")
+		return ctx
+	}
+
+	//nolint:staticcheck
+	return context.WithValue(ctx, ctxutil.CorrelationIDKey.String(), ids[0])
+}
+
+func outgoingCorrelationID(ctx context.Context, log logger.Logger) context.Context {
+	correlationID := ctxutil.GetCorrelationID(ctx)
+	if correlationID == "" {
+		log.Warnf("correlation-id not found in context")
+		return ctx
+	}
+
+	md := metadata.Pairs(ctxutil.CorrelationIDKey.String(), correlationID)
+	return metadata.NewOutgoingContext(ctx, md)
 }
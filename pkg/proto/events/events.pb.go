@@ -26,6 +26,16 @@ type Weather struct {
 	Temperature   float64                `protobuf:"fixed64,1,opt,name=temperature,proto3" json:"temperature,omitempty"`
 	Humidity      int32                  `protobuf:"varint,2,opt,name=humidity,proto3" json:"humidity,omitempty"`
 	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	WindSpeed     float64                `protobuf:"fixed64,4,opt,name=wind_speed,json=windSpeed,proto3" json:"wind_speed,omitempty"`
+	WindDirection int32                  `protobuf:"varint,5,opt,name=wind_direction,json=windDirection,proto3" json:"wind_direction,omitempty"`
+	Pressure      float64                `protobuf:"fixed64,6,opt,name=pressure,proto3" json:"pressure,omitempty"`
+	CloudCover    int32                  `protobuf:"varint,7,opt,name=cloud_cover,json=cloudCover,proto3" json:"cloud_cover,omitempty"`
+	Visibility    float64                `protobuf:"fixed64,8,opt,name=visibility,proto3" json:"visibility,omitempty"`
+	FeelsLike     float64                `protobuf:"fixed64,9,opt,name=feels_like,json=feelsLike,proto3" json:"feels_like,omitempty"`
+	HeatIndex     float64                `protobuf:"fixed64,10,opt,name=heat_index,json=heatIndex,proto3" json:"heat_index,omitempty"`
+	WindChill     float64                `protobuf:"fixed64,11,opt,name=wind_chill,json=windChill,proto3" json:"wind_chill,omitempty"`
+	DewPoint      float64                `protobuf:"fixed64,12,opt,name=dew_point,json=dewPoint,proto3" json:"dew_point,omitempty"`
+	Stale         bool                   `protobuf:"varint,13,opt,name=stale,proto3" json:"stale,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -81,6 +91,160 @@ func (x *Weather) GetDescription() string {
 	return ""
 }
 
+func (x *Weather) GetWindSpeed() float64 {
+	if x != nil {
+		return x.WindSpeed
+	}
+	return 0
+}
+
+func (x *Weather) GetWindDirection() int32 {
+	if x != nil {
+		return x.WindDirection
+	}
+	return 0
+}
+
+func (x *Weather) GetPressure() float64 {
+	if x != nil {
+		return x.Pressure
+	}
+	return 0
+}
+
+func (x *Weather) GetCloudCover() int32 {
+	if x != nil {
+		return x.CloudCover
+	}
+	return 0
+}
+
+func (x *Weather) GetVisibility() float64 {
+	if x != nil {
+		return x.Visibility
+	}
+	return 0
+}
+
+func (x *Weather) GetFeelsLike() float64 {
+	if x != nil {
+		return x.FeelsLike
+	}
+	return 0
+}
+
+func (x *Weather) GetHeatIndex() float64 {
+	if x != nil {
+		return x.HeatIndex
+	}
+	return 0
+}
+
+func (x *Weather) GetWindChill() float64 {
+	if x != nil {
+		return x.WindChill
+	}
+	return 0
+}
+
+func (x *Weather) GetDewPoint() float64 {
+	if x != nil {
+		return x.DewPoint
+	}
+	return 0
+}
+
+func (x *Weather) GetStale() bool {
+	if x != nil {
+		return x.Stale
+	}
+	return false
+}
+
+type AirQuality struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Aqi           int32                  `protobuf:"varint,1,opt,name=aqi,proto3" json:"aqi,omitempty"`
+	Category      string                 `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`
+	Pm2_5         float64                `protobuf:"fixed64,3,opt,name=pm2_5,json=pm25,proto3" json:"pm2_5,omitempty"`
+	Pm10          float64                `protobuf:"fixed64,4,opt,name=pm10,proto3" json:"pm10,omitempty"`
+	O3            float64                `protobuf:"fixed64,5,opt,name=o3,proto3" json:"o3,omitempty"`
+	No2           float64                `protobuf:"fixed64,6,opt,name=no2,proto3" json:"no2,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AirQuality) Reset() {
+	*x = AirQuality{}
+	mi := &file_events_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AirQuality) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AirQuality) ProtoMessage() {}
+
+func (x *AirQuality) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AirQuality.ProtoReflect.Descriptor instead.
+func (*AirQuality) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AirQuality) GetAqi() int32 {
+	if x != nil {
+		return x.Aqi
+	}
+	return 0
+}
+
+func (x *AirQuality) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *AirQuality) GetPm2_5() float64 {
+	if x != nil {
+		return x.Pm2_5
+	}
+	return 0
+}
+
+func (x *AirQuality) GetPm10() float64 {
+	if x != nil {
+		return x.Pm10
+	}
+	return 0
+}
+
+func (x *AirQuality) GetO3() float64 {
+	if x != nil {
+		return x.O3
+	}
+	return 0
+}
+
+func (x *AirQuality) GetNo2() float64 {
+	if x != nil {
+		return x.No2
+	}
+	return 0
+}
+
 type SubscriptionEvent struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
@@ -92,7 +256,7 @@ type SubscriptionEvent struct {
 
 func (x *SubscriptionEvent) Reset() {
 	*x = SubscriptionEvent{}
-	mi := &file_events_proto_msgTypes[1]
+	mi := &file_events_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -104,7 +268,7 @@ func (x *SubscriptionEvent) String() string {
 func (*SubscriptionEvent) ProtoMessage() {}
 
 func (x *SubscriptionEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_events_proto_msgTypes[1]
+	mi := &file_events_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -117,7 +281,7 @@ func (x *SubscriptionEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubscriptionEvent.ProtoReflect.Descriptor instead.
 func (*SubscriptionEvent) Descriptor() ([]byte, []int) {
-	return file_events_proto_rawDescGZIP(), []int{1}
+	return file_events_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *SubscriptionEvent) GetEmail() string {
@@ -152,7 +316,7 @@ type ConfirmedEvent struct {
 
 func (x *ConfirmedEvent) Reset() {
 	*x = ConfirmedEvent{}
-	mi := &file_events_proto_msgTypes[2]
+	mi := &file_events_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -164,7 +328,7 @@ func (x *ConfirmedEvent) String() string {
 func (*ConfirmedEvent) ProtoMessage() {}
 
 func (x *ConfirmedEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_events_proto_msgTypes[2]
+	mi := &file_events_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -177,7 +341,7 @@ func (x *ConfirmedEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConfirmedEvent.ProtoReflect.Descriptor instead.
 func (*ConfirmedEvent) Descriptor() ([]byte, []int) {
-	return file_events_proto_rawDescGZIP(), []int{2}
+	return file_events_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *ConfirmedEvent) GetEmail() string {
@@ -212,7 +376,7 @@ type UnsubscribedEvent struct {
 
 func (x *UnsubscribedEvent) Reset() {
 	*x = UnsubscribedEvent{}
-	mi := &file_events_proto_msgTypes[3]
+	mi := &file_events_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -224,7 +388,7 @@ func (x *UnsubscribedEvent) String() string {
 func (*UnsubscribedEvent) ProtoMessage() {}
 
 func (x *UnsubscribedEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_events_proto_msgTypes[3]
+	mi := &file_events_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -237,7 +401,7 @@ func (x *UnsubscribedEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnsubscribedEvent.ProtoReflect.Descriptor instead.
 func (*UnsubscribedEvent) Descriptor() ([]byte, []int) {
-	return file_events_proto_rawDescGZIP(), []int{3}
+	return file_events_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *UnsubscribedEvent) GetEmail() string {
@@ -266,13 +430,14 @@ type WeatherSuccessEvent struct {
 	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
 	City          string                 `protobuf:"bytes,2,opt,name=city,proto3" json:"city,omitempty"`
 	Weather       *Weather               `protobuf:"bytes,3,opt,name=weather,proto3" json:"weather,omitempty"`
+	AirQuality    *AirQuality            `protobuf:"bytes,4,opt,name=air_quality,json=airQuality,proto3" json:"air_quality,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *WeatherSuccessEvent) Reset() {
 	*x = WeatherSuccessEvent{}
-	mi := &file_events_proto_msgTypes[4]
+	mi := &file_events_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -284,7 +449,7 @@ func (x *WeatherSuccessEvent) String() string {
 func (*WeatherSuccessEvent) ProtoMessage() {}
 
 func (x *WeatherSuccessEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_events_proto_msgTypes[4]
+	mi := &file_events_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -297,7 +462,7 @@ func (x *WeatherSuccessEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WeatherSuccessEvent.ProtoReflect.Descriptor instead.
 func (*WeatherSuccessEvent) Descriptor() ([]byte, []int) {
-	return file_events_proto_rawDescGZIP(), []int{4}
+	return file_events_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *WeatherSuccessEvent) GetEmail() string {
@@ -321,6 +486,13 @@ func (x *WeatherSuccessEvent) GetWeather() *Weather {
 	return nil
 }
 
+func (x *WeatherSuccessEvent) GetAirQuality() *AirQuality {
+	if x != nil {
+		return x.AirQuality
+	}
+	return nil
+}
+
 type WeatherErrorEvent struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
@@ -331,7 +503,7 @@ type WeatherErrorEvent struct {
 
 func (x *WeatherErrorEvent) Reset() {
 	*x = WeatherErrorEvent{}
-	mi := &file_events_proto_msgTypes[5]
+	mi := &file_events_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -343,7 +515,7 @@ func (x *WeatherErrorEvent) String() string {
 func (*WeatherErrorEvent) ProtoMessage() {}
 
 func (x *WeatherErrorEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_events_proto_msgTypes[5]
+	mi := &file_events_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -356,7 +528,7 @@ func (x *WeatherErrorEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WeatherErrorEvent.ProtoReflect.Descriptor instead.
 func (*WeatherErrorEvent) Descriptor() ([]byte, []int) {
-	return file_events_proto_rawDescGZIP(), []int{5}
+	return file_events_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *WeatherErrorEvent) GetEmail() string {
@@ -373,15 +545,193 @@ func (x *WeatherErrorEvent) GetCity() string {
 	return ""
 }
 
+type Alert struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Event         string                 `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
+	Severity      string                 `protobuf:"bytes,3,opt,name=severity,proto3" json:"severity,omitempty"`
+	Headline      string                 `protobuf:"bytes,4,opt,name=headline,proto3" json:"headline,omitempty"`
+	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	StartsAt      int64                  `protobuf:"varint,6,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	EndsAt        int64                  `protobuf:"varint,7,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Alert) Reset() {
+	*x = Alert{}
+	mi := &file_events_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Alert) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Alert) ProtoMessage() {}
+
+func (x *Alert) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Alert.ProtoReflect.Descriptor instead.
+func (*Alert) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Alert) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Alert) GetEvent() string {
+	if x != nil {
+		return x.Event
+	}
+	return ""
+}
+
+func (x *Alert) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
+func (x *Alert) GetHeadline() string {
+	if x != nil {
+		return x.Headline
+	}
+	return ""
+}
+
+func (x *Alert) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Alert) GetStartsAt() int64 {
+	if x != nil {
+		return x.StartsAt
+	}
+	return 0
+}
+
+func (x *Alert) GetEndsAt() int64 {
+	if x != nil {
+		return x.EndsAt
+	}
+	return 0
+}
+
+type WeatherAlertEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	City          string                 `protobuf:"bytes,2,opt,name=city,proto3" json:"city,omitempty"`
+	Alert         *Alert                 `protobuf:"bytes,3,opt,name=alert,proto3" json:"alert,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WeatherAlertEvent) Reset() {
+	*x = WeatherAlertEvent{}
+	mi := &file_events_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WeatherAlertEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WeatherAlertEvent) ProtoMessage() {}
+
+func (x *WeatherAlertEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_events_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WeatherAlertEvent.ProtoReflect.Descriptor instead.
+func (*WeatherAlertEvent) Descriptor() ([]byte, []int) {
+	return file_events_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *WeatherAlertEvent) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *WeatherAlertEvent) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *WeatherAlertEvent) GetAlert() *Alert {
+	if x != nil {
+		return x.Alert
+	}
+	return nil
+}
+
 var File_events_proto protoreflect.FileDescriptor
 
 const file_events_proto_rawDesc = "" +
 	"\n" +
-	"\fevents.proto\x12\x06events\"i\n" +
+	"\fevents.proto\x12\x06events\"\x9c\x03\n" +
 	"\aWeather\x12 \n" +
 	"\vtemperature\x18\x01 \x01(\x01R\vtemperature\x12\x1a\n" +
 	"\bhumidity\x18\x02 \x01(\x05R\bhumidity\x12 \n" +
-	"\vdescription\x18\x03 \x01(\tR\vdescription\"]\n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1d\n" +
+	"\n" +
+	"wind_speed\x18\x04 \x01(\x01R\twindSpeed\x12%\n" +
+	"\x0ewind_direction\x18\x05 \x01(\x05R\rwindDirection\x12\x1a\n" +
+	"\bpressure\x18\x06 \x01(\x01R\bpressure\x12\x1f\n" +
+	"\vcloud_cover\x18\a \x01(\x05R\n" +
+	"cloudCover\x12\x1e\n" +
+	"\n" +
+	"visibility\x18\b \x01(\x01R\n" +
+	"visibility\x12\x1d\n" +
+	"\n" +
+	"feels_like\x18\t \x01(\x01R\tfeelsLike\x12\x1d\n" +
+	"\n" +
+	"heat_index\x18\n" +
+	" \x01(\x01R\theatIndex\x12\x1d\n" +
+	"\n" +
+	"wind_chill\x18\v \x01(\x01R\twindChill\x12\x1b\n" +
+	"\tdew_point\x18\f \x01(\x01R\bdewPoint\x12\x14\n" +
+	"\x05stale\x18\r \x01(\bR\x05stale\"\x85\x01\n" +
+	"\n" +
+	"AirQuality\x12\x10\n" +
+	"\x03aqi\x18\x01 \x01(\x05R\x03aqi\x12\x1a\n" +
+	"\bcategory\x18\x02 \x01(\tR\bcategory\x12\x13\n" +
+	"\x05pm2_5\x18\x03 \x01(\x01R\x04pm25\x12\x12\n" +
+	"\x04pm10\x18\x04 \x01(\x01R\x04pm10\x12\x0e\n" +
+	"\x02o3\x18\x05 \x01(\x01R\x02o3\x12\x10\n" +
+	"\x03no2\x18\x06 \x01(\x01R\x03no2\"]\n" +
 	"\x11SubscriptionEvent\x12\x14\n" +
 	"\x05email\x18\x01 \x01(\tR\x05email\x12\x14\n" +
 	"\x05token\x18\x02 \x01(\tR\x05token\x12\x1c\n" +
@@ -393,14 +743,28 @@ const file_events_proto_rawDesc = "" +
 	"\x11UnsubscribedEvent\x12\x14\n" +
 	"\x05email\x18\x01 \x01(\tR\x05email\x12\x12\n" +
 	"\x04city\x18\x02 \x01(\tR\x04city\x12\x1c\n" +
-	"\tfrequency\x18\x03 \x01(\tR\tfrequency\"j\n" +
+	"\tfrequency\x18\x03 \x01(\tR\tfrequency\"\x9f\x01\n" +
 	"\x13WeatherSuccessEvent\x12\x14\n" +
 	"\x05email\x18\x01 \x01(\tR\x05email\x12\x12\n" +
 	"\x04city\x18\x02 \x01(\tR\x04city\x12)\n" +
-	"\aweather\x18\x03 \x01(\v2\x0f.events.WeatherR\aweather\"=\n" +
+	"\aweather\x18\x03 \x01(\v2\x0f.events.WeatherR\aweather\x123\n" +
+	"\vair_quality\x18\x04 \x01(\v2\x12.events.AirQualityR\n" +
+	"airQuality\"=\n" +
 	"\x11WeatherErrorEvent\x12\x14\n" +
 	"\x05email\x18\x01 \x01(\tR\x05email\x12\x12\n" +
-	"\x04city\x18\x02 \x01(\tR\x04cityB\vZ\t./;eventsb\x06proto3"
+	"\x04city\x18\x02 \x01(\tR\x04city\"\xbd\x01\n" +
+	"\x05Alert\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05event\x18\x02 \x01(\tR\x05event\x12\x1a\n" +
+	"\bseverity\x18\x03 \x01(\tR\bseverity\x12\x1a\n" +
+	"\bheadline\x18\x04 \x01(\tR\bheadline\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12\x1b\n" +
+	"\tstarts_at\x18\x06 \x01(\x03R\bstartsAt\x12\x17\n" +
+	"\aends_at\x18\a \x01(\x03R\x06endsAt\"b\n" +
+	"\x11WeatherAlertEvent\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\x12\x12\n" +
+	"\x04city\x18\x02 \x01(\tR\x04city\x12#\n" +
+	"\x05alert\x18\x03 \x01(\v2\r.events.AlertR\x05alertB\vZ\t./;eventsb\x06proto3"
 
 var (
 	file_events_proto_rawDescOnce sync.Once
@@ -414,22 +778,27 @@ func file_events_proto_rawDescGZIP() []byte {
 	return file_events_proto_rawDescData
 }
 
-var file_events_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_events_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_events_proto_goTypes = []any{
 	(*Weather)(nil),             // 0: events.Weather
-	(*SubscriptionEvent)(nil),   // 1: events.SubscriptionEvent
-	(*ConfirmedEvent)(nil),      // 2: events.ConfirmedEvent
-	(*UnsubscribedEvent)(nil),   // 3: events.UnsubscribedEvent
-	(*WeatherSuccessEvent)(nil), // 4: events.WeatherSuccessEvent
-	(*WeatherErrorEvent)(nil),   // 5: events.WeatherErrorEvent
+	(*AirQuality)(nil),          // 1: events.AirQuality
+	(*SubscriptionEvent)(nil),   // 2: events.SubscriptionEvent
+	(*ConfirmedEvent)(nil),      // 3: events.ConfirmedEvent
+	(*UnsubscribedEvent)(nil),   // 4: events.UnsubscribedEvent
+	(*WeatherSuccessEvent)(nil), // 5: events.WeatherSuccessEvent
+	(*WeatherErrorEvent)(nil),   // 6: events.WeatherErrorEvent
+	(*Alert)(nil),               // 7: events.Alert
+	(*WeatherAlertEvent)(nil),   // 8: events.WeatherAlertEvent
 }
 var file_events_proto_depIdxs = []int32{
 	0, // 0: events.WeatherSuccessEvent.weather:type_name -> events.Weather
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	1, // 1: events.WeatherSuccessEvent.air_quality:type_name -> events.AirQuality
+	7, // 2: events.WeatherAlertEvent.alert:type_name -> events.Alert
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_events_proto_init() }
@@ -443,7 +812,7 @@ func file_events_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_events_proto_rawDesc), len(file_events_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -28,6 +28,7 @@ const (
 	Frequency_UNSPECIFIED Frequency = 0
 	Frequency_DAILY       Frequency = 1
 	Frequency_HOURLY      Frequency = 2
+	Frequency_ALERTS      Frequency = 3
 )
 
 // Enum value maps for Frequency.
@@ -36,11 +37,13 @@ var (
 		0: "UNSPECIFIED",
 		1: "DAILY",
 		2: "HOURLY",
+		3: "ALERTS",
 	}
 	Frequency_value = map[string]int32{
 		"UNSPECIFIED": 0,
 		"DAILY":       1,
 		"HOURLY":      2,
+		"ALERTS":      3,
 	}
 )
 
@@ -72,12 +75,13 @@ func (Frequency) EnumDescriptor() ([]byte, []int) {
 }
 
 type SubscribeRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
-	City          string                 `protobuf:"bytes,2,opt,name=city,proto3" json:"city,omitempty"`
-	Frequency     Frequency              `protobuf:"varint,3,opt,name=frequency,proto3,enum=subscription.Frequency" json:"frequency,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Email             string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	City              string                 `protobuf:"bytes,2,opt,name=city,proto3" json:"city,omitempty"`
+	Frequency         Frequency              `protobuf:"varint,3,opt,name=frequency,proto3,enum=subscription.Frequency" json:"frequency,omitempty"`
+	IncludeAirQuality bool                   `protobuf:"varint,4,opt,name=include_air_quality,json=includeAirQuality,proto3" json:"include_air_quality,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *SubscribeRequest) Reset() {
@@ -131,6 +135,13 @@ func (x *SubscribeRequest) GetFrequency() Frequency {
 	return Frequency_UNSPECIFIED
 }
 
+func (x *SubscribeRequest) GetIncludeAirQuality() bool {
+	if x != nil {
+		return x.IncludeAirQuality
+	}
+	return false
+}
+
 type GetSubscriptionsByFrequencyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Frequency     Frequency              `protobuf:"varint,1,opt,name=frequency,proto3,enum=subscription.Frequency" json:"frequency,omitempty"`
@@ -280,11 +291,12 @@ func (x *UnsubscribeRequest) GetToken() string {
 }
 
 type Subscription struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
-	City          string                 `protobuf:"bytes,2,opt,name=city,proto3" json:"city,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Email             string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	City              string                 `protobuf:"bytes,2,opt,name=city,proto3" json:"city,omitempty"`
+	IncludeAirQuality bool                   `protobuf:"varint,3,opt,name=include_air_quality,json=includeAirQuality,proto3" json:"include_air_quality,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *Subscription) Reset() {
@@ -331,6 +343,13 @@ func (x *Subscription) GetCity() string {
 	return ""
 }
 
+func (x *Subscription) GetIncludeAirQuality() bool {
+	if x != nil {
+		return x.IncludeAirQuality
+	}
+	return false
+}
+
 type GetSubscriptionsByFrequencyResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Subscriptions []*Subscription        `protobuf:"bytes,1,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
@@ -387,11 +406,12 @@ var File_subscription_proto protoreflect.FileDescriptor
 
 const file_subscription_proto_rawDesc = "" +
 	"\n" +
-	"\x12subscription.proto\x12\fsubscription\x1a\x1bgoogle/protobuf/empty.proto\"s\n" +
+	"\x12subscription.proto\x12\fsubscription\x1a\x1bgoogle/protobuf/empty.proto\"\xa3\x01\n" +
 	"\x10SubscribeRequest\x12\x14\n" +
 	"\x05email\x18\x01 \x01(\tR\x05email\x12\x12\n" +
 	"\x04city\x18\x02 \x01(\tR\x04city\x125\n" +
-	"\tfrequency\x18\x03 \x01(\x0e2\x17.subscription.FrequencyR\tfrequency\"\x97\x01\n" +
+	"\tfrequency\x18\x03 \x01(\x0e2\x17.subscription.FrequencyR\tfrequency\x12.\n" +
+	"\x13include_air_quality\x18\x04 \x01(\bR\x11includeAirQuality\"\x97\x01\n" +
 	"\"GetSubscriptionsByFrequencyRequest\x125\n" +
 	"\tfrequency\x18\x01 \x01(\x0e2\x17.subscription.FrequencyR\tfrequency\x12\x1b\n" +
 	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
@@ -400,18 +420,21 @@ const file_subscription_proto_rawDesc = "" +
 	"\x0eConfirmRequest\x12\x14\n" +
 	"\x05token\x18\x01 \x01(\tR\x05token\"*\n" +
 	"\x12UnsubscribeRequest\x12\x14\n" +
-	"\x05token\x18\x01 \x01(\tR\x05token\"8\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"h\n" +
 	"\fSubscription\x12\x14\n" +
 	"\x05email\x18\x01 \x01(\tR\x05email\x12\x12\n" +
-	"\x04city\x18\x02 \x01(\tR\x04city\"\x8f\x01\n" +
+	"\x04city\x18\x02 \x01(\tR\x04city\x12.\n" +
+	"\x13include_air_quality\x18\x03 \x01(\bR\x11includeAirQuality\"\x8f\x01\n" +
 	"#GetSubscriptionsByFrequencyResponse\x12@\n" +
 	"\rsubscriptions\x18\x01 \x03(\v2\x1a.subscription.SubscriptionR\rsubscriptions\x12&\n" +
-	"\x0fnext_page_index\x18\x02 \x01(\x05R\rnextPageIndex*3\n" +
+	"\x0fnext_page_index\x18\x02 \x01(\x05R\rnextPageIndex*?\n" +
 	"\tFrequency\x12\x0f\n" +
 	"\vUNSPECIFIED\x10\x00\x12\t\n" +
 	"\x05DAILY\x10\x01\x12\n" +
 	"\n" +
-	"\x06HOURLY\x10\x022\xe9\x02\n" +
+	"\x06HOURLY\x10\x02\x12\n" +
+	"\n" +
+	"\x06ALERTS\x10\x032\xe9\x02\n" +
 	"\x13SubscriptionService\x12C\n" +
 	"\tSubscribe\x12\x1e.subscription.SubscribeRequest\x1a\x16.google.protobuf.Empty\x12?\n" +
 	"\aConfirm\x12\x1c.subscription.ConfirmRequest\x1a\x16.google.protobuf.Empty\x12G\n" +
@@ -22,8 +22,14 @@ const (
 )
 
 type GetWeatherRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	City          string                 `protobuf:"bytes,1,opt,name=city,proto3" json:"city,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Location:
+	//
+	//	*GetWeatherRequest_City
+	//	*GetWeatherRequest_Coordinates
+	Location      isGetWeatherRequest_Location `protobuf_oneof:"location"`
+	Units         string                       `protobuf:"bytes,2,opt,name=units,proto3" json:"units,omitempty"`
+	Lang          string                       `protobuf:"bytes,3,opt,name=lang,proto3" json:"lang,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -58,18 +64,77 @@ func (*GetWeatherRequest) Descriptor() ([]byte, []int) {
 	return file_weather_proto_rawDescGZIP(), []int{0}
 }
 
+func (x *GetWeatherRequest) GetLocation() isGetWeatherRequest_Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
 func (x *GetWeatherRequest) GetCity() string {
 	if x != nil {
-		return x.City
+		if x, ok := x.Location.(*GetWeatherRequest_City); ok {
+			return x.City
+		}
+	}
+	return ""
+}
+
+func (x *GetWeatherRequest) GetCoordinates() *Coordinates {
+	if x != nil {
+		if x, ok := x.Location.(*GetWeatherRequest_Coordinates); ok {
+			return x.Coordinates
+		}
+	}
+	return nil
+}
+
+func (x *GetWeatherRequest) GetUnits() string {
+	if x != nil {
+		return x.Units
+	}
+	return ""
+}
+
+func (x *GetWeatherRequest) GetLang() string {
+	if x != nil {
+		return x.Lang
 	}
 	return ""
 }
 
+type isGetWeatherRequest_Location interface {
+	isGetWeatherRequest_Location()
+}
+
+type GetWeatherRequest_City struct {
+	City string `protobuf:"bytes,1,opt,name=city,proto3,oneof"`
+}
+
+type GetWeatherRequest_Coordinates struct {
+	Coordinates *Coordinates `protobuf:"bytes,4,opt,name=coordinates,proto3,oneof"`
+}
+
+func (*GetWeatherRequest_City) isGetWeatherRequest_Location() {}
+
+func (*GetWeatherRequest_Coordinates) isGetWeatherRequest_Location() {}
+
 type GetWeatherResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Temperature   float64                `protobuf:"fixed64,1,opt,name=temperature,proto3" json:"temperature,omitempty"`
 	Humidity      int32                  `protobuf:"varint,2,opt,name=humidity,proto3" json:"humidity,omitempty"`
 	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	WindSpeed     float64                `protobuf:"fixed64,4,opt,name=wind_speed,json=windSpeed,proto3" json:"wind_speed,omitempty"`
+	WindDirection int32                  `protobuf:"varint,5,opt,name=wind_direction,json=windDirection,proto3" json:"wind_direction,omitempty"`
+	Pressure      float64                `protobuf:"fixed64,6,opt,name=pressure,proto3" json:"pressure,omitempty"`
+	CloudCover    int32                  `protobuf:"varint,7,opt,name=cloud_cover,json=cloudCover,proto3" json:"cloud_cover,omitempty"`
+	Visibility    float64                `protobuf:"fixed64,8,opt,name=visibility,proto3" json:"visibility,omitempty"`
+	FeelsLike     float64                `protobuf:"fixed64,9,opt,name=feels_like,json=feelsLike,proto3" json:"feels_like,omitempty"`
+	HeatIndex     float64                `protobuf:"fixed64,10,opt,name=heat_index,json=heatIndex,proto3" json:"heat_index,omitempty"`
+	WindChill     float64                `protobuf:"fixed64,11,opt,name=wind_chill,json=windChill,proto3" json:"wind_chill,omitempty"`
+	DewPoint      float64                `protobuf:"fixed64,12,opt,name=dew_point,json=dewPoint,proto3" json:"dew_point,omitempty"`
+	Stale         bool                   `protobuf:"varint,13,opt,name=stale,proto3" json:"stale,omitempty"`
+	Disputed      bool                   `protobuf:"varint,14,opt,name=disputed,proto3" json:"disputed,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -125,53 +190,2233 @@ func (x *GetWeatherResponse) GetDescription() string {
 	return ""
 }
 
-var File_weather_proto protoreflect.FileDescriptor
+func (x *GetWeatherResponse) GetWindSpeed() float64 {
+	if x != nil {
+		return x.WindSpeed
+	}
+	return 0
+}
 
-const file_weather_proto_rawDesc = "" +
-	"\n" +
-	"\rweather.proto\x12\aweather\"'\n" +
-	"\x11GetWeatherRequest\x12\x12\n" +
-	"\x04city\x18\x01 \x01(\tR\x04city\"t\n" +
-	"\x12GetWeatherResponse\x12 \n" +
-	"\vtemperature\x18\x01 \x01(\x01R\vtemperature\x12\x1a\n" +
-	"\bhumidity\x18\x02 \x01(\x05R\bhumidity\x12 \n" +
-	"\vdescription\x18\x03 \x01(\tR\vdescription2W\n" +
-	"\x0eWeatherService\x12E\n" +
-	"\n" +
-	"GetWeather\x12\x1a.weather.GetWeatherRequest\x1a\x1b.weather.GetWeatherResponseB\fZ\n" +
-	"./;weatherb\x06proto3"
+func (x *GetWeatherResponse) GetWindDirection() int32 {
+	if x != nil {
+		return x.WindDirection
+	}
+	return 0
+}
 
-var (
-	file_weather_proto_rawDescOnce sync.Once
-	file_weather_proto_rawDescData []byte
-)
+func (x *GetWeatherResponse) GetPressure() float64 {
+	if x != nil {
+		return x.Pressure
+	}
+	return 0
+}
 
-func file_weather_proto_rawDescGZIP() []byte {
-	file_weather_proto_rawDescOnce.Do(func() {
-		file_weather_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_weather_proto_rawDesc), len(file_weather_proto_rawDesc)))
-	})
-	return file_weather_proto_rawDescData
+func (x *GetWeatherResponse) GetCloudCover() int32 {
+	if x != nil {
+		return x.CloudCover
+	}
+	return 0
 }
 
-var file_weather_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
-var file_weather_proto_goTypes = []any{
-	(*GetWeatherRequest)(nil),  // 0: weather.GetWeatherRequest
-	(*GetWeatherResponse)(nil), // 1: weather.GetWeatherResponse
+func (x *GetWeatherResponse) GetVisibility() float64 {
+	if x != nil {
+		return x.Visibility
+	}
+	return 0
 }
-var file_weather_proto_depIdxs = []int32{
-	0, // 0: weather.WeatherService.GetWeather:input_type -> weather.GetWeatherRequest
-	1, // 1: weather.WeatherService.GetWeather:output_type -> weather.GetWeatherResponse
-	1, // [1:2] is the sub-list for method output_type
-	0, // [0:1] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+
+func (x *GetWeatherResponse) GetFeelsLike() float64 {
+	if x != nil {
+		return x.FeelsLike
+	}
+	return 0
 }
 
-func init() { file_weather_proto_init() }
-func file_weather_proto_init() {
-	if File_weather_proto != nil {
-		return
+func (x *GetWeatherResponse) GetHeatIndex() float64 {
+	if x != nil {
+		return x.HeatIndex
+	}
+	return 0
+}
+
+func (x *GetWeatherResponse) GetWindChill() float64 {
+	if x != nil {
+		return x.WindChill
+	}
+	return 0
+}
+
+func (x *GetWeatherResponse) GetDewPoint() float64 {
+	if x != nil {
+		return x.DewPoint
+	}
+	return 0
+}
+
+func (x *GetWeatherResponse) GetStale() bool {
+	if x != nil {
+		return x.Stale
+	}
+	return false
+}
+
+func (x *GetWeatherResponse) GetDisputed() bool {
+	if x != nil {
+		return x.Disputed
+	}
+	return false
+}
+
+type GetForecastRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	City          string                 `protobuf:"bytes,1,opt,name=city,proto3" json:"city,omitempty"`
+	Days          int32                  `protobuf:"varint,2,opt,name=days,proto3" json:"days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetForecastRequest) Reset() {
+	*x = GetForecastRequest{}
+	mi := &file_weather_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetForecastRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetForecastRequest) ProtoMessage() {}
+
+func (x *GetForecastRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_weather_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetForecastRequest.ProtoReflect.Descriptor instead.
+func (*GetForecastRequest) Descriptor() ([]byte, []int) {
+	return file_weather_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetForecastRequest) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *GetForecastRequest) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+type DailyForecast struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Date                string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	MinTemperature      float64                `protobuf:"fixed64,2,opt,name=min_temperature,json=minTemperature,proto3" json:"min_temperature,omitempty"`
+	MaxTemperature      float64                `protobuf:"fixed64,3,opt,name=max_temperature,json=maxTemperature,proto3" json:"max_temperature,omitempty"`
+	PrecipitationChance int32                  `protobuf:"varint,4,opt,name=precipitation_chance,json=precipitationChance,proto3" json:"precipitation_chance,omitempty"`
+	Condition           string                 `protobuf:"bytes,5,opt,name=condition,proto3" json:"condition,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *DailyForecast) Reset() {
+	*x = DailyForecast{}
+	mi := &file_weather_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DailyForecast) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DailyForecast) ProtoMessage() {}
+
+func (x *DailyForecast) ProtoReflect() protoreflect.Message {
+	mi := &file_weather_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DailyForecast.ProtoReflect.Descriptor instead.
+func (*DailyForecast) Descriptor() ([]byte, []int) {
+	return file_weather_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DailyForecast) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *DailyForecast) GetMinTemperature() float64 {
+	if x != nil {
+		return x.MinTemperature
+	}
+	return 0
+}
+
+func (x *DailyForecast) GetMaxTemperature() float64 {
+	if x != nil {
+		return x.MaxTemperature
+	}
+	return 0
+}
+
+func (x *DailyForecast) GetPrecipitationChance() int32 {
+	if x != nil {
+		return x.PrecipitationChance
+	}
+	return 0
+}
+
+func (x *DailyForecast) GetCondition() string {
+	if x != nil {
+		return x.Condition
+	}
+	return ""
+}
+
+type GetForecastResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Days          []*DailyForecast       `protobuf:"bytes,1,rep,name=days,proto3" json:"days,omitempty"`
+	Stale         bool                   `protobuf:"varint,2,opt,name=stale,proto3" json:"stale,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetForecastResponse) Reset() {
+	*x = GetForecastResponse{}
+	mi := &file_weather_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetForecastResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetForecastResponse) ProtoMessage() {}
+
+func (x *GetForecastResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_weather_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetForecastResponse.ProtoReflect.Descriptor instead.
+func (*GetForecastResponse) Descriptor() ([]byte, []int) {
+	return file_weather_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetForecastResponse) GetDays() []*DailyForecast {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+func (x *GetForecastResponse) GetStale() bool {
+	if x != nil {
+		return x.Stale
+	}
+	return false
+}
+
+type ResolveCityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	City          string                 `protobuf:"bytes,1,opt,name=city,proto3" json:"city,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveCityRequest) Reset() {
+	*x = ResolveCityRequest{}
+	mi := &file_weather_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveCityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveCityRequest) ProtoMessage() {}
+
+func (x *ResolveCityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_weather_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveCityRequest.ProtoReflect.Descriptor instead.
+func (*ResolveCityRequest) Descriptor() ([]byte, []int) {
+	return file_weather_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ResolveCityRequest) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+type Coordinates struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Latitude      float64                `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude     float64                `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Coordinates) Reset() {
+	*x = Coordinates{}
+	mi := &file_weather_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Coordinates) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Coordinates) ProtoMessage() {}
+
+func (x *Coordinates) ProtoReflect() protoreflect.Message {
+	mi := &file_weather_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Coordinates.ProtoReflect.Descriptor instead.
+func (*Coordinates) Descriptor() ([]byte, []int) {
+	return file_weather_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Coordinates) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *Coordinates) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+type Location struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Country       string                 `protobuf:"bytes,3,opt,name=country,proto3" json:"country,omitempty"`
+	Coordinates   *Coordinates           `protobuf:"bytes,4,opt,name=coordinates,proto3" json:"coordinates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Location) Reset() {
+	*x = Location{}
+	mi := &file_weather_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Location) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Location) ProtoMessage() {}
+
+func (x *Location) ProtoReflect() protoreflect.Message {
+	mi := &file_weather_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Location.ProtoReflect.Descriptor instead.
+func (*Location) Descriptor() ([]byte, []int) {
+	return file_weather_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Location) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Location) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Location) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *Location) GetCoordinates() *Coordinates {
+	if x != nil {
+		return x.Coordinates
+	}
+	return nil
+}
+
+type ResolveCityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Location      *Location              `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveCityResponse) Reset() {
+	*x = ResolveCityResponse{}
+	mi := &file_weather_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveCityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveCityResponse) ProtoMessage() {}
+
+func (x *ResolveCityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_weather_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveCityResponse.ProtoReflect.Descriptor instead.
+func (*ResolveCityResponse) Descriptor() ([]byte, []int) {
+	return file_weather_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ResolveCityResponse) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+type GetWeatherBatchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cities        []string               `protobuf:"bytes,1,rep,name=cities,proto3" json:"cities,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWeatherBatchRequest) Reset() {
+	*x = GetWeatherBatchRequest{}
+	mi := &file_weather_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWeatherBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWeatherBatchRequest) ProtoMessage() {}
+
+func (x *GetWeatherBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_weather_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWeatherBatchRequest.ProtoReflect.Descriptor instead.
+func (*GetWeatherBatchRequest) Descriptor() ([]byte, []int) {
+	return file_weather_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetWeatherBatchRequest) GetCities() []string {
+	if x != nil {
+		return x.Cities
+	}
+	return nil
+}
+
+type CityWeatherError struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CityWeatherError) Reset() {
+	*x = CityWeatherError{}
+	mi := &file_weather_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CityWeatherError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CityWeatherError) ProtoMessage() {}
+
+func (x *CityWeatherError) ProtoReflect() protoreflect.Message {
+	mi := &file_weather_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CityWeatherError.ProtoReflect.Descriptor instead.
+func (*CityWeatherError) Descriptor() ([]byte, []int) {
+	return file_weather_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CityWeatherError) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *CityWeatherError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type CityWeatherResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	City  string                 `protobuf:"bytes,1,opt,name=city,proto3" json:"city,omitempty"`
+	// Types that are valid to be assigned to Result:
+	//
+	//	*CityWeatherResult_Weather
+	//	*CityWeatherResult_Error
+	Result        isCityWeatherResult_Result `protobuf_oneof:"result"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CityWeatherResult) Reset() {
+	*x = CityWeatherResult{}
+	mi := &file_weather_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CityWeatherResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CityWeatherResult) ProtoMessage() {}
+
+func (x *CityWeatherResult) ProtoReflect() protoreflect.Message {
+	mi := &file_weather_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CityWeatherResult.ProtoReflect.Descriptor instead.
+func (*CityWeatherResult) Descriptor() ([]byte, []int) {
+	return file_weather_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CityWeatherResult) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *CityWeatherResult) GetResult() isCityWeatherResult_Result {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *CityWeatherResult) GetWeather() *GetWeatherResponse {
+	if x != nil {
+		if x, ok := x.Result.(*CityWeatherResult_Weather); ok {
+			return x.Weather
+		}
+	}
+	return nil
+}
+
+func (x *CityWeatherResult) GetError() *CityWeatherError {
+	if x != nil {
+		if x, ok := x.Result.(*CityWeatherResult_Error); ok {
+			return x.Error
+		}
+	}
+	return nil
+}
+
+type isCityWeatherResult_Result interface {
+	isCityWeatherResult_Result()
+}
+
+type CityWeatherResult_Weather struct {
+	Weather *GetWeatherResponse `protobuf:"bytes,2,opt,name=weather,proto3,oneof"`
+}
+
+type CityWeatherResult_Error struct {
+	Error *CityWeatherError `protobuf:"bytes,3,opt,name=error,proto3,oneof"`
+}
+
+func (*CityWeatherResult_Weather) isCityWeatherResult_Result() {}
+
+func (*CityWeatherResult_Error) isCityWeatherResult_Result() {}
+
+type GetWeatherBatchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*CityWeatherResult   `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWeatherBatchResponse) Reset() {
+	*x = GetWeatherBatchResponse{}
+	mi := &file_weather_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWeatherBatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWeatherBatchResponse) ProtoMessage() {}
+
+func (x *GetWeatherBatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_weather_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWeatherBatchResponse.ProtoReflect.Descriptor instead.
+func (*GetWeatherBatchResponse) Descriptor() ([]byte, []int) {
+	return file_weather_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetWeatherBatchResponse) GetResults() []*CityWeatherResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type WatchWeatherRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cities        []string               `protobuf:"bytes,1,rep,name=cities,proto3" json:"cities,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchWeatherRequest) Reset() {
+	*x = WatchWeatherRequest{}
+	mi := &file_weather_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchWeatherRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchWeatherRequest) ProtoMessage() {}
+
+func (x *WatchWeatherRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_weather_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchWeatherRequest.ProtoReflect.Descriptor instead.
+func (*WatchWeatherRequest) Descriptor() ([]byte, []int) {
+	return file_weather_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *WatchWeatherRequest) GetCities() []string {
+	if x != nil {
+		return x.Cities
+	}
+	return nil
+}
+
+type WeatherUpdate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	City          string                 `protobuf:"bytes,1,opt,name=city,proto3" json:"city,omitempty"`
+	LocationId    string                 `protobuf:"bytes,2,opt,name=location_id,json=locationId,proto3" json:"location_id,omitempty"`
+	Weather       *GetWeatherResponse    `protobuf:"bytes,3,opt,name=weather,proto3" json:"weather,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WeatherUpdate) Reset() {
+	*x = WeatherUpdate{}
+	mi := &file_weather_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WeatherUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WeatherUpdate) ProtoMessage() {}
+
+func (x *WeatherUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_weather_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WeatherUpdate.ProtoReflect.Descriptor instead.
+func (*WeatherUpdate) Descriptor() ([]byte, []int) {
+	return file_weather_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *WeatherUpdate) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *WeatherUpdate) GetLocationId() string {
+	if x != nil {
+		return x.LocationId
+	}
+	return ""
+}
+
+func (x *WeatherUpdate) GetWeather() *GetWeatherResponse {
+	if x != nil {
+		return x.Weather
+	}
+	return nil
+}
+
+type GetAlertsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	City          string                 `protobuf:"bytes,1,opt,name=city,proto3" json:"city,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAlertsRequest) Reset() {
+	*x = GetAlertsRequest{}
+	mi := &file_weather_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAlertsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAlertsRequest) ProtoMessage() {}
+
+func (x *GetAlertsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_weather_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAlertsRequest.ProtoReflect.Descriptor instead.
+func (*GetAlertsRequest) Descriptor() ([]byte, []int) {
+	return file_weather_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetAlertsRequest) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+type Alert struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Event         string                 `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
+	Severity      string                 `protobuf:"bytes,3,opt,name=severity,proto3" json:"severity,omitempty"`
+	Headline      string                 `protobuf:"bytes,4,opt,name=headline,proto3" json:"headline,omitempty"`
+	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	StartsAt      int64                  `protobuf:"varint,6,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	EndsAt        int64                  `protobuf:"varint,7,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Alert) Reset() {
+	*x = Alert{}
+	mi := &file_weather_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Alert) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Alert) ProtoMessage() {}
+
+func (x *Alert) ProtoReflect() protoreflect.Message {
+	mi := &file_weather_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Alert.ProtoReflect.Descriptor instead.
+func (*Alert) Descriptor() ([]byte, []int) {
+	return file_weather_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Alert) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Alert) GetEvent() string {
+	if x != nil {
+		return x.Event
+	}
+	return ""
+}
+
+func (x *Alert) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
+func (x *Alert) GetHeadline() string {
+	if x != nil {
+		return x.Headline
+	}
+	return ""
+}
+
+func (x *Alert) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Alert) GetStartsAt() int64 {
+	if x != nil {
+		return x.StartsAt
+	}
+	return 0
+}
+
+func (x *Alert) GetEndsAt() int64 {
+	if x != nil {
+		return x.EndsAt
+	}
+	return 0
+}
+
+type GetAlertsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Alerts        []*Alert               `protobuf:"bytes,1,rep,name=alerts,proto3" json:"alerts,omitempty"`
+	Stale         bool                   `protobuf:"varint,2,opt,name=stale,proto3" json:"stale,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAlertsResponse) Reset() {
+	*x = GetAlertsResponse{}
+	mi := &file_weather_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAlertsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAlertsResponse) ProtoMessage() {}
+
+func (x *GetAlertsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_weather_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAlertsResponse.ProtoReflect.Descriptor instead.
+func (*GetAlertsResponse) Descriptor() ([]byte, []int) {
+	return file_weather_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetAlertsResponse) GetAlerts() []*Alert {
+	if x != nil {
+		return x.Alerts
+	}
+	return nil
+}
+
+func (x *GetAlertsResponse) GetStale() bool {
+	if x != nil {
+		return x.Stale
+	}
+	return false
+}
+
+type GetAirQualityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	City          string                 `protobuf:"bytes,1,opt,name=city,proto3" json:"city,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAirQualityRequest) Reset() {
+	*x = GetAirQualityRequest{}
+	mi := &file_weather_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAirQualityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAirQualityRequest) ProtoMessage() {}
+
+func (x *GetAirQualityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_weather_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAirQualityRequest.ProtoReflect.Descriptor instead.
+func (*GetAirQualityRequest) Descriptor() ([]byte, []int) {
+	return file_weather_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetAirQualityRequest) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+type GetAirQualityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Aqi           int32                  `protobuf:"varint,1,opt,name=aqi,proto3" json:"aqi,omitempty"`
+	Category      string                 `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`
+	Pm2_5         float64                `protobuf:"fixed64,3,opt,name=pm2_5,json=pm25,proto3" json:"pm2_5,omitempty"`
+	Pm10          float64                `protobuf:"fixed64,4,opt,name=pm10,proto3" json:"pm10,omitempty"`
+	O3            float64                `protobuf:"fixed64,5,opt,name=o3,proto3" json:"o3,omitempty"`
+	No2           float64                `protobuf:"fixed64,6,opt,name=no2,proto3" json:"no2,omitempty"`
+	Stale         bool                   `protobuf:"varint,7,opt,name=stale,proto3" json:"stale,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAirQualityResponse) Reset() {
+	*x = GetAirQualityResponse{}
+	mi := &file_weather_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAirQualityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAirQualityResponse) ProtoMessage() {}
+
+func (x *GetAirQualityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_weather_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAirQualityResponse.ProtoReflect.Descriptor instead.
+func (*GetAirQualityResponse) Descriptor() ([]byte, []int) {
+	return file_weather_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetAirQualityResponse) GetAqi() int32 {
+	if x != nil {
+		return x.Aqi
+	}
+	return 0
+}
+
+func (x *GetAirQualityResponse) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *GetAirQualityResponse) GetPm2_5() float64 {
+	if x != nil {
+		return x.Pm2_5
+	}
+	return 0
+}
+
+func (x *GetAirQualityResponse) GetPm10() float64 {
+	if x != nil {
+		return x.Pm10
+	}
+	return 0
+}
+
+func (x *GetAirQualityResponse) GetO3() float64 {
+	if x != nil {
+		return x.O3
+	}
+	return 0
+}
+
+func (x *GetAirQualityResponse) GetNo2() float64 {
+	if x != nil {
+		return x.No2
+	}
+	return 0
+}
+
+func (x *GetAirQualityResponse) GetStale() bool {
+	if x != nil {
+		return x.Stale
+	}
+	return false
+}
+
+type GetHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	City          string                 `protobuf:"bytes,1,opt,name=city,proto3" json:"city,omitempty"`
+	From          int64                  `protobuf:"varint,2,opt,name=from,proto3" json:"from,omitempty"`
+	To            int64                  `protobuf:"varint,3,opt,name=to,proto3" json:"to,omitempty"`
+	Aggregation   string                 `protobuf:"bytes,4,opt,name=aggregation,proto3" json:"aggregation,omitempty"`
+	Units         string                 `protobuf:"bytes,5,opt,name=units,proto3" json:"units,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHistoryRequest) Reset() {
+	*x = GetHistoryRequest{}
+	mi := &file_weather_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHistoryRequest) ProtoMessage() {}
+
+func (x *GetHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_weather_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_weather_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetHistoryRequest) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *GetHistoryRequest) GetFrom() int64 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *GetHistoryRequest) GetTo() int64 {
+	if x != nil {
+		return x.To
+	}
+	return 0
+}
+
+func (x *GetHistoryRequest) GetAggregation() string {
+	if x != nil {
+		return x.Aggregation
+	}
+	return ""
+}
+
+func (x *GetHistoryRequest) GetUnits() string {
+	if x != nil {
+		return x.Units
+	}
+	return ""
+}
+
+type HistoryPoint struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Timestamp      int64                  `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Provider       string                 `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	Temperature    float64                `protobuf:"fixed64,3,opt,name=temperature,proto3" json:"temperature,omitempty"`
+	MinTemperature float64                `protobuf:"fixed64,4,opt,name=min_temperature,json=minTemperature,proto3" json:"min_temperature,omitempty"`
+	MaxTemperature float64                `protobuf:"fixed64,5,opt,name=max_temperature,json=maxTemperature,proto3" json:"max_temperature,omitempty"`
+	Humidity       int32                  `protobuf:"varint,6,opt,name=humidity,proto3" json:"humidity,omitempty"`
+	Description    string                 `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
+	WindSpeed      float64                `protobuf:"fixed64,8,opt,name=wind_speed,json=windSpeed,proto3" json:"wind_speed,omitempty"`
+	Pressure       float64                `protobuf:"fixed64,9,opt,name=pressure,proto3" json:"pressure,omitempty"`
+	Samples        int32                  `protobuf:"varint,10,opt,name=samples,proto3" json:"samples,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *HistoryPoint) Reset() {
+	*x = HistoryPoint{}
+	mi := &file_weather_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HistoryPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HistoryPoint) ProtoMessage() {}
+
+func (x *HistoryPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_weather_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HistoryPoint.ProtoReflect.Descriptor instead.
+func (*HistoryPoint) Descriptor() ([]byte, []int) {
+	return file_weather_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *HistoryPoint) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *HistoryPoint) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *HistoryPoint) GetTemperature() float64 {
+	if x != nil {
+		return x.Temperature
+	}
+	return 0
+}
+
+func (x *HistoryPoint) GetMinTemperature() float64 {
+	if x != nil {
+		return x.MinTemperature
+	}
+	return 0
+}
+
+func (x *HistoryPoint) GetMaxTemperature() float64 {
+	if x != nil {
+		return x.MaxTemperature
+	}
+	return 0
+}
+
+func (x *HistoryPoint) GetHumidity() int32 {
+	if x != nil {
+		return x.Humidity
+	}
+	return 0
+}
+
+func (x *HistoryPoint) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *HistoryPoint) GetWindSpeed() float64 {
+	if x != nil {
+		return x.WindSpeed
+	}
+	return 0
+}
+
+func (x *HistoryPoint) GetPressure() float64 {
+	if x != nil {
+		return x.Pressure
+	}
+	return 0
+}
+
+func (x *HistoryPoint) GetSamples() int32 {
+	if x != nil {
+		return x.Samples
+	}
+	return 0
+}
+
+type GetHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LocationId    string                 `protobuf:"bytes,1,opt,name=location_id,json=locationId,proto3" json:"location_id,omitempty"`
+	Points        []*HistoryPoint        `protobuf:"bytes,2,rep,name=points,proto3" json:"points,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHistoryResponse) Reset() {
+	*x = GetHistoryResponse{}
+	mi := &file_weather_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHistoryResponse) ProtoMessage() {}
+
+func (x *GetHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_weather_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_weather_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetHistoryResponse) GetLocationId() string {
+	if x != nil {
+		return x.LocationId
+	}
+	return ""
+}
+
+func (x *GetHistoryResponse) GetPoints() []*HistoryPoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+type SearchCitiesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Prefix        string                 `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchCitiesRequest) Reset() {
+	*x = SearchCitiesRequest{}
+	mi := &file_weather_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchCitiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchCitiesRequest) ProtoMessage() {}
+
+func (x *SearchCitiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_weather_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchCitiesRequest.ProtoReflect.Descriptor instead.
+func (*SearchCitiesRequest) Descriptor() ([]byte, []int) {
+	return file_weather_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SearchCitiesRequest) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *SearchCitiesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type SearchCitiesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Locations     []*Location            `protobuf:"bytes,1,rep,name=locations,proto3" json:"locations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchCitiesResponse) Reset() {
+	*x = SearchCitiesResponse{}
+	mi := &file_weather_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchCitiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchCitiesResponse) ProtoMessage() {}
+
+func (x *SearchCitiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_weather_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchCitiesResponse.ProtoReflect.Descriptor instead.
+func (*SearchCitiesResponse) Descriptor() ([]byte, []int) {
+	return file_weather_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SearchCitiesResponse) GetLocations() []*Location {
+	if x != nil {
+		return x.Locations
+	}
+	return nil
+}
+
+type PrefetchWeatherRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Cities           []string               `protobuf:"bytes,1,rep,name=cities,proto3" json:"cities,omitempty"`
+	AirQualityCities []string               `protobuf:"bytes,2,rep,name=air_quality_cities,json=airQualityCities,proto3" json:"air_quality_cities,omitempty"`
+	FreshUntil       int64                  `protobuf:"varint,3,opt,name=fresh_until,json=freshUntil,proto3" json:"fresh_until,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *PrefetchWeatherRequest) Reset() {
+	*x = PrefetchWeatherRequest{}
+	mi := &file_weather_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrefetchWeatherRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrefetchWeatherRequest) ProtoMessage() {}
+
+func (x *PrefetchWeatherRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_weather_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrefetchWeatherRequest.ProtoReflect.Descriptor instead.
+func (*PrefetchWeatherRequest) Descriptor() ([]byte, []int) {
+	return file_weather_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *PrefetchWeatherRequest) GetCities() []string {
+	if x != nil {
+		return x.Cities
+	}
+	return nil
+}
+
+func (x *PrefetchWeatherRequest) GetAirQualityCities() []string {
+	if x != nil {
+		return x.AirQualityCities
+	}
+	return nil
+}
+
+func (x *PrefetchWeatherRequest) GetFreshUntil() int64 {
+	if x != nil {
+		return x.FreshUntil
+	}
+	return 0
+}
+
+type PrefetchWeatherResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Warmed        int32                  `protobuf:"varint,1,opt,name=warmed,proto3" json:"warmed,omitempty"`
+	Failed        []string               `protobuf:"bytes,2,rep,name=failed,proto3" json:"failed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PrefetchWeatherResponse) Reset() {
+	*x = PrefetchWeatherResponse{}
+	mi := &file_weather_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrefetchWeatherResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrefetchWeatherResponse) ProtoMessage() {}
+
+func (x *PrefetchWeatherResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_weather_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrefetchWeatherResponse.ProtoReflect.Descriptor instead.
+func (*PrefetchWeatherResponse) Descriptor() ([]byte, []int) {
+	return file_weather_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *PrefetchWeatherResponse) GetWarmed() int32 {
+	if x != nil {
+		return x.Warmed
+	}
+	return 0
+}
+
+func (x *PrefetchWeatherResponse) GetFailed() []string {
+	if x != nil {
+		return x.Failed
+	}
+	return nil
+}
+
+type CacheEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	LocationId    string                 `protobuf:"bytes,3,opt,name=location_id,json=locationId,proto3" json:"location_id,omitempty"`
+	Source        string                 `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`
+	CachedAt      int64                  `protobuf:"varint,5,opt,name=cached_at,json=cachedAt,proto3" json:"cached_at,omitempty"`
+	AgeSeconds    int64                  `protobuf:"varint,6,opt,name=age_seconds,json=ageSeconds,proto3" json:"age_seconds,omitempty"`
+	Stale         bool                   `protobuf:"varint,7,opt,name=stale,proto3" json:"stale,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CacheEntry) Reset() {
+	*x = CacheEntry{}
+	mi := &file_weather_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CacheEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CacheEntry) ProtoMessage() {}
+
+func (x *CacheEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_weather_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CacheEntry.ProtoReflect.Descriptor instead.
+func (*CacheEntry) Descriptor() ([]byte, []int) {
+	return file_weather_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *CacheEntry) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *CacheEntry) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *CacheEntry) GetLocationId() string {
+	if x != nil {
+		return x.LocationId
+	}
+	return ""
+}
+
+func (x *CacheEntry) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *CacheEntry) GetCachedAt() int64 {
+	if x != nil {
+		return x.CachedAt
+	}
+	return 0
+}
+
+func (x *CacheEntry) GetAgeSeconds() int64 {
+	if x != nil {
+		return x.AgeSeconds
+	}
+	return 0
+}
+
+func (x *CacheEntry) GetStale() bool {
+	if x != nil {
+		return x.Stale
+	}
+	return false
+}
+
+type ListCacheRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	City          string                 `protobuf:"bytes,1,opt,name=city,proto3" json:"city,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCacheRequest) Reset() {
+	*x = ListCacheRequest{}
+	mi := &file_weather_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCacheRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCacheRequest) ProtoMessage() {}
+
+func (x *ListCacheRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_weather_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCacheRequest.ProtoReflect.Descriptor instead.
+func (*ListCacheRequest) Descriptor() ([]byte, []int) {
+	return file_weather_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListCacheRequest) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+type ListCacheResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*CacheEntry          `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCacheResponse) Reset() {
+	*x = ListCacheResponse{}
+	mi := &file_weather_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCacheResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCacheResponse) ProtoMessage() {}
+
+func (x *ListCacheResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_weather_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCacheResponse.ProtoReflect.Descriptor instead.
+func (*ListCacheResponse) Descriptor() ([]byte, []int) {
+	return file_weather_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListCacheResponse) GetEntries() []*CacheEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type InvalidateCacheRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	City          string                 `protobuf:"bytes,1,opt,name=city,proto3" json:"city,omitempty"`
+	All           bool                   `protobuf:"varint,2,opt,name=all,proto3" json:"all,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InvalidateCacheRequest) Reset() {
+	*x = InvalidateCacheRequest{}
+	mi := &file_weather_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvalidateCacheRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvalidateCacheRequest) ProtoMessage() {}
+
+func (x *InvalidateCacheRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_weather_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvalidateCacheRequest.ProtoReflect.Descriptor instead.
+func (*InvalidateCacheRequest) Descriptor() ([]byte, []int) {
+	return file_weather_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *InvalidateCacheRequest) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *InvalidateCacheRequest) GetAll() bool {
+	if x != nil {
+		return x.All
+	}
+	return false
+}
+
+type InvalidateCacheResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Removed       int32                  `protobuf:"varint,1,opt,name=removed,proto3" json:"removed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InvalidateCacheResponse) Reset() {
+	*x = InvalidateCacheResponse{}
+	mi := &file_weather_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvalidateCacheResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvalidateCacheResponse) ProtoMessage() {}
+
+func (x *InvalidateCacheResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_weather_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvalidateCacheResponse.ProtoReflect.Descriptor instead.
+func (*InvalidateCacheResponse) Descriptor() ([]byte, []int) {
+	return file_weather_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *InvalidateCacheResponse) GetRemoved() int32 {
+	if x != nil {
+		return x.Removed
+	}
+	return 0
+}
+
+type RefreshCacheRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	City          string                 `protobuf:"bytes,1,opt,name=city,proto3" json:"city,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefreshCacheRequest) Reset() {
+	*x = RefreshCacheRequest{}
+	mi := &file_weather_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshCacheRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshCacheRequest) ProtoMessage() {}
+
+func (x *RefreshCacheRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_weather_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshCacheRequest.ProtoReflect.Descriptor instead.
+func (*RefreshCacheRequest) Descriptor() ([]byte, []int) {
+	return file_weather_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *RefreshCacheRequest) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+type RefreshCacheResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*CacheEntry          `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefreshCacheResponse) Reset() {
+	*x = RefreshCacheResponse{}
+	mi := &file_weather_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshCacheResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshCacheResponse) ProtoMessage() {}
+
+func (x *RefreshCacheResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_weather_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshCacheResponse.ProtoReflect.Descriptor instead.
+func (*RefreshCacheResponse) Descriptor() ([]byte, []int) {
+	return file_weather_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *RefreshCacheResponse) GetEntries() []*CacheEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+var File_weather_proto protoreflect.FileDescriptor
+
+const file_weather_proto_rawDesc = "" +
+	"\n" +
+	"\rweather.proto\x12\aweather\"\x99\x01\n" +
+	"\x11GetWeatherRequest\x12\x14\n" +
+	"\x04city\x18\x01 \x01(\tH\x00R\x04city\x128\n" +
+	"\vcoordinates\x18\x04 \x01(\v2\x14.weather.CoordinatesH\x00R\vcoordinates\x12\x14\n" +
+	"\x05units\x18\x02 \x01(\tR\x05units\x12\x12\n" +
+	"\x04lang\x18\x03 \x01(\tR\x04langB\n" +
+	"\n" +
+	"\blocation\"\xc3\x03\n" +
+	"\x12GetWeatherResponse\x12 \n" +
+	"\vtemperature\x18\x01 \x01(\x01R\vtemperature\x12\x1a\n" +
+	"\bhumidity\x18\x02 \x01(\x05R\bhumidity\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1d\n" +
+	"\n" +
+	"wind_speed\x18\x04 \x01(\x01R\twindSpeed\x12%\n" +
+	"\x0ewind_direction\x18\x05 \x01(\x05R\rwindDirection\x12\x1a\n" +
+	"\bpressure\x18\x06 \x01(\x01R\bpressure\x12\x1f\n" +
+	"\vcloud_cover\x18\a \x01(\x05R\n" +
+	"cloudCover\x12\x1e\n" +
+	"\n" +
+	"visibility\x18\b \x01(\x01R\n" +
+	"visibility\x12\x1d\n" +
+	"\n" +
+	"feels_like\x18\t \x01(\x01R\tfeelsLike\x12\x1d\n" +
+	"\n" +
+	"heat_index\x18\n" +
+	" \x01(\x01R\theatIndex\x12\x1d\n" +
+	"\n" +
+	"wind_chill\x18\v \x01(\x01R\twindChill\x12\x1b\n" +
+	"\tdew_point\x18\f \x01(\x01R\bdewPoint\x12\x14\n" +
+	"\x05stale\x18\r \x01(\bR\x05stale\x12\x1a\n" +
+	"\bdisputed\x18\x0e \x01(\bR\bdisputed\"<\n" +
+	"\x12GetForecastRequest\x12\x12\n" +
+	"\x04city\x18\x01 \x01(\tR\x04city\x12\x12\n" +
+	"\x04days\x18\x02 \x01(\x05R\x04days\"\xc6\x01\n" +
+	"\rDailyForecast\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12'\n" +
+	"\x0fmin_temperature\x18\x02 \x01(\x01R\x0eminTemperature\x12'\n" +
+	"\x0fmax_temperature\x18\x03 \x01(\x01R\x0emaxTemperature\x121\n" +
+	"\x14precipitation_chance\x18\x04 \x01(\x05R\x13precipitationChance\x12\x1c\n" +
+	"\tcondition\x18\x05 \x01(\tR\tcondition\"W\n" +
+	"\x13GetForecastResponse\x12*\n" +
+	"\x04days\x18\x01 \x03(\v2\x16.weather.DailyForecastR\x04days\x12\x14\n" +
+	"\x05stale\x18\x02 \x01(\bR\x05stale\"(\n" +
+	"\x12ResolveCityRequest\x12\x12\n" +
+	"\x04city\x18\x01 \x01(\tR\x04city\"G\n" +
+	"\vCoordinates\x12\x1a\n" +
+	"\blatitude\x18\x01 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x02 \x01(\x01R\tlongitude\"\x80\x01\n" +
+	"\bLocation\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\acountry\x18\x03 \x01(\tR\acountry\x126\n" +
+	"\vcoordinates\x18\x04 \x01(\v2\x14.weather.CoordinatesR\vcoordinates\"D\n" +
+	"\x13ResolveCityResponse\x12-\n" +
+	"\blocation\x18\x01 \x01(\v2\x11.weather.LocationR\blocation\"0\n" +
+	"\x16GetWeatherBatchRequest\x12\x16\n" +
+	"\x06cities\x18\x01 \x03(\tR\x06cities\"@\n" +
+	"\x10CityWeatherError\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\x9d\x01\n" +
+	"\x11CityWeatherResult\x12\x12\n" +
+	"\x04city\x18\x01 \x01(\tR\x04city\x127\n" +
+	"\aweather\x18\x02 \x01(\v2\x1b.weather.GetWeatherResponseH\x00R\aweather\x121\n" +
+	"\x05error\x18\x03 \x01(\v2\x19.weather.CityWeatherErrorH\x00R\x05errorB\b\n" +
+	"\x06result\"O\n" +
+	"\x17GetWeatherBatchResponse\x124\n" +
+	"\aresults\x18\x01 \x03(\v2\x1a.weather.CityWeatherResultR\aresults\"-\n" +
+	"\x13WatchWeatherRequest\x12\x16\n" +
+	"\x06cities\x18\x01 \x03(\tR\x06cities\"{\n" +
+	"\rWeatherUpdate\x12\x12\n" +
+	"\x04city\x18\x01 \x01(\tR\x04city\x12\x1f\n" +
+	"\vlocation_id\x18\x02 \x01(\tR\n" +
+	"locationId\x125\n" +
+	"\aweather\x18\x03 \x01(\v2\x1b.weather.GetWeatherResponseR\aweather\"&\n" +
+	"\x10GetAlertsRequest\x12\x12\n" +
+	"\x04city\x18\x01 \x01(\tR\x04city\"\xbd\x01\n" +
+	"\x05Alert\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05event\x18\x02 \x01(\tR\x05event\x12\x1a\n" +
+	"\bseverity\x18\x03 \x01(\tR\bseverity\x12\x1a\n" +
+	"\bheadline\x18\x04 \x01(\tR\bheadline\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12\x1b\n" +
+	"\tstarts_at\x18\x06 \x01(\x03R\bstartsAt\x12\x17\n" +
+	"\aends_at\x18\a \x01(\x03R\x06endsAt\"Q\n" +
+	"\x11GetAlertsResponse\x12&\n" +
+	"\x06alerts\x18\x01 \x03(\v2\x0e.weather.AlertR\x06alerts\x12\x14\n" +
+	"\x05stale\x18\x02 \x01(\bR\x05stale\"*\n" +
+	"\x14GetAirQualityRequest\x12\x12\n" +
+	"\x04city\x18\x01 \x01(\tR\x04city\"\xa6\x01\n" +
+	"\x15GetAirQualityResponse\x12\x10\n" +
+	"\x03aqi\x18\x01 \x01(\x05R\x03aqi\x12\x1a\n" +
+	"\bcategory\x18\x02 \x01(\tR\bcategory\x12\x13\n" +
+	"\x05pm2_5\x18\x03 \x01(\x01R\x04pm25\x12\x12\n" +
+	"\x04pm10\x18\x04 \x01(\x01R\x04pm10\x12\x0e\n" +
+	"\x02o3\x18\x05 \x01(\x01R\x02o3\x12\x10\n" +
+	"\x03no2\x18\x06 \x01(\x01R\x03no2\x12\x14\n" +
+	"\x05stale\x18\a \x01(\bR\x05stale\"\x83\x01\n" +
+	"\x11GetHistoryRequest\x12\x12\n" +
+	"\x04city\x18\x01 \x01(\tR\x04city\x12\x12\n" +
+	"\x04from\x18\x02 \x01(\x03R\x04from\x12\x0e\n" +
+	"\x02to\x18\x03 \x01(\x03R\x02to\x12 \n" +
+	"\vaggregation\x18\x04 \x01(\tR\vaggregation\x12\x14\n" +
+	"\x05units\x18\x05 \x01(\tR\x05units\"\xcf\x02\n" +
+	"\fHistoryPoint\x12\x1c\n" +
+	"\ttimestamp\x18\x01 \x01(\x03R\ttimestamp\x12\x1a\n" +
+	"\bprovider\x18\x02 \x01(\tR\bprovider\x12 \n" +
+	"\vtemperature\x18\x03 \x01(\x01R\vtemperature\x12'\n" +
+	"\x0fmin_temperature\x18\x04 \x01(\x01R\x0eminTemperature\x12'\n" +
+	"\x0fmax_temperature\x18\x05 \x01(\x01R\x0emaxTemperature\x12\x1a\n" +
+	"\bhumidity\x18\x06 \x01(\x05R\bhumidity\x12 \n" +
+	"\vdescription\x18\a \x01(\tR\vdescription\x12\x1d\n" +
+	"\n" +
+	"wind_speed\x18\b \x01(\x01R\twindSpeed\x12\x1a\n" +
+	"\bpressure\x18\t \x01(\x01R\bpressure\x12\x18\n" +
+	"\asamples\x18\n" +
+	" \x01(\x05R\asamples\"d\n" +
+	"\x12GetHistoryResponse\x12\x1f\n" +
+	"\vlocation_id\x18\x01 \x01(\tR\n" +
+	"locationId\x12-\n" +
+	"\x06points\x18\x02 \x03(\v2\x15.weather.HistoryPointR\x06points\"C\n" +
+	"\x13SearchCitiesRequest\x12\x16\n" +
+	"\x06prefix\x18\x01 \x01(\tR\x06prefix\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"G\n" +
+	"\x14SearchCitiesResponse\x12/\n" +
+	"\tlocations\x18\x01 \x03(\v2\x11.weather.LocationR\tlocations\"\x7f\n" +
+	"\x16PrefetchWeatherRequest\x12\x16\n" +
+	"\x06cities\x18\x01 \x03(\tR\x06cities\x12,\n" +
+	"\x12air_quality_cities\x18\x02 \x03(\tR\x10airQualityCities\x12\x1f\n" +
+	"\vfresh_until\x18\x03 \x01(\x03R\n" +
+	"freshUntil\"I\n" +
+	"\x17PrefetchWeatherResponse\x12\x16\n" +
+	"\x06warmed\x18\x01 \x01(\x05R\x06warmed\x12\x16\n" +
+	"\x06failed\x18\x02 \x03(\tR\x06failed\"\xbf\x01\n" +
+	"\n" +
+	"CacheEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x1f\n" +
+	"\vlocation_id\x18\x03 \x01(\tR\n" +
+	"locationId\x12\x16\n" +
+	"\x06source\x18\x04 \x01(\tR\x06source\x12\x1b\n" +
+	"\tcached_at\x18\x05 \x01(\x03R\bcachedAt\x12\x1f\n" +
+	"\vage_seconds\x18\x06 \x01(\x03R\n" +
+	"ageSeconds\x12\x14\n" +
+	"\x05stale\x18\a \x01(\bR\x05stale\"&\n" +
+	"\x10ListCacheRequest\x12\x12\n" +
+	"\x04city\x18\x01 \x01(\tR\x04city\"B\n" +
+	"\x11ListCacheResponse\x12-\n" +
+	"\aentries\x18\x01 \x03(\v2\x13.weather.CacheEntryR\aentries\">\n" +
+	"\x16InvalidateCacheRequest\x12\x12\n" +
+	"\x04city\x18\x01 \x01(\tR\x04city\x12\x10\n" +
+	"\x03all\x18\x02 \x01(\bR\x03all\"3\n" +
+	"\x17InvalidateCacheResponse\x12\x18\n" +
+	"\aremoved\x18\x01 \x01(\x05R\aremoved\")\n" +
+	"\x13RefreshCacheRequest\x12\x12\n" +
+	"\x04city\x18\x01 \x01(\tR\x04city\"E\n" +
+	"\x14RefreshCacheResponse\x12-\n" +
+	"\aentries\x18\x01 \x03(\v2\x13.weather.CacheEntryR\aentries2\x87\x06\n" +
+	"\x0eWeatherService\x12E\n" +
+	"\n" +
+	"GetWeather\x12\x1a.weather.GetWeatherRequest\x1a\x1b.weather.GetWeatherResponse\x12H\n" +
+	"\vGetForecast\x12\x1b.weather.GetForecastRequest\x1a\x1c.weather.GetForecastResponse\x12H\n" +
+	"\vResolveCity\x12\x1b.weather.ResolveCityRequest\x1a\x1c.weather.ResolveCityResponse\x12T\n" +
+	"\x0fGetWeatherBatch\x12\x1f.weather.GetWeatherBatchRequest\x1a .weather.GetWeatherBatchResponse\x12F\n" +
+	"\fWatchWeather\x12\x1c.weather.WatchWeatherRequest\x1a\x16.weather.WeatherUpdate0\x01\x12B\n" +
+	"\tGetAlerts\x12\x19.weather.GetAlertsRequest\x1a\x1a.weather.GetAlertsResponse\x12N\n" +
+	"\rGetAirQuality\x12\x1d.weather.GetAirQualityRequest\x1a\x1e.weather.GetAirQualityResponse\x12E\n" +
+	"\n" +
+	"GetHistory\x12\x1a.weather.GetHistoryRequest\x1a\x1b.weather.GetHistoryResponse\x12K\n" +
+	"\fSearchCities\x12\x1c.weather.SearchCitiesRequest\x1a\x1d.weather.SearchCitiesResponse\x12T\n" +
+	"\x0fPrefetchWeather\x12\x1f.weather.PrefetchWeatherRequest\x1a .weather.PrefetchWeatherResponse2\xfc\x01\n" +
+	"\x13WeatherAdminService\x12B\n" +
+	"\tListCache\x12\x19.weather.ListCacheRequest\x1a\x1a.weather.ListCacheResponse\x12T\n" +
+	"\x0fInvalidateCache\x12\x1f.weather.InvalidateCacheRequest\x1a .weather.InvalidateCacheResponse\x12K\n" +
+	"\fRefreshCache\x12\x1c.weather.RefreshCacheRequest\x1a\x1d.weather.RefreshCacheResponseB\fZ\n" +
+	"./;weatherb\x06proto3"
+
+var (
+	file_weather_proto_rawDescOnce sync.Once
+	file_weather_proto_rawDescData []byte
+)
+
+func file_weather_proto_rawDescGZIP() []byte {
+	file_weather_proto_rawDescOnce.Do(func() {
+		file_weather_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_weather_proto_rawDesc), len(file_weather_proto_rawDesc)))
+	})
+	return file_weather_proto_rawDescData
+}
+
+var file_weather_proto_msgTypes = make([]protoimpl.MessageInfo, 34)
+var file_weather_proto_goTypes = []any{
+	(*GetWeatherRequest)(nil),       // 0: weather.GetWeatherRequest
+	(*GetWeatherResponse)(nil),      // 1: weather.GetWeatherResponse
+	(*GetForecastRequest)(nil),      // 2: weather.GetForecastRequest
+	(*DailyForecast)(nil),           // 3: weather.DailyForecast
+	(*GetForecastResponse)(nil),     // 4: weather.GetForecastResponse
+	(*ResolveCityRequest)(nil),      // 5: weather.ResolveCityRequest
+	(*Coordinates)(nil),             // 6: weather.Coordinates
+	(*Location)(nil),                // 7: weather.Location
+	(*ResolveCityResponse)(nil),     // 8: weather.ResolveCityResponse
+	(*GetWeatherBatchRequest)(nil),  // 9: weather.GetWeatherBatchRequest
+	(*CityWeatherError)(nil),        // 10: weather.CityWeatherError
+	(*CityWeatherResult)(nil),       // 11: weather.CityWeatherResult
+	(*GetWeatherBatchResponse)(nil), // 12: weather.GetWeatherBatchResponse
+	(*WatchWeatherRequest)(nil),     // 13: weather.WatchWeatherRequest
+	(*WeatherUpdate)(nil),           // 14: weather.WeatherUpdate
+	(*GetAlertsRequest)(nil),        // 15: weather.GetAlertsRequest
+	(*Alert)(nil),                   // 16: weather.Alert
+	(*GetAlertsResponse)(nil),       // 17: weather.GetAlertsResponse
+	(*GetAirQualityRequest)(nil),    // 18: weather.GetAirQualityRequest
+	(*GetAirQualityResponse)(nil),   // 19: weather.GetAirQualityResponse
+	(*GetHistoryRequest)(nil),       // 20: weather.GetHistoryRequest
+	(*HistoryPoint)(nil),            // 21: weather.HistoryPoint
+	(*GetHistoryResponse)(nil),      // 22: weather.GetHistoryResponse
+	(*SearchCitiesRequest)(nil),     // 23: weather.SearchCitiesRequest
+	(*SearchCitiesResponse)(nil),    // 24: weather.SearchCitiesResponse
+	(*PrefetchWeatherRequest)(nil),  // 25: weather.PrefetchWeatherRequest
+	(*PrefetchWeatherResponse)(nil), // 26: weather.PrefetchWeatherResponse
+	(*CacheEntry)(nil),              // 27: weather.CacheEntry
+	(*ListCacheRequest)(nil),        // 28: weather.ListCacheRequest
+	(*ListCacheResponse)(nil),       // 29: weather.ListCacheResponse
+	(*InvalidateCacheRequest)(nil),  // 30: weather.InvalidateCacheRequest
+	(*InvalidateCacheResponse)(nil), // 31: weather.InvalidateCacheResponse
+	(*RefreshCacheRequest)(nil),     // 32: weather.RefreshCacheRequest
+	(*RefreshCacheResponse)(nil),    // 33: weather.RefreshCacheResponse
+}
+var file_weather_proto_depIdxs = []int32{
+	6,  // 0: weather.GetWeatherRequest.coordinates:type_name -> weather.Coordinates
+	3,  // 1: weather.GetForecastResponse.days:type_name -> weather.DailyForecast
+	6,  // 2: weather.Location.coordinates:type_name -> weather.Coordinates
+	7,  // 3: weather.ResolveCityResponse.location:type_name -> weather.Location
+	1,  // 4: weather.CityWeatherResult.weather:type_name -> weather.GetWeatherResponse
+	10, // 5: weather.CityWeatherResult.error:type_name -> weather.CityWeatherError
+	11, // 6: weather.GetWeatherBatchResponse.results:type_name -> weather.CityWeatherResult
+	1,  // 7: weather.WeatherUpdate.weather:type_name -> weather.GetWeatherResponse
+	16, // 8: weather.GetAlertsResponse.alerts:type_name -> weather.Alert
+	21, // 9: weather.GetHistoryResponse.points:type_name -> weather.HistoryPoint
+	7,  // 10: weather.SearchCitiesResponse.locations:type_name -> weather.Location
+	27, // 11: weather.ListCacheResponse.entries:type_name -> weather.CacheEntry
+	27, // 12: weather.RefreshCacheResponse.entries:type_name -> weather.CacheEntry
+	0,  // 13: weather.WeatherService.GetWeather:input_type -> weather.GetWeatherRequest
+	2,  // 14: weather.WeatherService.GetForecast:input_type -> weather.GetForecastRequest
+	5,  // 15: weather.WeatherService.ResolveCity:input_type -> weather.ResolveCityRequest
+	9,  // 16: weather.WeatherService.GetWeatherBatch:input_type -> weather.GetWeatherBatchRequest
+	13, // 17: weather.WeatherService.WatchWeather:input_type -> weather.WatchWeatherRequest
+	15, // 18: weather.WeatherService.GetAlerts:input_type -> weather.GetAlertsRequest
+	18, // 19: weather.WeatherService.GetAirQuality:input_type -> weather.GetAirQualityRequest
+	20, // 20: weather.WeatherService.GetHistory:input_type -> weather.GetHistoryRequest
+	23, // 21: weather.WeatherService.SearchCities:input_type -> weather.SearchCitiesRequest
+	25, // 22: weather.WeatherService.PrefetchWeather:input_type -> weather.PrefetchWeatherRequest
+	28, // 23: weather.WeatherAdminService.ListCache:input_type -> weather.ListCacheRequest
+	30, // 24: weather.WeatherAdminService.InvalidateCache:input_type -> weather.InvalidateCacheRequest
+	32, // 25: weather.WeatherAdminService.RefreshCache:input_type -> weather.RefreshCacheRequest
+	1,  // 26: weather.WeatherService.GetWeather:output_type -> weather.GetWeatherResponse
+	4,  // 27: weather.WeatherService.GetForecast:output_type -> weather.GetForecastResponse
+	8,  // 28: weather.WeatherService.ResolveCity:output_type -> weather.ResolveCityResponse
+	12, // 29: weather.WeatherService.GetWeatherBatch:output_type -> weather.GetWeatherBatchResponse
+	14, // 30: weather.WeatherService.WatchWeather:output_type -> weather.WeatherUpdate
+	17, // 31: weather.WeatherService.GetAlerts:output_type -> weather.GetAlertsResponse
+	19, // 32: weather.WeatherService.GetAirQuality:output_type -> weather.GetAirQualityResponse
+	22, // 33: weather.WeatherService.GetHistory:output_type -> weather.GetHistoryResponse
+	24, // 34: weather.WeatherService.SearchCities:output_type -> weather.SearchCitiesResponse
+	26, // 35: weather.WeatherService.PrefetchWeather:output_type -> weather.PrefetchWeatherResponse
+	29, // 36: weather.WeatherAdminService.ListCache:output_type -> weather.ListCacheResponse
+	31, // 37: weather.WeatherAdminService.InvalidateCache:output_type -> weather.InvalidateCacheResponse
+	33, // 38: weather.WeatherAdminService.RefreshCache:output_type -> weather.RefreshCacheResponse
+	26, // [26:39] is the sub-list for method output_type
+	13, // [13:26] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
+}
+
+func init() { file_weather_proto_init() }
+func file_weather_proto_init() {
+	if File_weather_proto != nil {
+		return
+	}
+	file_weather_proto_msgTypes[0].OneofWrappers = []any{
+		(*GetWeatherRequest_City)(nil),
+		(*GetWeatherRequest_Coordinates)(nil),
+	}
+	file_weather_proto_msgTypes[11].OneofWrappers = []any{
+		(*CityWeatherResult_Weather)(nil),
+		(*CityWeatherResult_Error)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -179,9 +2424,9 @@ func file_weather_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_weather_proto_rawDesc), len(file_weather_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   34,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   2,
 		},
 		GoTypes:           file_weather_proto_goTypes,
 		DependencyIndexes: file_weather_proto_depIdxs,
@@ -19,7 +19,16 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	WeatherService_GetWeather_FullMethodName = "/weather.WeatherService/GetWeather"
+	WeatherService_GetWeather_FullMethodName      = "/weather.WeatherService/GetWeather"
+	WeatherService_GetForecast_FullMethodName     = "/weather.WeatherService/GetForecast"
+	WeatherService_ResolveCity_FullMethodName     = "/weather.WeatherService/ResolveCity"
+	WeatherService_GetWeatherBatch_FullMethodName = "/weather.WeatherService/GetWeatherBatch"
+	WeatherService_WatchWeather_FullMethodName    = "/weather.WeatherService/WatchWeather"
+	WeatherService_GetAlerts_FullMethodName       = "/weather.WeatherService/GetAlerts"
+	WeatherService_GetAirQuality_FullMethodName   = "/weather.WeatherService/GetAirQuality"
+	WeatherService_GetHistory_FullMethodName      = "/weather.WeatherService/GetHistory"
+	WeatherService_SearchCities_FullMethodName    = "/weather.WeatherService/SearchCities"
+	WeatherService_PrefetchWeather_FullMethodName = "/weather.WeatherService/PrefetchWeather"
 )
 
 // WeatherServiceClient is the client API for WeatherService service.
@@ -27,6 +36,15 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type WeatherServiceClient interface {
 	GetWeather(ctx context.Context, in *GetWeatherRequest, opts ...grpc.CallOption) (*GetWeatherResponse, error)
+	GetForecast(ctx context.Context, in *GetForecastRequest, opts ...grpc.CallOption) (*GetForecastResponse, error)
+	ResolveCity(ctx context.Context, in *ResolveCityRequest, opts ...grpc.CallOption) (*ResolveCityResponse, error)
+	GetWeatherBatch(ctx context.Context, in *GetWeatherBatchRequest, opts ...grpc.CallOption) (*GetWeatherBatchResponse, error)
+	WatchWeather(ctx context.Context, in *WatchWeatherRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WeatherUpdate], error)
+	GetAlerts(ctx context.Context, in *GetAlertsRequest, opts ...grpc.CallOption) (*GetAlertsResponse, error)
+	GetAirQuality(ctx context.Context, in *GetAirQualityRequest, opts ...grpc.CallOption) (*GetAirQualityResponse, error)
+	GetHistory(ctx context.Context, in *GetHistoryRequest, opts ...grpc.CallOption) (*GetHistoryResponse, error)
+	SearchCities(ctx context.Context, in *SearchCitiesRequest, opts ...grpc.CallOption) (*SearchCitiesResponse, error)
+	PrefetchWeather(ctx context.Context, in *PrefetchWeatherRequest, opts ...grpc.CallOption) (*PrefetchWeatherResponse, error)
 }
 
 type weatherServiceClient struct {
@@ -47,11 +65,119 @@ func (c *weatherServiceClient) GetWeather(ctx context.Context, in *GetWeatherReq
 	return out, nil
 }
 
+func (c *weatherServiceClient) GetForecast(ctx context.Context, in *GetForecastRequest, opts ...grpc.CallOption) (*GetForecastResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetForecastResponse)
+	err := c.cc.Invoke(ctx, WeatherService_GetForecast_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weatherServiceClient) ResolveCity(ctx context.Context, in *ResolveCityRequest, opts ...grpc.CallOption) (*ResolveCityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResolveCityResponse)
+	err := c.cc.Invoke(ctx, WeatherService_ResolveCity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weatherServiceClient) GetWeatherBatch(ctx context.Context, in *GetWeatherBatchRequest, opts ...grpc.CallOption) (*GetWeatherBatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWeatherBatchResponse)
+	err := c.cc.Invoke(ctx, WeatherService_GetWeatherBatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weatherServiceClient) WatchWeather(ctx context.Context, in *WatchWeatherRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WeatherUpdate], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &WeatherService_ServiceDesc.Streams[0], WeatherService_WatchWeather_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchWeatherRequest, WeatherUpdate]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type WeatherService_WatchWeatherClient = grpc.ServerStreamingClient[WeatherUpdate]
+
+func (c *weatherServiceClient) GetAlerts(ctx context.Context, in *GetAlertsRequest, opts ...grpc.CallOption) (*GetAlertsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAlertsResponse)
+	err := c.cc.Invoke(ctx, WeatherService_GetAlerts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weatherServiceClient) GetAirQuality(ctx context.Context, in *GetAirQualityRequest, opts ...grpc.CallOption) (*GetAirQualityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAirQualityResponse)
+	err := c.cc.Invoke(ctx, WeatherService_GetAirQuality_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weatherServiceClient) GetHistory(ctx context.Context, in *GetHistoryRequest, opts ...grpc.CallOption) (*GetHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetHistoryResponse)
+	err := c.cc.Invoke(ctx, WeatherService_GetHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weatherServiceClient) SearchCities(ctx context.Context, in *SearchCitiesRequest, opts ...grpc.CallOption) (*SearchCitiesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchCitiesResponse)
+	err := c.cc.Invoke(ctx, WeatherService_SearchCities_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weatherServiceClient) PrefetchWeather(ctx context.Context, in *PrefetchWeatherRequest, opts ...grpc.CallOption) (*PrefetchWeatherResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PrefetchWeatherResponse)
+	err := c.cc.Invoke(ctx, WeatherService_PrefetchWeather_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // WeatherServiceServer is the server API for WeatherService service.
 // All implementations must embed UnimplementedWeatherServiceServer
 // for forward compatibility.
 type WeatherServiceServer interface {
 	GetWeather(context.Context, *GetWeatherRequest) (*GetWeatherResponse, error)
+	GetForecast(context.Context, *GetForecastRequest) (*GetForecastResponse, error)
+	ResolveCity(context.Context, *ResolveCityRequest) (*ResolveCityResponse, error)
+	GetWeatherBatch(context.Context, *GetWeatherBatchRequest) (*GetWeatherBatchResponse, error)
+	WatchWeather(*WatchWeatherRequest, grpc.ServerStreamingServer[WeatherUpdate]) error
+	GetAlerts(context.Context, *GetAlertsRequest) (*GetAlertsResponse, error)
+	GetAirQuality(context.Context, *GetAirQualityRequest) (*GetAirQualityResponse, error)
+	GetHistory(context.Context, *GetHistoryRequest) (*GetHistoryResponse, error)
+	SearchCities(context.Context, *SearchCitiesRequest) (*SearchCitiesResponse, error)
+	PrefetchWeather(context.Context, *PrefetchWeatherRequest) (*PrefetchWeatherResponse, error)
 	mustEmbedUnimplementedWeatherServiceServer()
 }
 
@@ -65,6 +191,33 @@ type UnimplementedWeatherServiceServer struct{}
 func (UnimplementedWeatherServiceServer) GetWeather(context.Context, *GetWeatherRequest) (*GetWeatherResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetWeather not implemented")
 }
+func (UnimplementedWeatherServiceServer) GetForecast(context.Context, *GetForecastRequest) (*GetForecastResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetForecast not implemented")
+}
+func (UnimplementedWeatherServiceServer) ResolveCity(context.Context, *ResolveCityRequest) (*ResolveCityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveCity not implemented")
+}
+func (UnimplementedWeatherServiceServer) GetWeatherBatch(context.Context, *GetWeatherBatchRequest) (*GetWeatherBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWeatherBatch not implemented")
+}
+func (UnimplementedWeatherServiceServer) WatchWeather(*WatchWeatherRequest, grpc.ServerStreamingServer[WeatherUpdate]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchWeather not implemented")
+}
+func (UnimplementedWeatherServiceServer) GetAlerts(context.Context, *GetAlertsRequest) (*GetAlertsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAlerts not implemented")
+}
+func (UnimplementedWeatherServiceServer) GetAirQuality(context.Context, *GetAirQualityRequest) (*GetAirQualityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAirQuality not implemented")
+}
+func (UnimplementedWeatherServiceServer) GetHistory(context.Context, *GetHistoryRequest) (*GetHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHistory not implemented")
+}
+func (UnimplementedWeatherServiceServer) SearchCities(context.Context, *SearchCitiesRequest) (*SearchCitiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchCities not implemented")
+}
+func (UnimplementedWeatherServiceServer) PrefetchWeather(context.Context, *PrefetchWeatherRequest) (*PrefetchWeatherResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PrefetchWeather not implemented")
+}
 func (UnimplementedWeatherServiceServer) mustEmbedUnimplementedWeatherServiceServer() {}
 func (UnimplementedWeatherServiceServer) testEmbeddedByValue()                        {}
 
@@ -104,6 +257,161 @@ func _WeatherService_GetWeather_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WeatherService_GetForecast_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetForecastRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeatherServiceServer).GetForecast(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WeatherService_GetForecast_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeatherServiceServer).GetForecast(ctx, req.(*GetForecastRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WeatherService_ResolveCity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveCityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeatherServiceServer).ResolveCity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WeatherService_ResolveCity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeatherServiceServer).ResolveCity(ctx, req.(*ResolveCityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WeatherService_GetWeatherBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWeatherBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeatherServiceServer).GetWeatherBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WeatherService_GetWeatherBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeatherServiceServer).GetWeatherBatch(ctx, req.(*GetWeatherBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WeatherService_WatchWeather_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchWeatherRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WeatherServiceServer).WatchWeather(m, &grpc.GenericServerStream[WatchWeatherRequest, WeatherUpdate]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type WeatherService_WatchWeatherServer = grpc.ServerStreamingServer[WeatherUpdate]
+
+func _WeatherService_GetAlerts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAlertsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeatherServiceServer).GetAlerts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WeatherService_GetAlerts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeatherServiceServer).GetAlerts(ctx, req.(*GetAlertsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WeatherService_GetAirQuality_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAirQualityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeatherServiceServer).GetAirQuality(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WeatherService_GetAirQuality_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeatherServiceServer).GetAirQuality(ctx, req.(*GetAirQualityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WeatherService_GetHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeatherServiceServer).GetHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WeatherService_GetHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeatherServiceServer).GetHistory(ctx, req.(*GetHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WeatherService_SearchCities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchCitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeatherServiceServer).SearchCities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WeatherService_SearchCities_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeatherServiceServer).SearchCities(ctx, req.(*SearchCitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WeatherService_PrefetchWeather_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PrefetchWeatherRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeatherServiceServer).PrefetchWeather(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WeatherService_PrefetchWeather_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeatherServiceServer).PrefetchWeather(ctx, req.(*PrefetchWeatherRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // WeatherService_ServiceDesc is the grpc.ServiceDesc for WeatherService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -115,6 +423,222 @@ var WeatherService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetWeather",
 			Handler:    _WeatherService_GetWeather_Handler,
 		},
+		{
+			MethodName: "GetForecast",
+			Handler:    _WeatherService_GetForecast_Handler,
+		},
+		{
+			MethodName: "ResolveCity",
+			Handler:    _WeatherService_ResolveCity_Handler,
+		},
+		{
+			MethodName: "GetWeatherBatch",
+			Handler:    _WeatherService_GetWeatherBatch_Handler,
+		},
+		{
+			MethodName: "GetAlerts",
+			Handler:    _WeatherService_GetAlerts_Handler,
+		},
+		{
+			MethodName: "GetAirQuality",
+			Handler:    _WeatherService_GetAirQuality_Handler,
+		},
+		{
+			MethodName: "GetHistory",
+			Handler:    _WeatherService_GetHistory_Handler,
+		},
+		{
+			MethodName: "SearchCities",
+			Handler:    _WeatherService_SearchCities_Handler,
+		},
+		{
+			MethodName: "PrefetchWeather",
+			Handler:    _WeatherService_PrefetchWeather_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchWeather",
+			Handler:       _WeatherService_WatchWeather_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "weather.proto",
+}
+
+const (
+	WeatherAdminService_ListCache_FullMethodName       = "/weather.WeatherAdminService/ListCache"
+	WeatherAdminService_InvalidateCache_FullMethodName = "/weather.WeatherAdminService/InvalidateCache"
+	WeatherAdminService_RefreshCache_FullMethodName    = "/weather.WeatherAdminService/RefreshCache"
+)
+
+// WeatherAdminServiceClient is the client API for WeatherAdminService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type WeatherAdminServiceClient interface {
+	ListCache(ctx context.Context, in *ListCacheRequest, opts ...grpc.CallOption) (*ListCacheResponse, error)
+	InvalidateCache(ctx context.Context, in *InvalidateCacheRequest, opts ...grpc.CallOption) (*InvalidateCacheResponse, error)
+	RefreshCache(ctx context.Context, in *RefreshCacheRequest, opts ...grpc.CallOption) (*RefreshCacheResponse, error)
+}
+
+type weatherAdminServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWeatherAdminServiceClient(cc grpc.ClientConnInterface) WeatherAdminServiceClient {
+	return &weatherAdminServiceClient{cc}
+}
+
+func (c *weatherAdminServiceClient) ListCache(ctx context.Context, in *ListCacheRequest, opts ...grpc.CallOption) (*ListCacheResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCacheResponse)
+	err := c.cc.Invoke(ctx, WeatherAdminService_ListCache_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weatherAdminServiceClient) InvalidateCache(ctx context.Context, in *InvalidateCacheRequest, opts ...grpc.CallOption) (*InvalidateCacheResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InvalidateCacheResponse)
+	err := c.cc.Invoke(ctx, WeatherAdminService_InvalidateCache_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weatherAdminServiceClient) RefreshCache(ctx context.Context, in *RefreshCacheRequest, opts ...grpc.CallOption) (*RefreshCacheResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RefreshCacheResponse)
+	err := c.cc.Invoke(ctx, WeatherAdminService_RefreshCache_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WeatherAdminServiceServer is the server API for WeatherAdminService service.
+// All implementations must embed UnimplementedWeatherAdminServiceServer
+// for forward compatibility.
+type WeatherAdminServiceServer interface {
+	ListCache(context.Context, *ListCacheRequest) (*ListCacheResponse, error)
+	InvalidateCache(context.Context, *InvalidateCacheRequest) (*InvalidateCacheResponse, error)
+	RefreshCache(context.Context, *RefreshCacheRequest) (*RefreshCacheResponse, error)
+	mustEmbedUnimplementedWeatherAdminServiceServer()
+}
+
+// UnimplementedWeatherAdminServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedWeatherAdminServiceServer struct{}
+
+func (UnimplementedWeatherAdminServiceServer) ListCache(context.Context, *ListCacheRequest) (*ListCacheResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCache not implemented")
+}
+func (UnimplementedWeatherAdminServiceServer) InvalidateCache(context.Context, *InvalidateCacheRequest) (*InvalidateCacheResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InvalidateCache not implemented")
+}
+func (UnimplementedWeatherAdminServiceServer) RefreshCache(context.Context, *RefreshCacheRequest) (*RefreshCacheResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshCache not implemented")
+}
+func (UnimplementedWeatherAdminServiceServer) mustEmbedUnimplementedWeatherAdminServiceServer() {}
+func (UnimplementedWeatherAdminServiceServer) testEmbeddedByValue()                             {}
+
+// UnsafeWeatherAdminServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to WeatherAdminServiceServer will
+// result in compilation errors.
+type UnsafeWeatherAdminServiceServer interface {
+	mustEmbedUnimplementedWeatherAdminServiceServer()
+}
+
+func RegisterWeatherAdminServiceServer(s grpc.ServiceRegistrar, srv WeatherAdminServiceServer) {
+	// If the following call pancis, it indicates UnimplementedWeatherAdminServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&WeatherAdminService_ServiceDesc, srv)
+}
+
+func _WeatherAdminService_ListCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCacheRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeatherAdminServiceServer).ListCache(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WeatherAdminService_ListCache_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeatherAdminServiceServer).ListCache(ctx, req.(*ListCacheRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WeatherAdminService_InvalidateCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InvalidateCacheRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeatherAdminServiceServer).InvalidateCache(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WeatherAdminService_InvalidateCache_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeatherAdminServiceServer).InvalidateCache(ctx, req.(*InvalidateCacheRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WeatherAdminService_RefreshCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshCacheRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeatherAdminServiceServer).RefreshCache(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WeatherAdminService_RefreshCache_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeatherAdminServiceServer).RefreshCache(ctx, req.(*RefreshCacheRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// WeatherAdminService_ServiceDesc is the grpc.ServiceDesc for WeatherAdminService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var WeatherAdminService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "weather.WeatherAdminService",
+	HandlerType: (*WeatherAdminServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListCache",
+			Handler:    _WeatherAdminService_ListCache_Handler,
+		},
+		{
+			MethodName: "InvalidateCache",
+			Handler:    _WeatherAdminService_InvalidateCache_Handler,
+		},
+		{
+			MethodName: "RefreshCache",
+			Handler:    _WeatherAdminService_RefreshCache_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "weather.proto",
@@ -1,20 +1,58 @@
 package dto
 
+import "time"
+
 type (
 	Weather struct {
-		Temperature float64
-		Humidity    int
-		Description string
+		Temperature   float64
+		Humidity      int
+		Description   string
+		WindSpeed     float64
+		WindDirection int
+		Pressure      float64
+		CloudCover    int
+		Visibility    float64
+		FeelsLike     float64
+		HeatIndex     float64
+		WindChill     float64
+		DewPoint      float64
+		Stale         bool
+	}
+
+	AirQuality struct {
+		AQI      int
+		Category string
+		PM25     float64
+		PM10     float64
+		O3       float64
+		NO2      float64
 	}
 
 	WeatherSuccess struct {
-		City    string
-		Email   string
-		Weather Weather
+		City       string
+		Email      string
+		Weather    Weather
+		AirQuality *AirQuality
 	}
 
 	WeatherError struct {
 		City  string
 		Email string
 	}
+
+	Alert struct {
+		ID          string
+		Event       string
+		Severity    string
+		Headline    string
+		Description string
+		Start       time.Time
+		End         time.Time
+	}
+
+	WeatherAlert struct {
+		City  string
+		Email string
+		Alert Alert
+	}
 )
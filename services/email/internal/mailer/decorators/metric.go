@@ -24,9 +24,16 @@ func NewMetricMailer(mailer services.Mailer, metric metrics.MailRecorder, logger
 }
 
 func (m *MetricMailer) Send(ctx context.Context, subject string, body, email string) error {
+	return m.record(ctx, subject, m.mailer.Send(ctx, subject, body, email))
+}
+
+func (m *MetricMailer) SendUrgent(ctx context.Context, subject string, body, email string) error {
+	return m.record(ctx, subject, m.mailer.SendUrgent(ctx, subject, body, email))
+}
+
+func (m *MetricMailer) record(ctx context.Context, subject string, err error) error {
 	log := m.logger.WithContext(ctx)
 
-	err := m.mailer.Send(ctx, subject, body, email)
 	if err != nil {
 		log.Debugf("Incrementing emails_failed_total metric")
 		m.metric.RecordEmailFail(subject)
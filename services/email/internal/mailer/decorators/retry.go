@@ -33,13 +33,25 @@ func NewRetryMailer(mailer services.Mailer, cfg config.Retry, logger logger.Logg
 }
 
 func (m *RetryMailer) Send(ctx context.Context, subject string, body, email string) error {
+	return m.retry(ctx, email, func() error {
+		return m.mailer.Send(ctx, subject, body, email)
+	})
+}
+
+func (m *RetryMailer) SendUrgent(ctx context.Context, subject string, body, email string) error {
+	return m.retry(ctx, email, func() error {
+		return m.mailer.SendUrgent(ctx, subject, body, email)
+	})
+}
+
+func (m *RetryMailer) retry(ctx context.Context, email string, send func() error) error {
 	log := m.logger.WithContext(ctx)
 
 	var err error
 
 	for attempt := 0; attempt < m.maxRetries; attempt++ {
 
-		err = m.mailer.Send(ctx, subject, body, email)
+		err = send()
 
 		if err == nil {
 			return nil
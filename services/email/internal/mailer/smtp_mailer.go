@@ -32,10 +32,20 @@ func NewSMTPMailer(cfg *config.Mailer, logger logger.Logger) *SMTPMailer {
 }
 
 func (m *SMTPMailer) Send(_ context.Context, subject string, body, email string) error {
+	return m.send(subject, body, email, "")
+}
+
+// SendUrgent marks the message as high priority for the mail clients.
+func (m *SMTPMailer) SendUrgent(_ context.Context, subject string, body, email string) error {
+	return m.send(subject, body, email, "X-Priority: 1 (Highest)\r\nImportance: High\r\n")
+}
+
+func (m *SMTPMailer) send(subject string, body, email string, headers string) error {
 	msg := []byte(
 		fmt.Sprintf("To: %s\r\n", email) +
 			fmt.Sprintf("From: %s\r\n", m.from) +
 			fmt.Sprintf("Subject: %s\r\n", subject) +
+			headers +
 			"\r\n" + body,
 	)
 
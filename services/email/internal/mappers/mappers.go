@@ -3,6 +3,7 @@ package mappers
 import (
 	"email-service/internal/dto"
 	"strings"
+	"time"
 	"weather-forecast/pkg/proto/events"
 )
 
@@ -32,20 +33,43 @@ func UnsubscribeEventToDTO(event *events.UnsubscribedEvent) *dto.UnsubscribedEma
 
 func WeatherToDTO(weather *events.Weather) *dto.Weather {
 	return &dto.Weather{
-		Temperature: weather.Temperature,
-		Humidity:    int(weather.Humidity),
-		Description: weather.Description,
+		Temperature:   weather.Temperature,
+		Humidity:      int(weather.Humidity),
+		Description:   weather.Description,
+		WindSpeed:     weather.WindSpeed,
+		WindDirection: int(weather.WindDirection),
+		Pressure:      weather.Pressure,
+		CloudCover:    int(weather.CloudCover),
+		Visibility:    weather.Visibility,
+		FeelsLike:     weather.FeelsLike,
+		HeatIndex:     weather.HeatIndex,
+		WindChill:     weather.WindChill,
+		DewPoint:      weather.DewPoint,
+		Stale:         weather.Stale,
 	}
 }
 
 func SuccessWeatherToDTO(event *events.WeatherSuccessEvent) *dto.WeatherSuccess {
 	weather := WeatherToDTO(event.Weather)
 
-	return &dto.WeatherSuccess{
+	res := &dto.WeatherSuccess{
 		City:    event.City,
 		Email:   event.Email,
 		Weather: *weather,
 	}
+
+	if event.AirQuality != nil {
+		res.AirQuality = &dto.AirQuality{
+			AQI:      int(event.AirQuality.Aqi),
+			Category: event.AirQuality.Category,
+			PM25:     event.AirQuality.Pm2_5,
+			PM10:     event.AirQuality.Pm10,
+			O3:       event.AirQuality.O3,
+			NO2:      event.AirQuality.No2,
+		}
+	}
+
+	return res
 }
 
 func ErrorWeatherToDTO(event *events.WeatherErrorEvent) *dto.WeatherError {
@@ -55,6 +79,32 @@ func ErrorWeatherToDTO(event *events.WeatherErrorEvent) *dto.WeatherError {
 	}
 }
 
+func AlertWeatherToDTO(event *events.WeatherAlertEvent) *dto.WeatherAlert {
+	alert := event.GetAlert()
+
+	return &dto.WeatherAlert{
+		City:  event.City,
+		Email: event.Email,
+		Alert: dto.Alert{
+			ID:          alert.GetId(),
+			Event:       alert.GetEvent(),
+			Severity:    alert.GetSeverity(),
+			Headline:    alert.GetHeadline(),
+			Description: alert.GetDescription(),
+			Start:       unixToTime(alert.GetStartsAt()),
+			End:         unixToTime(alert.GetEndsAt()),
+		},
+	}
+}
+
+func unixToTime(seconds int64) time.Time {
+	if seconds == 0 {
+		return time.Time{}
+	}
+
+	return time.Unix(seconds, 0).UTC()
+}
+
 func SubjectToSubjectType(subject string) string {
 	subject = strings.ToLower(subject)
 	switch {
@@ -62,6 +112,8 @@ func SubjectToSubjectType(subject string) string {
 		return "confirmation"
 	case strings.Contains(subject, "confirm"):
 		return "subscription"
+	case strings.Contains(subject, "alert"):
+		return "alert"
 	case strings.Contains(subject, "weather"):
 		return "weather"
 	case strings.Contains(subject, "canceled"):
@@ -16,6 +16,7 @@ const (
 	UnsubscribedRoute   = "emails.unsubscribed"
 	WeatherSuccessRoute = "emails.weather.success"
 	WeatherErrorRoute   = "emails.weather.error"
+	WeatherAlertRoute   = "emails.weather.alert"
 )
 
 type (
@@ -25,6 +26,7 @@ type (
 		SendUnsubscribed(ctx context.Context, info *dto.UnsubscribedEmailInfo)
 		SendWeather(ctx context.Context, info *dto.WeatherSuccess)
 		SendError(ctx context.Context, info *dto.WeatherError)
+		SendAlert(ctx context.Context, info *dto.WeatherAlert)
 	}
 
 	EventProcessor struct {
@@ -92,6 +94,15 @@ func (h *EventProcessor) Handle(ctx context.Context, routingKey string, body []b
 		log.Debugf("Successfully parsed WeatherErrorEvent for email: %s, city: %s", e.Email, e.City)
 		h.sender.SendError(ctx, mappers.ErrorWeatherToDTO(e))
 
+	case WeatherAlertRoute:
+		e := &events.WeatherAlertEvent{}
+		if err := proto.Unmarshal(body, e); err != nil {
+			log.Warnf("failed to unmarshal WeatherAlertEvent from routing_key = %s:%s", routingKey, err.Error())
+			return
+		}
+		log.Debugf("Successfully parsed WeatherAlertEvent for email: %s, city: %s", e.Email, e.City)
+		h.sender.SendAlert(ctx, mappers.AlertWeatherToDTO(e))
+
 	default:
 		log.Warnf("unknown event: %s", routingKey)
 
@@ -3,6 +3,8 @@ package services
 import (
 	"email-service/internal/dto"
 	"fmt"
+	"strings"
+	"time"
 	"weather-forecast/pkg/logger"
 )
 
@@ -56,15 +58,42 @@ func (s *SimpleEmailBuildService) CreateUnsubscribeEmail(info *dto.UnsubscribedE
 }
 
 func (s *SimpleEmailBuildService) CreateWeatherEmail(info *dto.WeatherSuccess) Email {
+	body := fmt.Sprintf(
+		"Here's the latest weather update for your city: %s\nTemperature: %.1f°C\nFeels like: %.1f°C\nHumidity: %d%%\nDescription: %s\nWind: %.1f km/h, %d°\nPressure: %.0f hPa\nCloud cover: %d%%\nVisibility: %.1f km\nHeat index: %.1f°C\nWind chill: %.1f°C\nDew point: %.1f°C",
+		info.City,
+		info.Weather.Temperature,
+		info.Weather.FeelsLike,
+		info.Weather.Humidity,
+		info.Weather.Description,
+		info.Weather.WindSpeed,
+		info.Weather.WindDirection,
+		info.Weather.Pressure,
+		info.Weather.CloudCover,
+		info.Weather.Visibility,
+		info.Weather.HeatIndex,
+		info.Weather.WindChill,
+		info.Weather.DewPoint,
+	)
+
+	if info.AirQuality != nil {
+		body += fmt.Sprintf(
+			"\n\nAir quality index: %d (%s)\nPM2.5: %.1f µg/m³\nPM10: %.1f µg/m³\nO3: %.1f µg/m³\nNO2: %.1f µg/m³",
+			info.AirQuality.AQI,
+			info.AirQuality.Category,
+			info.AirQuality.PM25,
+			info.AirQuality.PM10,
+			info.AirQuality.O3,
+			info.AirQuality.NO2,
+		)
+	}
+
+	if info.Weather.Stale {
+		body += "\n\nNote: fresh weather data is temporarily unavailable, this is the last known weather for your city."
+	}
+
 	return Email{
 		Subject: "Weather Update",
-		Body: fmt.Sprintf(
-			"Here's the latest weather update for your city: %s\nTemperature: %.1f°C\nHumidity: %d%%\nDescription: %s",
-			info.City,
-			info.Weather.Temperature,
-			info.Weather.Humidity,
-			info.Weather.Description,
-		),
+		Body:    body,
 	}
 }
 
@@ -74,3 +103,31 @@ func (s *SimpleEmailBuildService) CreateWeatherErrorEmail(info *dto.WeatherError
 		Body:    fmt.Sprintf("Sorry, there was an error retrieving weather in your city: %s", info.City),
 	}
 }
+
+func (s *SimpleEmailBuildService) CreateWeatherAlertEmail(info *dto.WeatherAlert) Email {
+	var body strings.Builder
+
+	fmt.Fprintf(&body, "Severe weather alert for your city: %s\n", info.City)
+	if info.Alert.Headline != "" {
+		fmt.Fprintf(&body, "%s\n", info.Alert.Headline)
+	}
+	fmt.Fprintf(&body, "Event: %s\n", info.Alert.Event)
+	if info.Alert.Severity != "" {
+		fmt.Fprintf(&body, "Severity: %s\n", info.Alert.Severity)
+	}
+	if !info.Alert.Start.IsZero() {
+		fmt.Fprintf(&body, "From: %s\n", info.Alert.Start.Format(time.RFC1123))
+	}
+	if !info.Alert.End.IsZero() {
+		fmt.Fprintf(&body, "Until: %s\n", info.Alert.End.Format(time.RFC1123))
+	}
+	if info.Alert.Description != "" {
+		fmt.Fprintf(&body, "\n%s\n", info.Alert.Description)
+	}
+	body.WriteString("\nPlease follow the instructions of your local authorities and stay safe.")
+
+	return Email{
+		Subject: fmt.Sprintf("URGENT: %s alert for %s", info.Alert.Event, info.City),
+		Body:    body.String(),
+	}
+}
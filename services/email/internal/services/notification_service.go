@@ -13,10 +13,12 @@ type (
 		CreateUnsubscribeEmail(info *dto.UnsubscribedEmailInfo) Email
 		CreateWeatherEmail(info *dto.WeatherSuccess) Email
 		CreateWeatherErrorEmail(info *dto.WeatherError) Email
+		CreateWeatherAlertEmail(info *dto.WeatherAlert) Email
 	}
 
 	Mailer interface {
 		Send(ctx context.Context, subject string, body, email string) error
+		SendUrgent(ctx context.Context, subject string, body, email string) error
 	}
 
 	NotificationService struct {
@@ -103,3 +105,17 @@ func (s *NotificationService) SendError(ctx context.Context, info *dto.WeatherEr
 		log.Infof("Weather error email sent successfully to %s (city: %s)", info.Email, info.City)
 	}
 }
+
+func (s *NotificationService) SendAlert(ctx context.Context, info *dto.WeatherAlert) {
+	log := s.logger.WithContext(ctx)
+
+	email := s.emailBuilder.CreateWeatherAlertEmail(info)
+	log.Debugf("Created weather alert email for: %s, city: %s, alert: %s", info.Email, info.City, info.Alert.ID)
+
+	err := s.mailer.SendUrgent(ctx, email.Subject, email.Body, info.Email)
+	if err != nil {
+		log.Errorf("Failed to send weather alert email to %s (city: %s): %v", info.Email, info.City, err)
+	} else {
+		log.Infof("Weather alert email sent successfully to %s (city: %s)", info.Email, info.City)
+	}
+}
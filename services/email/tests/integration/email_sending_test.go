@@ -7,6 +7,7 @@ import (
 	"email-service/tests/mock/mailer"
 	"weather-forecast/pkg/proto/events"
 
+	"strings"
 	"testing"
 	stub_logger "weather-forecast/pkg/stubs/logger"
 
@@ -128,9 +129,18 @@ func Test_WeatherSuccessEvent(t *testing.T) {
 	eventProcessor, mockMailer := setupEventProcessor()
 
 	weather := &events.Weather{
-		Temperature: 54,
-		Humidity:    54,
-		Description: "Sunny",
+		Temperature:   54,
+		Humidity:      54,
+		Description:   "Sunny",
+		WindSpeed:     12.6,
+		WindDirection: 270,
+		Pressure:      1012,
+		CloudCover:    10,
+		Visibility:    10,
+		FeelsLike:     52.5,
+		HeatIndex:     54.2,
+		WindChill:     54,
+		DewPoint:      42.1,
 	}
 
 	event := &events.WeatherSuccessEvent{
@@ -141,7 +151,7 @@ func Test_WeatherSuccessEvent(t *testing.T) {
 
 	expected := mailer.SentEmail{
 		Subject: "Weather Update",
-		Body:    "Here's the latest weather update for your city: Kyiv\nTemperature: 54.0°C\nHumidity: 54%\nDescription: Sunny",
+		Body:    "Here's the latest weather update for your city: Kyiv\nTemperature: 54.0°C\nFeels like: 52.5°C\nHumidity: 54%\nDescription: Sunny\nWind: 12.6 km/h, 270°\nPressure: 1012 hPa\nCloud cover: 10%\nVisibility: 10.0 km\nHeat index: 54.2°C\nWind chill: 54.0°C\nDew point: 42.1°C",
 		SentTo:  "test@example.com",
 	}
 
@@ -156,6 +166,64 @@ func Test_WeatherSuccessEvent(t *testing.T) {
 	assertEmailMatches(t, emails[0], expected)
 }
 
+func Test_WeatherSuccessEvent_Stale(t *testing.T) {
+	eventProcessor, mockMailer := setupEventProcessor()
+
+	event := &events.WeatherSuccessEvent{
+		Email: "test@example.com",
+		City:  "Kyiv",
+		Weather: &events.Weather{
+			Temperature: 20,
+			Humidity:    50,
+			Description: "Cloudy",
+			Stale:       true,
+		},
+	}
+
+	eventBody, err := proto.Marshal(event)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	eventProcessor.Handle(ctx, "emails.weather.success", eventBody)
+
+	emails := mockMailer.GetSentEmails()
+	require.Len(t, emails, 1)
+	assert.Contains(t, emails[0].Body, "Description: Cloudy")
+	assert.True(t, strings.HasSuffix(emails[0].Body, "\n\nNote: fresh weather data is temporarily unavailable, this is the last known weather for your city."))
+}
+
+func Test_WeatherSuccessEvent_AirQuality(t *testing.T) {
+	eventProcessor, mockMailer := setupEventProcessor()
+
+	event := &events.WeatherSuccessEvent{
+		Email: "test@example.com",
+		City:  "Kyiv",
+		Weather: &events.Weather{
+			Temperature: 20,
+			Humidity:    50,
+			Description: "Cloudy",
+		},
+		AirQuality: &events.AirQuality{
+			Aqi:      71,
+			Category: "Moderate",
+			Pm2_5:    20,
+			Pm10:     30,
+			O3:       60.1,
+			No2:      12.3,
+		},
+	}
+
+	eventBody, err := proto.Marshal(event)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	eventProcessor.Handle(ctx, "emails.weather.success", eventBody)
+
+	emails := mockMailer.GetSentEmails()
+	require.Len(t, emails, 1)
+	assert.True(t, strings.HasSuffix(emails[0].Body, "\n\nAir quality index: 71 (Moderate)\nPM2.5: 20.0 µg/m³\nPM10: 30.0 µg/m³\nO3: 60.1 µg/m³\nNO2: 12.3 µg/m³"))
+}
+
 func Test_WeatherErrorEvent(t *testing.T) {
 	eventProcessor, mockMailer := setupEventProcessor()
 
@@ -180,3 +248,45 @@ func Test_WeatherErrorEvent(t *testing.T) {
 	require.Len(t, emails, 1)
 	assertEmailMatches(t, emails[0], expected)
 }
+
+func Test_WeatherAlertEvent(t *testing.T) {
+	eventProcessor, mockMailer := setupEventProcessor()
+
+	event := &events.WeatherAlertEvent{
+		Email: "test@example.com",
+		City:  "Kyiv",
+		Alert: &events.Alert{
+			Id:          "storm:1751371200",
+			Event:       "Storm",
+			Severity:    "Severe",
+			Headline:    "Storm warning for Kyiv",
+			Description: "Wind gusts up to 90 km/h",
+			StartsAt:    1751371200,
+			EndsAt:      1751392800,
+		},
+	}
+
+	expected := mailer.SentEmail{
+		Subject: "URGENT: Storm alert for Kyiv",
+		Body: "Severe weather alert for your city: Kyiv\n" +
+			"Storm warning for Kyiv\n" +
+			"Event: Storm\n" +
+			"Severity: Severe\n" +
+			"From: Tue, 01 Jul 2025 12:00:00 UTC\n" +
+			"Until: Tue, 01 Jul 2025 18:00:00 UTC\n" +
+			"\nWind gusts up to 90 km/h\n" +
+			"\nPlease follow the instructions of your local authorities and stay safe.",
+		SentTo: "test@example.com",
+	}
+
+	eventBody, err := proto.Marshal(event)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	eventProcessor.Handle(ctx, "emails.weather.alert", eventBody)
+
+	emails := mockMailer.GetSentEmails()
+	require.Len(t, emails, 1)
+	assertEmailMatches(t, emails[0], expected)
+	assert.True(t, emails[0].Urgent)
+}
@@ -10,6 +10,7 @@ type (
 		Subject string
 		Body    string
 		SentTo  string
+		Urgent  bool
 	}
 	MockSMTPMailer struct {
 		sentEmails []SentEmail
@@ -25,6 +26,18 @@ func NewMockSMTPMailer() *MockSMTPMailer {
 }
 
 func (m *MockSMTPMailer) Send(ctx context.Context, subject string, body, email string) error {
+	m.record(subject, body, email, false)
+
+	return nil
+}
+
+func (m *MockSMTPMailer) SendUrgent(ctx context.Context, subject string, body, email string) error {
+	m.record(subject, body, email, true)
+
+	return nil
+}
+
+func (m *MockSMTPMailer) record(subject string, body, email string, urgent bool) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
@@ -32,9 +45,8 @@ func (m *MockSMTPMailer) Send(ctx context.Context, subject string, body, email s
 		Subject: subject,
 		Body:    body,
 		SentTo:  email,
+		Urgent:  urgent,
 	})
-
-	return nil
 }
 
 func (m *MockSMTPMailer) GetSentEmails() []SentEmail {
@@ -28,9 +28,10 @@ func (c *SubscriptionGRPCClient) Subscribe(ctx context.Context, info handlers.Su
 	log.Debugf("Calling subscribe via GRPC: Email: %s, City: %s, Frequency: %s", info.Email, info.City, info.Frequency)
 
 	req := &subscription.SubscribeRequest{
-		Email:     info.Email,
-		City:      info.City,
-		Frequency: mappers.MapFrequencyToProto(info.Frequency),
+		Email:             info.Email,
+		City:              info.City,
+		Frequency:         mappers.MapFrequencyToProto(info.Frequency),
+		IncludeAirQuality: info.IncludeAirQuality,
 	}
 	_, err := c.subscriptionGRPC.Subscribe(ctx, req)
 	if err != nil {
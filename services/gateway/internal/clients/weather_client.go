@@ -2,6 +2,7 @@ package clients
 
 import (
 	"context"
+	"time"
 	"weather-forecast/gateway/internal/dto"
 	"weather-forecast/gateway/internal/mappers"
 	"weather-forecast/pkg/logger"
@@ -22,11 +23,15 @@ func NewWeatherGRPCClient(weatherGRPCClient weather.WeatherServiceClient, logger
 	}
 }
 
-func (c *WeatherGRPCClient) GetWeatherByCity(ctx context.Context, city string) (*dto.Weather, error) {
+func (c *WeatherGRPCClient) GetWeatherByCity(ctx context.Context, city string, options dto.WeatherOptions) (*dto.Weather, error) {
 	log := c.logger.WithContext(ctx)
 
 	log.Debugf("Calling get weather via GRPC: %s", city)
-	req := &weather.GetWeatherRequest{City: city}
+	req := &weather.GetWeatherRequest{
+		Location: &weather.GetWeatherRequest_City{City: city},
+		Units:    options.Units,
+		Lang:     options.Lang,
+	}
 	resp, err := c.weatherGRPC.GetWeather(ctx, req)
 	if err != nil {
 		log.Warnf("Failed to get weather via GRPC: City: %s", city)
@@ -37,3 +42,108 @@ func (c *WeatherGRPCClient) GetWeatherByCity(ctx context.Context, city string) (
 
 	return mappers.MapProtoToWeatherDTO(resp), nil
 }
+
+func (c *WeatherGRPCClient) GetWeatherByCoordinates(ctx context.Context, coordinates dto.Coordinates, options dto.WeatherOptions) (*dto.Weather, error) {
+	log := c.logger.WithContext(ctx)
+
+	log.Debugf("Calling get weather via GRPC: %f,%f", coordinates.Latitude, coordinates.Longitude)
+	req := &weather.GetWeatherRequest{
+		Location: &weather.GetWeatherRequest_Coordinates{Coordinates: &weather.Coordinates{
+			Latitude:  coordinates.Latitude,
+			Longitude: coordinates.Longitude,
+		}},
+		Units: options.Units,
+		Lang:  options.Lang,
+	}
+	resp, err := c.weatherGRPC.GetWeather(ctx, req)
+	if err != nil {
+		log.Warnf("Failed to get weather via GRPC: Coordinates: %f,%f", coordinates.Latitude, coordinates.Longitude)
+		return nil, err
+	}
+
+	log.Debugf("Successfully received weather via gRPC: Coordinates %f,%f", coordinates.Latitude, coordinates.Longitude)
+
+	return mappers.MapProtoToWeatherDTO(resp), nil
+}
+
+func (c *WeatherGRPCClient) GetWeatherBatch(ctx context.Context, cities []string) ([]dto.CityWeather, error) {
+	log := c.logger.WithContext(ctx)
+
+	log.Debugf("Calling get weather batch via GRPC: %d cities", len(cities))
+	req := &weather.GetWeatherBatchRequest{Cities: cities}
+	resp, err := c.weatherGRPC.GetWeatherBatch(ctx, req)
+	if err != nil {
+		log.Warnf("Failed to get weather batch via GRPC: %d cities", len(cities))
+		return nil, err
+	}
+
+	log.Debugf("Successfully received weather batch via gRPC: %d cities", len(cities))
+
+	return mappers.MapProtoToCityWeatherList(resp), nil
+}
+
+func (c *WeatherGRPCClient) GetAirQuality(ctx context.Context, city string) (*dto.AirQuality, error) {
+	log := c.logger.WithContext(ctx)
+
+	log.Debugf("Calling get air quality via GRPC: %s", city)
+	req := &weather.GetAirQualityRequest{City: city}
+	resp, err := c.weatherGRPC.GetAirQuality(ctx, req)
+	if err != nil {
+		log.Warnf("Failed to get air quality via GRPC: City: %s", city)
+		return nil, err
+	}
+
+	log.Debugf("Successfully received air quality via gRPC: City %s", city)
+
+	return mappers.MapProtoToAirQualityDTO(resp), nil
+}
+
+func (c *WeatherGRPCClient) GetHistory(ctx context.Context, city string, query dto.HistoryQuery) (*dto.History, error) {
+	log := c.logger.WithContext(ctx)
+
+	log.Debugf("Calling get history via GRPC: %s", city)
+	req := &weather.GetHistoryRequest{
+		City:        city,
+		From:        unixOrZero(query.From),
+		To:          unixOrZero(query.To),
+		Aggregation: query.Aggregation,
+		Units:       query.Units,
+	}
+	resp, err := c.weatherGRPC.GetHistory(ctx, req)
+	if err != nil {
+		log.Warnf("Failed to get history via GRPC: City: %s", city)
+		return nil, err
+	}
+
+	log.Debugf("Successfully received history via gRPC: City %s, points: %d", city, len(resp.Points))
+
+	return mappers.MapProtoToHistoryDTO(resp), nil
+}
+
+func (c *WeatherGRPCClient) SearchCities(ctx context.Context, prefix string, limit int) ([]dto.City, error) {
+	log := c.logger.WithContext(ctx)
+
+	log.Debugf("Calling search cities via GRPC: %s", prefix)
+	req := &weather.SearchCitiesRequest{
+		Prefix: prefix,
+		Limit:  int32(limit),
+	}
+	resp, err := c.weatherGRPC.SearchCities(ctx, req)
+	if err != nil {
+		log.Warnf("Failed to search cities via GRPC: Prefix: %s", prefix)
+		return nil, err
+	}
+
+	log.Debugf("Successfully received cities via gRPC: Prefix %s, cities: %d", prefix, len(resp.Locations))
+
+	return mappers.MapProtoToCitiesDTO(resp), nil
+}
+
+// unixOrZero leaves an unset time as zero, so the weather service applies its default range.
+func unixOrZero(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+
+	return t.Unix()
+}
@@ -1,7 +1,78 @@
 package dto
 
+import "time"
+
 type Weather struct {
-	Temperature float64
-	Humidity    int
-	Description string
+	Temperature   float64
+	Humidity      int
+	Description   string
+	WindSpeed     float64
+	WindDirection int
+	Pressure      float64
+	CloudCover    int
+	Visibility    float64
+	FeelsLike     float64
+	HeatIndex     float64
+	WindChill     float64
+	DewPoint      float64
+	Stale         bool
+	Disputed      bool
+}
+
+type WeatherOptions struct {
+	Units string
+	Lang  string
+}
+
+type CityWeather struct {
+	City    string
+	Weather *Weather
+	Err     error
+}
+
+type AirQuality struct {
+	AQI      int
+	Category string
+	PM25     float64
+	PM10     float64
+	O3       float64
+	NO2      float64
+	Stale    bool
+}
+
+type HistoryQuery struct {
+	From        time.Time
+	To          time.Time
+	Aggregation string
+	Units       string
+}
+
+type HistoryPoint struct {
+	Time           time.Time
+	Provider       string
+	Temperature    float64
+	MinTemperature float64
+	MaxTemperature float64
+	Humidity       int
+	Description    string
+	WindSpeed      float64
+	Pressure       float64
+	Samples        int
+}
+
+type History struct {
+	LocationID string
+	Points     []HistoryPoint
+}
+
+type Coordinates struct {
+	Latitude  float64
+	Longitude float64
+}
+
+type City struct {
+	ID          string
+	Name        string
+	Country     string
+	Coordinates *Coordinates
 }
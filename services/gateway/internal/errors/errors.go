@@ -44,6 +44,12 @@ func NewHTTPFromGRPC(err error, logger logger.Logger) *HTTPResponse {
 			Body:       map[string]any{"error": st.Message()},
 		}
 
+	case codes.Unimplemented:
+		return &HTTPResponse{
+			StatusCode: http.StatusNotImplemented,
+			Body:       map[string]any{"error": st.Message()},
+		}
+
 	default:
 		logger.Warnf("Unexpected gRPC error: %s", err.Error())
 		return &HTTPResponse{
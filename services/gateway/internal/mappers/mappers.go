@@ -1,9 +1,13 @@
 package mappers
 
 import (
+	"time"
 	"weather-forecast/gateway/internal/dto"
 	"weather-forecast/pkg/proto/subscription"
 	"weather-forecast/pkg/proto/weather"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func MapFrequencyToProto(freq string) subscription.Frequency {
@@ -12,6 +16,8 @@ func MapFrequencyToProto(freq string) subscription.Frequency {
 		return subscription.Frequency_DAILY
 	case "hourly":
 		return subscription.Frequency_HOURLY
+	case "alerts":
+		return subscription.Frequency_ALERTS
 	default:
 		return subscription.Frequency_DAILY
 	}
@@ -19,8 +25,94 @@ func MapFrequencyToProto(freq string) subscription.Frequency {
 
 func MapProtoToWeatherDTO(weatherResponse *weather.GetWeatherResponse) *dto.Weather {
 	return &dto.Weather{
-		Temperature: weatherResponse.Temperature,
-		Humidity:    int(weatherResponse.Humidity),
-		Description: weatherResponse.Description,
+		Temperature:   weatherResponse.Temperature,
+		Humidity:      int(weatherResponse.Humidity),
+		Description:   weatherResponse.Description,
+		WindSpeed:     weatherResponse.WindSpeed,
+		WindDirection: int(weatherResponse.WindDirection),
+		Pressure:      weatherResponse.Pressure,
+		CloudCover:    int(weatherResponse.CloudCover),
+		Visibility:    weatherResponse.Visibility,
+		FeelsLike:     weatherResponse.FeelsLike,
+		HeatIndex:     weatherResponse.HeatIndex,
+		WindChill:     weatherResponse.WindChill,
+		DewPoint:      weatherResponse.DewPoint,
+		Stale:         weatherResponse.Stale,
+		Disputed:      weatherResponse.Disputed,
+	}
+}
+
+func MapProtoToCityWeatherList(batchResponse *weather.GetWeatherBatchResponse) []dto.CityWeather {
+	res := make([]dto.CityWeather, 0, len(batchResponse.Results))
+
+	for _, result := range batchResponse.Results {
+		cityWeather := dto.CityWeather{City: result.City}
+
+		if protoWeather := result.GetWeather(); protoWeather != nil {
+			cityWeather.Weather = MapProtoToWeatherDTO(protoWeather)
+		} else {
+			cityWeather.Err = status.Error(codes.Code(result.GetError().GetCode()), result.GetError().GetMessage())
+		}
+
+		res = append(res, cityWeather)
+	}
+
+	return res
+}
+
+func MapProtoToAirQualityDTO(airQualityResponse *weather.GetAirQualityResponse) *dto.AirQuality {
+	return &dto.AirQuality{
+		AQI:      int(airQualityResponse.Aqi),
+		Category: airQualityResponse.Category,
+		PM25:     airQualityResponse.Pm2_5,
+		PM10:     airQualityResponse.Pm10,
+		O3:       airQualityResponse.O3,
+		NO2:      airQualityResponse.No2,
+		Stale:    airQualityResponse.Stale,
 	}
 }
+
+func MapProtoToHistoryDTO(historyResponse *weather.GetHistoryResponse) *dto.History {
+	history := &dto.History{
+		LocationID: historyResponse.LocationId,
+		Points:     make([]dto.HistoryPoint, 0, len(historyResponse.Points)),
+	}
+
+	for _, point := range historyResponse.Points {
+		history.Points = append(history.Points, dto.HistoryPoint{
+			Time:           time.Unix(point.Timestamp, 0).UTC(),
+			Provider:       point.Provider,
+			Temperature:    point.Temperature,
+			MinTemperature: point.MinTemperature,
+			MaxTemperature: point.MaxTemperature,
+			Humidity:       int(point.Humidity),
+			Description:    point.Description,
+			WindSpeed:      point.WindSpeed,
+			Pressure:       point.Pressure,
+			Samples:        int(point.Samples),
+		})
+	}
+
+	return history
+}
+
+func MapProtoToCitiesDTO(searchResponse *weather.SearchCitiesResponse) []dto.City {
+	cities := make([]dto.City, 0, len(searchResponse.Locations))
+
+	for _, location := range searchResponse.Locations {
+		city := dto.City{
+			ID:      location.Id,
+			Name:    location.Name,
+			Country: location.Country,
+		}
+		if location.Coordinates != nil {
+			city.Coordinates = &dto.Coordinates{
+				Latitude:  location.Coordinates.Latitude,
+				Longitude: location.Coordinates.Longitude,
+			}
+		}
+		cities = append(cities, city)
+	}
+
+	return cities
+}
@@ -22,9 +22,10 @@ type (
 	}
 
 	SubscribeRequest struct {
-		Email     string `json:"email" binding:"required,email"`
-		City      string `json:"city" binding:"required"`
-		Frequency string `json:"frequency" binding:"required,oneof=hourly daily"`
+		Email             string `json:"email" binding:"required,email"`
+		City              string `json:"city" binding:"required"`
+		Frequency         string `json:"frequency" binding:"required,oneof=hourly daily alerts"`
+		IncludeAirQuality bool   `json:"include_air_quality"`
 	}
 )
 
@@ -44,7 +45,7 @@ func (h *SubscriptionHandler) Subscribe(ctx *gin.Context) {
 		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid request format"})
 		return
 	}
-	log.Infof("Incoming subscription request: Email: %s, City: %s, Frequency: %s", req.Email, req.City, req.Frequency)
+	log.Infof("Incoming subscription request: Email: %s, City: %s, Frequency: %s, Air quality: %t", req.Email, req.City, req.Frequency, req.IncludeAirQuality)
 
 	err := h.subscriptionClient.Subscribe(ctx, req)
 
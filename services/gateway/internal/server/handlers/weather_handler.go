@@ -3,16 +3,23 @@ package handlers
 import (
 	"context"
 	"net/http"
+	"time"
 	"weather-forecast/gateway/internal/dto"
 	"weather-forecast/gateway/internal/errors"
 	"weather-forecast/pkg/logger"
 
 	"github.com/gin-gonic/gin"
+	"golang.org/x/text/language"
 )
 
 type (
 	WeatherClient interface {
-		GetWeatherByCity(ctx context.Context, city string) (*dto.Weather, error)
+		GetWeatherByCity(ctx context.Context, city string, options dto.WeatherOptions) (*dto.Weather, error)
+		GetWeatherByCoordinates(ctx context.Context, coordinates dto.Coordinates, options dto.WeatherOptions) (*dto.Weather, error)
+		GetWeatherBatch(ctx context.Context, cities []string) ([]dto.CityWeather, error)
+		GetAirQuality(ctx context.Context, city string) (*dto.AirQuality, error)
+		GetHistory(ctx context.Context, city string, query dto.HistoryQuery) (*dto.History, error)
+		SearchCities(ctx context.Context, prefix string, limit int) ([]dto.City, error)
 	}
 
 	WeatherHandler struct {
@@ -21,12 +28,98 @@ type (
 	}
 
 	GetWeatherRequest struct {
-		City string `json:"city" binding:"required"`
+		City  string `json:"city" binding:"required"`
+		Units string `json:"units" binding:"omitempty,oneof=metric imperial standard"`
+		Lang  string `json:"lang"`
+	}
+	GetWeatherByCoordinatesRequest struct {
+		Latitude  *float64 `form:"lat" binding:"required,min=-90,max=90"`
+		Longitude *float64 `form:"lon" binding:"required,min=-180,max=180"`
+		Units     string   `form:"units" binding:"omitempty,oneof=metric imperial standard"`
+		Lang      string   `form:"lang"`
 	}
 	GetWeatherResponse struct {
-		Temperature float64 `json:"temperature"`
-		Humidity    int     `json:"humidity"`
-		Description string  `json:"description"`
+		Temperature   float64 `json:"temperature"`
+		Humidity      int     `json:"humidity"`
+		Description   string  `json:"description"`
+		WindSpeed     float64 `json:"wind_speed"`
+		WindDirection int     `json:"wind_direction"`
+		Pressure      float64 `json:"pressure"`
+		CloudCover    int     `json:"cloud_cover"`
+		Visibility    float64 `json:"visibility"`
+		FeelsLike     float64 `json:"feels_like"`
+		HeatIndex     float64 `json:"heat_index"`
+		WindChill     float64 `json:"wind_chill"`
+		DewPoint      float64 `json:"dew_point"`
+		Stale         bool    `json:"stale,omitempty"`
+		Disputed      bool    `json:"disputed,omitempty"`
+	}
+
+	GetWeatherBatchRequest struct {
+		Cities []string `json:"cities" binding:"required,min=1"`
+	}
+	CityWeatherResponse struct {
+		City    string              `json:"city"`
+		Weather *GetWeatherResponse `json:"weather,omitempty"`
+		Error   string              `json:"error,omitempty"`
+	}
+	GetWeatherBatchResponse struct {
+		Results []CityWeatherResponse `json:"results"`
+	}
+
+	GetAirQualityRequest struct {
+		City string `form:"city" binding:"required"`
+	}
+	GetAirQualityResponse struct {
+		AQI      int     `json:"aqi"`
+		Category string  `json:"category"`
+		PM25     float64 `json:"pm2_5"`
+		PM10     float64 `json:"pm10"`
+		O3       float64 `json:"o3"`
+		NO2      float64 `json:"no2"`
+		Stale    bool    `json:"stale,omitempty"`
+	}
+
+	GetHistoryRequest struct {
+		City        string    `form:"city" binding:"required"`
+		From        time.Time `form:"from" time_format:"2006-01-02T15:04:05Z07:00"`
+		To          time.Time `form:"to" time_format:"2006-01-02T15:04:05Z07:00"`
+		Aggregation string    `form:"aggregation" binding:"omitempty,oneof=hourly daily"`
+		Units       string    `form:"units" binding:"omitempty,oneof=metric imperial standard"`
+	}
+	HistoryPointResponse struct {
+		Time           time.Time `json:"time"`
+		Provider       string    `json:"provider,omitempty"`
+		Temperature    float64   `json:"temperature"`
+		MinTemperature float64   `json:"min_temperature"`
+		MaxTemperature float64   `json:"max_temperature"`
+		Humidity       int       `json:"humidity"`
+		Description    string    `json:"description"`
+		WindSpeed      float64   `json:"wind_speed"`
+		Pressure       float64   `json:"pressure"`
+		Samples        int       `json:"samples"`
+	}
+	GetHistoryResponse struct {
+		LocationID string                 `json:"location_id"`
+		Points     []HistoryPointResponse `json:"points"`
+	}
+
+	SearchCitiesRequest struct {
+		Query string `form:"q" binding:"required"`
+		Limit int    `form:"limit" binding:"omitempty,min=1,max=20"`
+	}
+	CoordinatesResponse struct {
+		Latitude  float64 `json:"latitude"`
+		Longitude float64 `json:"longitude"`
+	}
+	CityResponse struct {
+		ID          string               `json:"id"`
+		Name        string               `json:"name"`
+		Country     string               `json:"country,omitempty"`
+		Coordinates *CoordinatesResponse `json:"coordinates,omitempty"`
+	}
+	SearchCitiesResponse struct {
+		Cities []CityResponse `json:"cities"`
 	}
 )
 
@@ -40,6 +133,13 @@ func NewWeatherHandler(weatherClient WeatherClient, logger logger.Logger) *Weath
 func (h *WeatherHandler) Get(ctx *gin.Context) {
 	log := h.logger.WithContext(ctx)
 
+	_, hasLatitude := ctx.GetQuery("lat")
+	_, hasLongitude := ctx.GetQuery("lon")
+	if hasLatitude || hasLongitude {
+		h.getByCoordinates(ctx)
+		return
+	}
+
 	var req GetWeatherRequest
 	if err := ctx.ShouldBindJSON(&req); err != nil {
 		log.Debugf("Failed to unmarshal request: %s", err.Error())
@@ -47,9 +147,17 @@ func (h *WeatherHandler) Get(ctx *gin.Context) {
 		return
 	}
 
-	log.Infof("Incoming get weather request: City: %s", req.City)
+	if req.Lang == "" {
+		req.Lang = preferredLanguage(ctx.GetHeader("Accept-Language"))
+	}
+
+	log.Infof("Incoming get weather request: City: %s, Units: %s, Lang: %s", req.City, req.Units, req.Lang)
 
-	weather, err := h.weatherClient.GetWeatherByCity(ctx, req.City)
+	options := dto.WeatherOptions{
+		Units: req.Units,
+		Lang:  req.Lang,
+	}
+	weather, err := h.weatherClient.GetWeatherByCity(ctx, req.City, options)
 	if err != nil {
 		log.Debugf("Get weather failed for city %s: %s", req.City, err.Error())
 		httpErr := errors.NewHTTPFromGRPC(err, h.logger)
@@ -59,12 +167,245 @@ func (h *WeatherHandler) Get(ctx *gin.Context) {
 
 	log.Infof("Weather successfully retrieved: City: %s", req.City)
 
-	response := GetWeatherResponse{
-		Temperature: weather.Temperature,
-		Humidity:    weather.Humidity,
-		Description: weather.Description,
+	ctx.JSON(http.StatusOK, mapWeatherResponse(weather))
+
+}
+
+func (h *WeatherHandler) getByCoordinates(ctx *gin.Context) {
+	log := h.logger.WithContext(ctx)
+
+	var req GetWeatherByCoordinatesRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		log.Debugf("Failed to bind query: %s", err.Error())
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid request format"})
+		return
+	}
+
+	if req.Lang == "" {
+		req.Lang = preferredLanguage(ctx.GetHeader("Accept-Language"))
+	}
+
+	coordinates := dto.Coordinates{
+		Latitude:  *req.Latitude,
+		Longitude: *req.Longitude,
+	}
+
+	log.Infof("Incoming get weather request: Coordinates: %f,%f, Units: %s, Lang: %s", coordinates.Latitude, coordinates.Longitude, req.Units, req.Lang)
+	options := dto.WeatherOptions{
+		Units: req.Units,
+		Lang:  req.Lang,
+	}
+	weather, err := h.weatherClient.GetWeatherByCoordinates(ctx, coordinates, options)
+	if err != nil {
+		log.Debugf("Get weather failed for coordinates %f,%f: %s", coordinates.Latitude, coordinates.Longitude, err.Error())
+		httpErr := errors.NewHTTPFromGRPC(err, h.logger)
+		ctx.JSON(httpErr.StatusCode, httpErr.Body)
+		return
+	}
+
+	log.Infof("Weather successfully retrieved: Coordinates: %f,%f", coordinates.Latitude, coordinates.Longitude)
+
+	ctx.JSON(http.StatusOK, mapWeatherResponse(weather))
+}
+
+func (h *WeatherHandler) GetBatch(ctx *gin.Context) {
+	log := h.logger.WithContext(ctx)
+
+	var req GetWeatherBatchRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		log.Debugf("Failed to unmarshal request: %s", err.Error())
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid request format"})
+		return
+	}
+
+	log.Infof("Incoming get weather batch request: Cities: %d", len(req.Cities))
+
+	batch, err := h.weatherClient.GetWeatherBatch(ctx, req.Cities)
+	if err != nil {
+		log.Debugf("Get weather batch failed: %s", err.Error())
+		httpErr := errors.NewHTTPFromGRPC(err, h.logger)
+		ctx.JSON(httpErr.StatusCode, httpErr.Body)
+		return
+	}
+
+	log.Infof("Weather batch successfully retrieved: Cities: %d", len(req.Cities))
+
+	response := GetWeatherBatchResponse{
+		Results: make([]CityWeatherResponse, 0, len(batch)),
+	}
+	for _, cityWeather := range batch {
+		result := CityWeatherResponse{City: cityWeather.City}
+		if cityWeather.Err != nil {
+			httpErr := errors.NewHTTPFromGRPC(cityWeather.Err, h.logger)
+			result.Error, _ = httpErr.Body["error"].(string)
+		} else {
+			weatherResponse := mapWeatherResponse(cityWeather.Weather)
+			result.Weather = &weatherResponse
+		}
+		response.Results = append(response.Results, result)
 	}
 
 	ctx.JSON(http.StatusOK, response)
+}
+
+func (h *WeatherHandler) GetAirQuality(ctx *gin.Context) {
+	log := h.logger.WithContext(ctx)
+
+	var req GetAirQualityRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		log.Debugf("Failed to bind request: %s", err.Error())
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid request format"})
+		return
+	}
+
+	log.Infof("Incoming get air quality request: City: %s", req.City)
+
+	airQuality, err := h.weatherClient.GetAirQuality(ctx, req.City)
+	if err != nil {
+		log.Debugf("Get air quality failed for city %s: %s", req.City, err.Error())
+		httpErr := errors.NewHTTPFromGRPC(err, h.logger)
+		ctx.JSON(httpErr.StatusCode, httpErr.Body)
+		return
+	}
+
+	log.Infof("Air quality successfully retrieved: City: %s", req.City)
+
+	ctx.JSON(http.StatusOK, GetAirQualityResponse{
+		AQI:      airQuality.AQI,
+		Category: airQuality.Category,
+		PM25:     airQuality.PM25,
+		PM10:     airQuality.PM10,
+		O3:       airQuality.O3,
+		NO2:      airQuality.NO2,
+		Stale:    airQuality.Stale,
+	})
+}
+
+func (h *WeatherHandler) GetHistory(ctx *gin.Context) {
+	log := h.logger.WithContext(ctx)
+
+	var req GetHistoryRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		log.Debugf("Failed to bind request: %s", err.Error())
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid request format"})
+		return
+	}
+
+	log.Infof("Incoming get history request: City: %s, Aggregation: %s", req.City, req.Aggregation)
+
+	query := dto.HistoryQuery{
+		From:        req.From,
+		To:          req.To,
+		Aggregation: req.Aggregation,
+		Units:       req.Units,
+	}
+	history, err := h.weatherClient.GetHistory(ctx, req.City, query)
+	if err != nil {
+		log.Debugf("Get history failed for city %s: %s", req.City, err.Error())
+		httpErr := errors.NewHTTPFromGRPC(err, h.logger)
+		ctx.JSON(httpErr.StatusCode, httpErr.Body)
+		return
+	}
+
+	log.Infof("History successfully retrieved: City: %s, Points: %d", req.City, len(history.Points))
+
+	response := GetHistoryResponse{
+		LocationID: history.LocationID,
+		Points:     make([]HistoryPointResponse, 0, len(history.Points)),
+	}
+	for _, point := range history.Points {
+		response.Points = append(response.Points, HistoryPointResponse{
+			Time:           point.Time,
+			Provider:       point.Provider,
+			Temperature:    point.Temperature,
+			MinTemperature: point.MinTemperature,
+			MaxTemperature: point.MaxTemperature,
+			Humidity:       point.Humidity,
+			Description:    point.Description,
+			WindSpeed:      point.WindSpeed,
+			Pressure:       point.Pressure,
+			Samples:        point.Samples,
+		})
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (h *WeatherHandler) SearchCities(ctx *gin.Context) {
+	log := h.logger.WithContext(ctx)
+
+	var req SearchCitiesRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		log.Debugf("Failed to bind request: %s", err.Error())
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid request format"})
+		return
+	}
+
+	log.Infof("Incoming search cities request: Query: %s, Limit: %d", req.Query, req.Limit)
+
+	cities, err := h.weatherClient.SearchCities(ctx, req.Query, req.Limit)
+	if err != nil {
+		log.Debugf("Search cities failed for query %s: %s", req.Query, err.Error())
+		httpErr := errors.NewHTTPFromGRPC(err, h.logger)
+		ctx.JSON(httpErr.StatusCode, httpErr.Body)
+		return
+	}
+
+	log.Infof("Cities successfully found: Query: %s, Cities: %d", req.Query, len(cities))
+
+	response := SearchCitiesResponse{
+		Cities: make([]CityResponse, 0, len(cities)),
+	}
+	for _, city := range cities {
+		cityResponse := CityResponse{
+			ID:      city.ID,
+			Name:    city.Name,
+			Country: city.Country,
+		}
+		if city.Coordinates != nil {
+			cityResponse.Coordinates = &CoordinatesResponse{
+				Latitude:  city.Coordinates.Latitude,
+				Longitude: city.Coordinates.Longitude,
+			}
+		}
+		response.Cities = append(response.Cities, cityResponse)
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+func mapWeatherResponse(weather *dto.Weather) GetWeatherResponse {
+	return GetWeatherResponse{
+		Temperature:   weather.Temperature,
+		Humidity:      weather.Humidity,
+		Description:   weather.Description,
+		WindSpeed:     weather.WindSpeed,
+		WindDirection: weather.WindDirection,
+		Pressure:      weather.Pressure,
+		CloudCover:    weather.CloudCover,
+		Visibility:    weather.Visibility,
+		FeelsLike:     weather.FeelsLike,
+		HeatIndex:     weather.HeatIndex,
+		WindChill:     weather.WindChill,
+		DewPoint:      weather.DewPoint,
+		Stale:         weather.Stale,
+		Disputed:      weather.Disputed,
+	}
+}
+
+// preferredLanguage takes the base language of the most preferred tag of the
+// Accept-Language header, an empty language leaves the choice to the weather service.
+func preferredLanguage(acceptLanguage string) string {
+	tags, _, err := language.ParseAcceptLanguage(acceptLanguage)
+	if err != nil {
+		return ""
+	}
+
+	for _, tag := range tags {
+		if base, confidence := tag.Base(); confidence != language.No && tag != language.Und {
+			return base.String()
+		}
+	}
 
+	return ""
 }
@@ -13,6 +13,10 @@ import (
 type (
 	WeatherHandler interface {
 		Get(ctx *gin.Context)
+		GetBatch(ctx *gin.Context)
+		GetAirQuality(ctx *gin.Context)
+		GetHistory(ctx *gin.Context)
+		SearchCities(ctx *gin.Context)
 	}
 
 	SubscriptionHandler interface {
@@ -59,6 +63,10 @@ func (s *Server) setUpRoutes() {
 		ctx.File("./static/subscription.html")
 	})
 	s.router.GET("/weather", s.weatherHandler.Get)
+	s.router.POST("/weather/batch", s.weatherHandler.GetBatch)
+	s.router.GET("/weather/history", s.weatherHandler.GetHistory)
+	s.router.GET("/air-quality", s.weatherHandler.GetAirQuality)
+	s.router.GET("/cities", s.weatherHandler.SearchCities)
 	s.router.POST("/subscribe", s.subscrtiptionHandler.Subscribe)
 	s.router.GET("/confirm/:token", s.subscrtiptionHandler.Confirm)
 	s.router.GET("/unsubscribe/:token", s.subscrtiptionHandler.Unsubscribe)
@@ -6,6 +6,7 @@ import (
 	"os/signal"
 	"subscription-service/internal/config"
 	"subscription-service/internal/domain/usecases"
+	"subscription-service/internal/infrastructure/clients"
 	"subscription-service/internal/infrastructure/database"
 	"subscription-service/internal/infrastructure/decorators"
 	"subscription-service/internal/infrastructure/metrics"
@@ -16,7 +17,9 @@ import (
 	"subscription-service/internal/infrastructure/token"
 	"subscription-service/internal/presentation/server"
 	"subscription-service/internal/presentation/server/handlers"
+	grpcpkg "weather-forecast/pkg/grpc"
 	"weather-forecast/pkg/logger"
+	"weather-forecast/pkg/proto/weather"
 	"weather-forecast/pkg/publisher"
 	"weather-forecast/pkg/rabbitmq"
 )
@@ -71,7 +74,20 @@ func main() {
 
 	eventSender := sender.NewEventSender(rabbitMQPublisher, logrusLog)
 
-	subscUseCase := usecases.NewSubscriptionService(subscRepo, tokenManager, eventSender, logrusLog)
+	weatherConn, err := grpcpkg.ConnectWithRetry(cfg.WeatherServiceAddress, cfg.GRPC, logrusLog)
+	if err != nil {
+		logrusLog.Fatalf("Failed to connect to Weather Service: %v", err)
+	}
+	defer func() {
+		if err := weatherConn.Close(); err != nil {
+			logrusLog.Errorf("Failed to close gRPC connection with weather service: %v", err)
+		}
+	}()
+
+	weatherGRPCClient := weather.NewWeatherServiceClient(weatherConn)
+	weatherClient := clients.NewWeatherGRPCClient(weatherGRPCClient, logrusLog)
+
+	subscUseCase := usecases.NewSubscriptionService(subscRepo, tokenManager, eventSender, weatherClient, logrusLog)
 	metricSubscUseCase := decorators.NewSubscriptionServiceMetricsDecorator(*subscUseCase, prometheusMetrics, logrusLog)
 	subscHandler := handlers.NewSubscriptionHandler(metricSubscUseCase, logrusLog)
 
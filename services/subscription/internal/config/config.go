@@ -3,6 +3,7 @@ package config
 import (
 	"fmt"
 	"strings"
+	grpcpkg "weather-forecast/pkg/grpc"
 	"weather-forecast/pkg/rabbitmq"
 
 	"github.com/spf13/viper"
@@ -20,6 +21,8 @@ type (
 	Config struct {
 		GRPCPort string `mapstructure:"GRPC_PORT"`
 
+		WeatherServiceAddress string `mapstructure:"WEATHER_SERVICE_ADDRESS"`
+
 		ServiceName       string `mapstructure:"SERVICE_NAME"`
 		MetricsServerPort string `mapstructure:"METRICS_SERVER_PORT"`
 
@@ -29,6 +32,8 @@ type (
 		DB DB `mapstructure:",squash"`
 
 		RabbitMQ rabbitmq.Config `mapstructure:",squash"`
+
+		GRPC grpcpkg.Config `mapstructure:",squash"`
 	}
 )
 
@@ -56,16 +61,21 @@ func validate(config *Config) error {
 		return err
 	}
 
+	if err := config.GRPC.Validate(); err != nil {
+		return err
+	}
+
 	required := map[string]string{
-		"GRPC_PORT":           config.GRPCPort,
-		"DB_HOST":             config.DB.Host,
-		"DB_USER":             config.DB.User,
-		"DB_PASSWORD":         config.DB.Password,
-		"DB_NAME":             config.DB.Name,
-		"DB_PORT":             config.DB.Port,
-		"SERVICE_NAME":        config.ServiceName,
-		"METRICS_SERVER_PORT": config.MetricsServerPort,
-		"LOG_LEVEL":           config.LogLevel,
+		"GRPC_PORT":               config.GRPCPort,
+		"WEATHER_SERVICE_ADDRESS": config.WeatherServiceAddress,
+		"DB_HOST":                 config.DB.Host,
+		"DB_USER":                 config.DB.User,
+		"DB_PASSWORD":             config.DB.Password,
+		"DB_NAME":                 config.DB.Name,
+		"DB_PORT":                 config.DB.Port,
+		"SERVICE_NAME":            config.ServiceName,
+		"METRICS_SERVER_PORT":     config.MetricsServerPort,
+		"LOG_LEVEL":               config.LogLevel,
 	}
 
 	var missing []string
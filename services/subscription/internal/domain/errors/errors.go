@@ -8,4 +8,5 @@ var (
 	ErrAlreadySubscribed = errors.New("email already subscribed")
 	ErrTokenNotFound     = errors.New("there is no subscription with such token")
 	ErrInvalidToken      = errors.New("invalid token")
+	ErrInvalidCity       = errors.New("invalid city")
 )
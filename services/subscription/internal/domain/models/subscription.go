@@ -4,16 +4,18 @@ type (
 	Frequency string
 
 	Subscription struct {
-		ID        int
-		Email     string
-		City      string
-		Token     string
-		Frequency Frequency
-		Confirmed bool
+		ID                int
+		Email             string
+		City              string
+		Token             string
+		Frequency         Frequency
+		Confirmed         bool
+		IncludeAirQuality bool
 	}
 )
 
 const (
 	Daily  Frequency = "daily"
 	Hourly Frequency = "hourly"
+	Alerts Frequency = "alerts"
 )
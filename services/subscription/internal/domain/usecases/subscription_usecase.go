@@ -29,19 +29,25 @@ type (
 		SendUnsubscribed(ctx context.Context, info *contracts.UnsubscribeInfo)
 	}
 
+	CityResolver interface {
+		ResolveCity(ctx context.Context, city string) (string, error)
+	}
+
 	SubscriptionService struct {
 		subscriptionRepository SubscriptionRepository
 		tokenManager           TokenManager
 		mailer                 NotificationSender
+		cityResolver           CityResolver
 		logger                 logger.Logger
 	}
 )
 
-func NewSubscriptionService(subscriptionRepo SubscriptionRepository, tokenManager TokenManager, mailer NotificationSender, logger logger.Logger) *SubscriptionService {
+func NewSubscriptionService(subscriptionRepo SubscriptionRepository, tokenManager TokenManager, mailer NotificationSender, cityResolver CityResolver, logger logger.Logger) *SubscriptionService {
 	return &SubscriptionService{
 		subscriptionRepository: subscriptionRepo,
 		tokenManager:           tokenManager,
 		mailer:                 mailer,
+		cityResolver:           cityResolver,
 		logger:                 logger,
 	}
 }
@@ -58,6 +64,13 @@ func (s *SubscriptionService) Subscribe(ctx context.Context, subscription *model
 		return nil, domainerrors.ErrAlreadySubscribed
 	}
 
+	city, err := s.cityResolver.ResolveCity(ctx, subscription.City)
+	if err != nil {
+		return nil, err
+	}
+	log.Debugf("City %s resolved to canonical name %s", subscription.City, city)
+	subscription.City = city
+
 	log.Debugf("Generating confirmation token for email: %s", subscription.Email)
 	token := s.tokenManager.Generate(ctx)
 	subscription.Token = token
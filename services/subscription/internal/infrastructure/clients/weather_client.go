@@ -0,0 +1,46 @@
+package clients
+
+import (
+	"context"
+	domainerrors "subscription-service/internal/domain/errors"
+	infraerrors "subscription-service/internal/infrastructure/errors"
+	"weather-forecast/pkg/logger"
+	"weather-forecast/pkg/proto/weather"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type (
+	WeatherGRPCClient struct {
+		weatherGRPC weather.WeatherServiceClient
+		logger      logger.Logger
+	}
+)
+
+func NewWeatherGRPCClient(weatherGRPCClient weather.WeatherServiceClient, logger logger.Logger) *WeatherGRPCClient {
+	return &WeatherGRPCClient{
+		weatherGRPC: weatherGRPCClient,
+		logger:      logger,
+	}
+}
+
+func (c *WeatherGRPCClient) ResolveCity(ctx context.Context, city string) (string, error) {
+	log := c.logger.WithContext(ctx)
+
+	log.Debugf("Calling resolve city via GRPC: %s", city)
+	resp, err := c.weatherGRPC.ResolveCity(ctx, &weather.ResolveCityRequest{City: city})
+	if err != nil {
+		if status.Code(err) == codes.InvalidArgument {
+			log.Warnf("Weather service rejected city: %s", city)
+			return "", domainerrors.ErrInvalidCity
+		}
+
+		log.Warnf("Failed to resolve city via GRPC: city=%s, err=%v", city, err)
+		return "", infraerrors.ErrResolveCity
+	}
+
+	log.Debugf("Successfully resolved city via gRPC: %s -> %s", city, resp.Location.Name)
+
+	return resp.Location.Name, nil
+}
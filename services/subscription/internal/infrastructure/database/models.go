@@ -6,17 +6,19 @@ type (
 	Frequency string
 
 	Subscription struct {
-		ID        int    `gorm:"primaryKey"`
-		Email     string `gorm:"unique"`
-		City      string
-		Token     string `gorm:"unique"`
-		Frequency Frequency
-		Confirmed bool      `gorm:"default:false"`
-		CreatedAt time.Time `gorm:"autoCreateTime"`
+		ID                int    `gorm:"primaryKey"`
+		Email             string `gorm:"unique"`
+		City              string
+		Token             string `gorm:"unique"`
+		Frequency         Frequency
+		Confirmed         bool      `gorm:"default:false"`
+		IncludeAirQuality bool      `gorm:"default:false"`
+		CreatedAt         time.Time `gorm:"autoCreateTime"`
 	}
 )
 
 const (
 	Daily  Frequency = "daily"
 	Hourly Frequency = "hourly"
+	Alerts Frequency = "alerts"
 )
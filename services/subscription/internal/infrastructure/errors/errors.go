@@ -8,4 +8,5 @@ var (
 	ErrDatabase          = errors.New("database raised an error")
 	ErrInternal          = errors.New("internal server error")
 	ErrUnknownEventRoute = errors.New("unknown event route")
+	ErrResolveCity       = errors.New("failed to resolve city")
 )
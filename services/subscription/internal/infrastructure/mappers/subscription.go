@@ -7,23 +7,25 @@ import (
 
 func DomainToDatabase(domain models.Subscription) database.Subscription {
 	return database.Subscription{
-		ID:        domain.ID,
-		Email:     domain.Email,
-		City:      domain.City,
-		Token:     domain.Token,
-		Frequency: database.Frequency(domain.Frequency),
-		Confirmed: domain.Confirmed,
+		ID:                domain.ID,
+		Email:             domain.Email,
+		City:              domain.City,
+		Token:             domain.Token,
+		Frequency:         database.Frequency(domain.Frequency),
+		Confirmed:         domain.Confirmed,
+		IncludeAirQuality: domain.IncludeAirQuality,
 	}
 }
 
 func DatabaseToDomain(db database.Subscription) models.Subscription {
 	return models.Subscription{
-		ID:        db.ID,
-		Email:     db.Email,
-		City:      db.City,
-		Token:     db.Token,
-		Frequency: models.Frequency(db.Frequency),
-		Confirmed: db.Confirmed,
+		ID:                db.ID,
+		Email:             db.Email,
+		City:              db.City,
+		Token:             db.Token,
+		Frequency:         models.Frequency(db.Frequency),
+		Confirmed:         db.Confirmed,
+		IncludeAirQuality: db.IncludeAirQuality,
 	}
 }
 
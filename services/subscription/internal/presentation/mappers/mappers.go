@@ -12,6 +12,8 @@ func ProtoToFrequency(protoFrequency subscription.Frequency) models.Frequency {
 		return models.Daily
 	case subscription.Frequency_HOURLY:
 		return models.Hourly
+	case subscription.Frequency_ALERTS:
+		return models.Alerts
 	default:
 		return models.Daily
 	}
@@ -24,6 +26,8 @@ func FrequencyToProto(freq models.Frequency) subscription.Frequency {
 		return subscription.Frequency_DAILY
 	case models.Hourly:
 		return subscription.Frequency_HOURLY
+	case models.Alerts:
+		return subscription.Frequency_ALERTS
 	default:
 		return subscription.Frequency_DAILY
 	}
@@ -31,8 +35,9 @@ func FrequencyToProto(freq models.Frequency) subscription.Frequency {
 
 func SubscriptionToProto(subsc models.Subscription) *subscription.Subscription {
 	return &subscription.Subscription{
-		Email: subsc.Email,
-		City:  subsc.City,
+		Email:             subsc.Email,
+		City:              subsc.City,
+		IncludeAirQuality: subsc.IncludeAirQuality,
 	}
 }
 
@@ -58,10 +63,11 @@ func SubscriptionListToProto(subscriptions []models.Subscription) *subscription.
 
 func SubscribeRequestToSubscribe(req *subscription.SubscribeRequest) *models.Subscription {
 	return &models.Subscription{
-		Email:     req.Email,
-		City:      req.City,
-		Frequency: ProtoToFrequency(req.Frequency),
-		Confirmed: false,
+		Email:             req.Email,
+		City:              req.City,
+		Frequency:         ProtoToFrequency(req.Frequency),
+		Confirmed:         false,
+		IncludeAirQuality: req.IncludeAirQuality,
 	}
 }
 
@@ -63,6 +63,12 @@ func (h *SubscriptionHandler) handleSubscribeError(err error) error {
 	case errors.Is(err, domainerr.ErrAlreadySubscribed):
 		return status.Error(codes.AlreadyExists, err.Error())
 
+	case errors.Is(err, domainerr.ErrInvalidCity):
+		return status.Error(codes.InvalidArgument, err.Error())
+
+	case errors.Is(err, infraerror.ErrResolveCity):
+		return status.Error(codes.Unavailable, err.Error())
+
 	case errors.Is(err, infraerror.ErrDatabase):
 		h.logger.Warnf("Database error during subscription: %v", err)
 		return status.Error(codes.Internal, "internal server error")
@@ -21,6 +21,7 @@ type (
 func New(subscriptionHandler subscription.SubscriptionServiceServer, logger logger.Logger) *Server {
 	grpcServer := grpc.NewServer(
 		grpc.UnaryInterceptor(grpcpkg.CorrelationIDServerInterceptor(logger)),
+		grpc.StreamInterceptor(grpcpkg.CorrelationIDStreamServerInterceptor(logger)),
 	)
 	reflection.Register(grpcServer)
 
@@ -5,12 +5,14 @@ import (
 	"subscription-service/internal/domain/models"
 	"subscription-service/internal/domain/usecases"
 	"subscription-service/internal/infrastructure/database"
+	infraerrors "subscription-service/internal/infrastructure/errors"
 	"subscription-service/internal/infrastructure/repositories"
 	"subscription-service/internal/infrastructure/sender"
 	"subscription-service/internal/infrastructure/token"
 	"subscription-service/internal/presentation/mappers"
 	"subscription-service/internal/presentation/server/handlers"
 	"subscription-service/tests/mocks/publisher"
+	"subscription-service/tests/mocks/weather"
 	protoevents "weather-forecast/pkg/proto/events"
 
 	"testing"
@@ -42,6 +44,10 @@ func setupDB(t *testing.T) *gorm.DB {
 }
 
 func setupHandler(db *gorm.DB) (*handlers.SubscriptionHandler, *publisher.MockEventPublisher) {
+	return setupHandlerWithResolver(db, weather.NewMockCityResolver())
+}
+
+func setupHandlerWithResolver(db *gorm.DB, cityResolver usecases.CityResolver) (*handlers.SubscriptionHandler, *publisher.MockEventPublisher) {
 
 	stubLogger := stub_logger.New()
 	tokenManager := token.NewUUIDManager()
@@ -49,7 +55,7 @@ func setupHandler(db *gorm.DB) (*handlers.SubscriptionHandler, *publisher.MockEv
 	publisher := publisher.NewMockEventPublisher()
 	sender := sender.NewEventSender(publisher, stubLogger)
 	subscRepo := repositories.NewSubscriptionRepository(db, stubLogger)
-	subscUC := usecases.NewSubscriptionService(subscRepo, tokenManager, sender, stubLogger)
+	subscUC := usecases.NewSubscriptionService(subscRepo, tokenManager, sender, cityResolver, stubLogger)
 	subscHandler := handlers.NewSubscriptionHandler(subscUC, stubLogger)
 
 	return subscHandler, publisher
@@ -127,3 +133,104 @@ func TestSubscribe_AlreadySubscribed(t *testing.T) {
 	assert.Contains(t, grpcStatus.Message(), "email already subscribed")
 
 }
+
+func TestSubscribe_StoresCanonicalCity(t *testing.T) {
+	db := setupDB(t)
+
+	subscriptionHandler, _ := setupHandler(db)
+
+	requestBody := &subscription.SubscribeRequest{
+		Email:     "test@gmail.com",
+		City:      " Київ ",
+		Frequency: subscription.Frequency_HOURLY,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	_, err := subscriptionHandler.Subscribe(ctx, requestBody)
+	require.NoError(t, err)
+
+	subscFromDB := models.Subscription{}
+	err = db.Where("email = ?", requestBody.Email).First(&subscFromDB).Error
+	require.NoError(t, err)
+	assert.Equal(t, "Kyiv", subscFromDB.City)
+}
+
+func TestSubscribe_AlertsFrequency(t *testing.T) {
+	db := setupDB(t)
+
+	subscriptionHandler, _ := setupHandler(db)
+
+	requestBody := &subscription.SubscribeRequest{
+		Email:     "test@gmail.com",
+		City:      "Kyiv",
+		Frequency: subscription.Frequency_ALERTS,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	_, err := subscriptionHandler.Subscribe(ctx, requestBody)
+	require.NoError(t, err)
+
+	subscFromDB := models.Subscription{}
+	err = db.Where("email = ?", requestBody.Email).First(&subscFromDB).Error
+	require.NoError(t, err)
+	assert.Equal(t, models.Alerts, subscFromDB.Frequency)
+}
+
+func TestSubscribe_IncludeAirQuality(t *testing.T) {
+	db := setupDB(t)
+
+	subscriptionHandler, _ := setupHandler(db)
+
+	requestBody := &subscription.SubscribeRequest{
+		Email:             "test@gmail.com",
+		City:              "Kyiv",
+		Frequency:         subscription.Frequency_DAILY,
+		IncludeAirQuality: true,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	_, err := subscriptionHandler.Subscribe(ctx, requestBody)
+	require.NoError(t, err)
+
+	err = db.Model(&models.Subscription{}).Where("email = ?", requestBody.Email).Update("confirmed", true).Error
+	require.NoError(t, err)
+
+	resp, err := subscriptionHandler.GetSubscriptionsByFrequency(ctx, &subscription.GetSubscriptionsByFrequencyRequest{
+		Frequency: subscription.Frequency_DAILY,
+		PageSize:  10,
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Subscriptions, 1)
+	assert.True(t, resp.Subscriptions[0].IncludeAirQuality)
+}
+
+func TestSubscribe_CityResolutionFailed(t *testing.T) {
+	db := setupDB(t)
+
+	cityResolver := weather.NewMockCityResolver()
+	cityResolver.SetError(infraerrors.ErrResolveCity)
+	subscriptionHandler, mockPublisher := setupHandlerWithResolver(db, cityResolver)
+
+	requestBody := &subscription.SubscribeRequest{
+		Email:     "test@gmail.com",
+		City:      "Kyiv",
+		Frequency: subscription.Frequency_DAILY,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	_, err := subscriptionHandler.Subscribe(ctx, requestBody)
+
+	grpcStatus, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.Unavailable, grpcStatus.Code())
+
+	var count int64
+	err = db.Model(&models.Subscription{}).Where("email = ?", requestBody.Email).Count(&count).Error
+	require.NoError(t, err)
+	assert.Zero(t, count)
+	assert.Empty(t, mockPublisher.GetPublishedEvents())
+}
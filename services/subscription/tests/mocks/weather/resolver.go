@@ -0,0 +1,44 @@
+package weather
+
+import (
+	"context"
+	"strings"
+	"sync"
+)
+
+type MockCityResolver struct {
+	canonicalNames map[string]string
+	err            error
+	mu             sync.RWMutex
+}
+
+func NewMockCityResolver() *MockCityResolver {
+	return &MockCityResolver{
+		canonicalNames: map[string]string{
+			"kyiv": "Kyiv",
+			"kiev": "Kyiv",
+			"київ": "Kyiv",
+		},
+	}
+}
+
+func (m *MockCityResolver) ResolveCity(ctx context.Context, city string) (string, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	if m.err != nil {
+		return "", m.err
+	}
+
+	if name, ok := m.canonicalNames[strings.ToLower(strings.TrimSpace(city))]; ok {
+		return name, nil
+	}
+
+	return strings.TrimSpace(city), nil
+}
+
+func (m *MockCityResolver) SetError(err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.err = err
+}
@@ -14,6 +14,7 @@ import (
 	"weather-broadcast-service/internal/scheduler"
 	"weather-broadcast-service/internal/sender"
 	"weather-broadcast-service/internal/services"
+	"weather-broadcast-service/internal/storage"
 	"weather-forecast/pkg/logger"
 	"weather-forecast/pkg/proto/subscription"
 	"weather-forecast/pkg/publisher"
@@ -85,18 +86,29 @@ func main() {
 	rabbitMQPublisher := publisher.NewRabbitMQPublisher(ch, cfg.RabbitMQ.Exchange, logrusLog)
 	eventSender := sender.NewEventSender(rabbitMQPublisher, logrusLog)
 
+	seenAlerts, err := storage.NewSeenAlerts(cfg.RedisSource)
+	if err != nil {
+		logrusLog.Fatalf("Failed to connect to Redis: %v", err)
+	}
+	defer func() {
+		if err := seenAlerts.Close(); err != nil {
+			logrusLog.Errorf("Failed to close Redis connection: %v", err)
+		}
+	}()
+
 	location, err := time.LoadLocation(cfg.Timezone)
 	if err != nil {
 		logrusLog.Fatalf("Failed to load timezone: %s", err.Error())
 	}
 
-	weatherBroadcastService := services.NewWeatherBroadcastService(subscriptionClient, weatherClient, eventSender, logrusLog)
+	weatherBroadcastService := services.NewWeatherBroadcastService(subscriptionClient, weatherClient, eventSender, seenAlerts, logrusLog)
 	correlationIdBroadcastDecorator := decorators.NewCorrelationIDDecorator(weatherBroadcastService, logrusLog)
 	metricBroadcastDecorator := decorators.NewBroadcastMetricsDecorator(correlationIdBroadcastDecorator, prometheusMetrics, logrusLog)
 
 	go prometheusMetrics.StartMetricsServer(cfg.MetricsServerPort)
 
-	scheduler := scheduler.New(context.Background(), metricBroadcastDecorator, location, logrusLog)
+	warmUpLead := time.Duration(cfg.WarmUpLeadMinutes) * time.Minute
+	scheduler := scheduler.New(context.Background(), metricBroadcastDecorator, location, warmUpLead, logrusLog)
 	scheduler.SetUp()
 	scheduler.Run()
 
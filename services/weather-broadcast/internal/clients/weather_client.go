@@ -2,6 +2,7 @@ package clients
 
 import (
 	"context"
+	"time"
 	"weather-broadcast-service/internal/dto"
 	"weather-broadcast-service/internal/mappers"
 
@@ -28,7 +29,7 @@ func (c *WeatherGRPCClient) GetWeatherByCity(ctx context.Context, city string) (
 
 	log.Debugf("Calling weather service for city: %s", city)
 
-	req := &weather.GetWeatherRequest{City: city}
+	req := &weather.GetWeatherRequest{Location: &weather.GetWeatherRequest_City{City: city}}
 
 	resp, err := c.weatherGRPC.GetWeather(ctx, req)
 
@@ -40,3 +41,79 @@ func (c *WeatherGRPCClient) GetWeatherByCity(ctx context.Context, city string) (
 	log.Infof("Weather retrieved successfully for city: %s", city)
 	return mappers.MapProtoToWeatherDTO(resp), nil
 }
+
+func (c *WeatherGRPCClient) GetWeatherBatch(ctx context.Context, cities []string) ([]dto.CityWeather, error) {
+	log := c.logger.WithContext(ctx)
+
+	log.Debugf("Calling weather service for batch of %d cities", len(cities))
+
+	req := &weather.GetWeatherBatchRequest{Cities: cities}
+
+	resp, err := c.weatherGRPC.GetWeatherBatch(ctx, req)
+
+	if err != nil {
+		log.Errorf("Weather service batch call failed for %d cities: %v", len(cities), err)
+		return nil, err
+	}
+
+	log.Infof("Weather batch retrieved successfully for %d cities", len(cities))
+	return mappers.MapProtoToCityWeatherList(resp), nil
+}
+
+func (c *WeatherGRPCClient) GetAlerts(ctx context.Context, city string) ([]dto.Alert, error) {
+	log := c.logger.WithContext(ctx)
+
+	log.Debugf("Calling weather service for alerts in city: %s", city)
+
+	req := &weather.GetAlertsRequest{City: city}
+
+	resp, err := c.weatherGRPC.GetAlerts(ctx, req)
+
+	if err != nil {
+		log.Errorf("Weather service alerts call failed for city %s: %v", city, err)
+		return nil, err
+	}
+
+	log.Infof("Alerts retrieved successfully for city: %s, alerts=%d", city, len(resp.Alerts))
+	return mappers.MapProtoToAlertList(resp), nil
+}
+
+func (c *WeatherGRPCClient) GetAirQuality(ctx context.Context, city string) (*dto.AirQuality, error) {
+	log := c.logger.WithContext(ctx)
+
+	log.Debugf("Calling weather service for air quality in city: %s", city)
+
+	req := &weather.GetAirQualityRequest{City: city}
+
+	resp, err := c.weatherGRPC.GetAirQuality(ctx, req)
+
+	if err != nil {
+		log.Errorf("Weather service air quality call failed for city %s: %v", city, err)
+		return nil, err
+	}
+
+	log.Infof("Air quality retrieved successfully for city: %s", city)
+	return mappers.MapProtoToAirQualityDTO(resp), nil
+}
+
+func (c *WeatherGRPCClient) PrefetchWeather(ctx context.Context, cities []string, airQualityCities []string, freshUntil time.Time) (*dto.Prefetch, error) {
+	log := c.logger.WithContext(ctx)
+
+	log.Debugf("Calling weather service to prefetch %d cities and %d air quality cities", len(cities), len(airQualityCities))
+
+	req := &weather.PrefetchWeatherRequest{
+		Cities:           cities,
+		AirQualityCities: airQualityCities,
+		FreshUntil:       freshUntil.Unix(),
+	}
+
+	resp, err := c.weatherGRPC.PrefetchWeather(ctx, req)
+
+	if err != nil {
+		log.Errorf("Weather service prefetch call failed for %d cities: %v", len(cities), err)
+		return nil, err
+	}
+
+	log.Infof("Weather prefetched successfully: warmed=%d, failed=%d", resp.Warmed, len(resp.Failed))
+	return mappers.MapProtoToPrefetchDTO(resp), nil
+}
@@ -19,6 +19,10 @@ type Config struct {
 
 	Timezone string `mapstructure:"TIMEZONE"`
 
+	WarmUpLeadMinutes int `mapstructure:"WARMUP_LEAD_MINUTES"`
+
+	RedisSource string `mapstructure:"REDIS_SOURCE"`
+
 	ServiceName       string `mapstructure:"SERVICE_NAME"`
 	MetricsServerPort string `mapstructure:"METRICS_SERVER_PORT"`
 
@@ -58,6 +62,7 @@ func validate(config *Config) error {
 		"WEATHER_SERVICE_ADDRESS":      config.WeatherServiceAddress,
 		"SUBSCRIPTION_SERVICE_ADDRESS": config.SubscriptionServiceAddress,
 		"TIMEZONE":                     config.Timezone,
+		"REDIS_SOURCE":                 config.RedisSource,
 		"SERVICE_NAME":                 config.ServiceName,
 		"METRICS_SERVER_PORT":          config.MetricsServerPort,
 		"LOG_LEVEL":                    config.LogLevel,
@@ -75,6 +80,10 @@ func validate(config *Config) error {
 
 	}
 
+	if config.WarmUpLeadMinutes < 0 || config.WarmUpLeadMinutes >= 60 {
+		missing = append(missing, "WARMUP_LEAD_MINUTES")
+	}
+
 	if len(missing) > 0 {
 		return fmt.Errorf("missing required fields: %s", strings.Join(missing, ", "))
 	}
@@ -37,3 +37,18 @@ func (d *BroadcastMetricsDecorator) Broadcast(ctx context.Context, frequency mod
 
 	log.Infof("Weather broadcast completed for %s subscription in %v", frequency, duration)
 }
+
+func (d *BroadcastMetricsDecorator) WarmUp(ctx context.Context, frequency models.Frequency, broadcastAt time.Time) {
+	log := d.logger.WithContext(ctx)
+
+	start := time.Now()
+
+	log.Infof("Starting cache warm-up for frequency: %s", frequency)
+
+	d.service.WarmUp(ctx, frequency, broadcastAt)
+
+	duration := time.Since(start)
+	d.metrics.RecordWarmUpDuration(string(frequency), duration)
+
+	log.Infof("Cache warm-up completed for %s subscription in %v", frequency, duration)
+}
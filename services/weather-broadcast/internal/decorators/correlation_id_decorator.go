@@ -2,6 +2,7 @@ package decorators
 
 import (
 	"context"
+	"time"
 	"weather-broadcast-service/internal/models"
 	"weather-broadcast-service/internal/scheduler"
 	"weather-forecast/pkg/ctxutil"
@@ -30,3 +31,11 @@ func (d *CorrelationIDDecorator) Broadcast(ctx context.Context, frequency models
 	d.service.Broadcast(ctx, frequency)
 
 }
+
+func (d *CorrelationIDDecorator) WarmUp(ctx context.Context, frequency models.Frequency, broadcastAt time.Time) {
+	correlationID := uuid.New().String()
+
+	//nolint:staticcheck
+	ctx = context.WithValue(ctx, ctxutil.CorrelationIDKey.String(), correlationID)
+	d.service.WarmUp(ctx, frequency, broadcastAt)
+}
@@ -4,8 +4,9 @@ import "weather-broadcast-service/internal/models"
 
 type (
 	Subscription struct {
-		Email string
-		City  string
+		Email             string
+		City              string
+		IncludeAirQuality bool
 	}
 	SubscriptionList struct {
 		Subscriptions []Subscription
@@ -1,19 +1,68 @@
 package dto
 
+import "time"
+
 type (
 	Weather struct {
-		Temperature float64
-		Humidity    int
-		Description string
+		Temperature   float64
+		Humidity      int
+		Description   string
+		WindSpeed     float64
+		WindDirection int
+		Pressure      float64
+		CloudCover    int
+		Visibility    float64
+		FeelsLike     float64
+		HeatIndex     float64
+		WindChill     float64
+		DewPoint      float64
+		Stale         bool
 	}
 
-	WeatherMailSuccessInfo struct {
-		Email   string
+	CityWeather struct {
 		City    string
-		Weather Weather
+		Weather *Weather
+		Err     error
+	}
+
+	Prefetch struct {
+		Warmed int
+		Failed []string
+	}
+
+	AirQuality struct {
+		AQI      int
+		Category string
+		PM25     float64
+		PM10     float64
+		O3       float64
+		NO2      float64
+	}
+
+	WeatherMailSuccessInfo struct {
+		Email      string
+		City       string
+		Weather    Weather
+		AirQuality *AirQuality
 	}
 	WeatherMailErrorInfo struct {
 		Email string
 		City  string
 	}
+
+	Alert struct {
+		ID          string
+		Event       string
+		Severity    string
+		Headline    string
+		Description string
+		Start       time.Time
+		End         time.Time
+	}
+
+	WeatherAlertInfo struct {
+		Email string
+		City  string
+		Alert Alert
+	}
 )
@@ -2,6 +2,7 @@ package events
 
 import (
 	"errors"
+	"time"
 	"weather-broadcast-service/internal/dto"
 	protoevents "weather-forecast/pkg/proto/events"
 
@@ -11,6 +12,7 @@ import (
 const (
 	weatherSuccessRoute = "emails.weather.success"
 	weatherErrorRoute   = "emails.weather.error"
+	weatherAlertRoute   = "emails.weather.alert"
 )
 
 type (
@@ -25,6 +27,7 @@ type (
 var (
 	weatherSuccessEvent EventType = "Weather success"
 	weatherErrorEvent   EventType = "Weather error"
+	weatherAlertEvent   EventType = "Weather alert"
 )
 
 func NewWeatherSuccess(info *dto.WeatherMailSuccessInfo) (*Event, error) {
@@ -32,11 +35,31 @@ func NewWeatherSuccess(info *dto.WeatherMailSuccessInfo) (*Event, error) {
 		Email: info.Email,
 		City:  info.City,
 		Weather: &protoevents.Weather{
-			Temperature: info.Weather.Temperature,
-			Humidity:    int32(info.Weather.Humidity),
-			Description: info.Weather.Description,
+			Temperature:   info.Weather.Temperature,
+			Humidity:      int32(info.Weather.Humidity),
+			Description:   info.Weather.Description,
+			WindSpeed:     info.Weather.WindSpeed,
+			WindDirection: int32(info.Weather.WindDirection),
+			Pressure:      info.Weather.Pressure,
+			CloudCover:    int32(info.Weather.CloudCover),
+			Visibility:    info.Weather.Visibility,
+			FeelsLike:     info.Weather.FeelsLike,
+			HeatIndex:     info.Weather.HeatIndex,
+			WindChill:     info.Weather.WindChill,
+			DewPoint:      info.Weather.DewPoint,
+			Stale:         info.Weather.Stale,
 		},
 	}
+	if info.AirQuality != nil {
+		e.AirQuality = &protoevents.AirQuality{
+			Aqi:      int32(info.AirQuality.AQI),
+			Category: info.AirQuality.Category,
+			Pm2_5:    info.AirQuality.PM25,
+			Pm10:     info.AirQuality.PM10,
+			O3:       info.AirQuality.O3,
+			No2:      info.AirQuality.NO2,
+		}
+	}
 	body, err := proto.Marshal(e)
 
 	if err != nil {
@@ -66,6 +89,40 @@ func NewWeatherError(info *dto.WeatherMailErrorInfo) (*Event, error) {
 	}, nil
 }
 
+func NewWeatherAlert(info *dto.WeatherAlertInfo) (*Event, error) {
+	e := &protoevents.WeatherAlertEvent{
+		Email: info.Email,
+		City:  info.City,
+		Alert: &protoevents.Alert{
+			Id:          info.Alert.ID,
+			Event:       info.Alert.Event,
+			Severity:    info.Alert.Severity,
+			Headline:    info.Alert.Headline,
+			Description: info.Alert.Description,
+			StartsAt:    unixOrZero(info.Alert.Start),
+			EndsAt:      unixOrZero(info.Alert.End),
+		},
+	}
+	body, err := proto.Marshal(e)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &Event{
+		Type: weatherAlertEvent,
+		Body: body,
+	}, nil
+}
+
+func unixOrZero(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+
+	return t.Unix()
+}
+
 func (e *Event) RoutingKey() (string, error) {
 
 	switch e.Type {
@@ -73,6 +130,8 @@ func (e *Event) RoutingKey() (string, error) {
 		return weatherSuccessRoute, nil
 	case weatherErrorEvent:
 		return weatherErrorRoute, nil
+	case weatherAlertEvent:
+		return weatherAlertRoute, nil
 	default:
 		return "", errors.New("unknown route key")
 	}
@@ -1,6 +1,8 @@
 package mappers
 
 import (
+	"fmt"
+	"time"
 	"weather-broadcast-service/internal/dto"
 	"weather-broadcast-service/internal/models"
 	"weather-forecast/pkg/proto/subscription"
@@ -13,6 +15,8 @@ func MapFrequencyToProto(freq models.Frequency) subscription.Frequency {
 		return subscription.Frequency_DAILY
 	case models.Hourly:
 		return subscription.Frequency_HOURLY
+	case models.Alerts:
+		return subscription.Frequency_ALERTS
 	default:
 		return subscription.Frequency_DAILY
 	}
@@ -26,8 +30,9 @@ func MapProtoToSubscriptionList(protoList *subscription.GetSubscriptionsByFreque
 
 	for _, protoSubsc := range protoList.Subscriptions {
 		subsc := dto.Subscription{
-			Email: protoSubsc.Email,
-			City:  protoSubsc.City,
+			Email:             protoSubsc.Email,
+			City:              protoSubsc.City,
+			IncludeAirQuality: protoSubsc.IncludeAirQuality,
 		}
 		res.Subscriptions = append(res.Subscriptions, subsc)
 
@@ -38,8 +43,80 @@ func MapProtoToSubscriptionList(protoList *subscription.GetSubscriptionsByFreque
 
 func MapProtoToWeatherDTO(weatherResponse *weather.GetWeatherResponse) *dto.Weather {
 	return &dto.Weather{
-		Temperature: weatherResponse.Temperature,
-		Humidity:    int(weatherResponse.Humidity),
-		Description: weatherResponse.Description,
+		Temperature:   weatherResponse.Temperature,
+		Humidity:      int(weatherResponse.Humidity),
+		Description:   weatherResponse.Description,
+		WindSpeed:     weatherResponse.WindSpeed,
+		WindDirection: int(weatherResponse.WindDirection),
+		Pressure:      weatherResponse.Pressure,
+		CloudCover:    int(weatherResponse.CloudCover),
+		Visibility:    weatherResponse.Visibility,
+		FeelsLike:     weatherResponse.FeelsLike,
+		HeatIndex:     weatherResponse.HeatIndex,
+		WindChill:     weatherResponse.WindChill,
+		DewPoint:      weatherResponse.DewPoint,
+		Stale:         weatherResponse.Stale,
 	}
 }
+
+func MapProtoToAirQualityDTO(airQualityResponse *weather.GetAirQualityResponse) *dto.AirQuality {
+	return &dto.AirQuality{
+		AQI:      int(airQualityResponse.Aqi),
+		Category: airQualityResponse.Category,
+		PM25:     airQualityResponse.Pm2_5,
+		PM10:     airQualityResponse.Pm10,
+		O3:       airQualityResponse.O3,
+		NO2:      airQualityResponse.No2,
+	}
+}
+
+func MapProtoToCityWeatherList(batchResponse *weather.GetWeatherBatchResponse) []dto.CityWeather {
+	res := make([]dto.CityWeather, 0, len(batchResponse.Results))
+
+	for _, result := range batchResponse.Results {
+		cityWeather := dto.CityWeather{City: result.City}
+
+		if protoWeather := result.GetWeather(); protoWeather != nil {
+			cityWeather.Weather = MapProtoToWeatherDTO(protoWeather)
+		} else {
+			cityWeather.Err = fmt.Errorf("code %d: %s", result.GetError().GetCode(), result.GetError().GetMessage())
+		}
+
+		res = append(res, cityWeather)
+	}
+
+	return res
+}
+
+func MapProtoToPrefetchDTO(prefetchResponse *weather.PrefetchWeatherResponse) *dto.Prefetch {
+	return &dto.Prefetch{
+		Warmed: int(prefetchResponse.Warmed),
+		Failed: prefetchResponse.Failed,
+	}
+}
+
+func MapProtoToAlertList(alertsResponse *weather.GetAlertsResponse) []dto.Alert {
+	res := make([]dto.Alert, 0, len(alertsResponse.Alerts))
+
+	for _, protoAlert := range alertsResponse.Alerts {
+		res = append(res, dto.Alert{
+			ID:          protoAlert.Id,
+			Event:       protoAlert.Event,
+			Severity:    protoAlert.Severity,
+			Headline:    protoAlert.Headline,
+			Description: protoAlert.Description,
+			Start:       unixToTime(protoAlert.StartsAt),
+			End:         unixToTime(protoAlert.EndsAt),
+		})
+	}
+
+	return res
+}
+
+func unixToTime(seconds int64) time.Time {
+	if seconds == 0 {
+		return time.Time{}
+	}
+
+	return time.Unix(seconds, 0).UTC()
+}
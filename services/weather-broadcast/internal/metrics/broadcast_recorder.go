@@ -4,4 +4,5 @@ import "time"
 
 type BroadcastRecorder interface {
 	RecordBroadcastDuration(frequency string, duration time.Duration)
+	RecordWarmUpDuration(frequency string, duration time.Duration)
 }
@@ -12,6 +12,7 @@ import (
 
 type Prometheus struct {
 	broadcastDuration *prometheus.HistogramVec
+	warmUpDuration    *prometheus.HistogramVec
 	logger            logger.Logger
 }
 
@@ -25,10 +26,18 @@ func NewPrometheus(logger logger.Logger) *Prometheus {
 			},
 			[]string{"frequency"},
 		),
+		warmUpDuration: prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Name:    "weather_broadcast_warmup_duration_seconds",
+				Help:    "Time taken to prefetch weather before a broadcast",
+				Buckets: []float64{1, 5, 10, 30, 60, 120, 300, 600},
+			},
+			[]string{"frequency"},
+		),
 		logger: logger,
 	}
 
-	prometheus.MustRegister(p.broadcastDuration)
+	prometheus.MustRegister(p.broadcastDuration, p.warmUpDuration)
 
 	return p
 }
@@ -47,3 +56,7 @@ func (m *Prometheus) StartMetricsServer(port string) {
 func (p *Prometheus) RecordBroadcastDuration(frequency string, duration time.Duration) {
 	p.broadcastDuration.WithLabelValues(frequency).Observe(duration.Seconds())
 }
+
+func (p *Prometheus) RecordWarmUpDuration(frequency string, duration time.Duration) {
+	p.warmUpDuration.WithLabelValues(frequency).Observe(duration.Seconds())
+}
@@ -5,4 +5,5 @@ type Frequency string
 const (
 	Daily  Frequency = "daily"
 	Hourly Frequency = "hourly"
+	Alerts Frequency = "alerts"
 )
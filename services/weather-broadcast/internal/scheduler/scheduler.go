@@ -10,27 +10,39 @@ import (
 	"github.com/robfig/cron/v3"
 )
 
-const DAILY = "0 12 * * * " //every day at 12 am
-const HOURLY = "0 * * * * " //every hour at 0 minute
+const DAILY = "0 12 * * * "   //every day at 12 am
+const HOURLY = "0 * * * * "   //every hour at 0 minute
+const ALERTS = "*/15 * * * *" //every 15 minutes
 
 type (
 	WeatherBroadcastService interface {
 		Broadcast(ctx context.Context, frequency models.Frequency)
+		WarmUp(ctx context.Context, frequency models.Frequency, broadcastAt time.Time)
 	}
 
 	Scheduler struct {
 		cron             cron.Cron
 		broadcastService WeatherBroadcastService
+		location         *time.Location
+		warmUpLead       time.Duration
 		wg               *sync.WaitGroup
 		logger           logger.Logger
 		ctx              context.Context
 	}
+
+	// leadSchedule fires lead before every activation of schedule.
+	leadSchedule struct {
+		schedule cron.Schedule
+		lead     time.Duration
+	}
 )
 
-func New(ctx context.Context, notificationService WeatherBroadcastService, location *time.Location, logger logger.Logger) *Scheduler {
+func New(ctx context.Context, notificationService WeatherBroadcastService, location *time.Location, warmUpLead time.Duration, logger logger.Logger) *Scheduler {
 	return &Scheduler{
 		cron:             *cron.New(cron.WithLocation(location)),
 		broadcastService: notificationService,
+		location:         location,
+		warmUpLead:       warmUpLead,
 		wg:               &sync.WaitGroup{},
 		logger:           logger,
 		ctx:              ctx,
@@ -38,9 +50,13 @@ func New(ctx context.Context, notificationService WeatherBroadcastService, locat
 
 }
 
+func (s leadSchedule) Next(t time.Time) time.Time {
+	return s.schedule.Next(t.Add(s.lead)).Add(-s.lead)
+}
+
 func (s *Scheduler) SetUp() {
 
-	s.logger.Infof("Setting up scheduler with daily and hourly broadcasts")
+	s.logger.Infof("Setting up scheduler with daily, hourly and alerts broadcasts")
 
 	_, err := s.cron.AddFunc(DAILY, func() {
 		s.wg.Add(1)
@@ -64,11 +80,60 @@ func (s *Scheduler) SetUp() {
 		s.logger.Fatalf("Failed to setup hourly sender: %s", err.Error())
 		return
 	}
+	_, err = s.cron.AddFunc(ALERTS, func() {
+		s.wg.Add(1)
+		defer s.wg.Done()
+
+		s.logger.Infof("Alerts broadcast triggered")
+		s.broadcastService.Broadcast(s.ctx, models.Alerts)
+	})
+	if err != nil {
+		s.logger.Fatalf("Failed to setup alerts sender: %s", err.Error())
+		return
+	}
+
+	if s.warmUpLead > 0 {
+		s.logger.Infof("Setting up cache warm-ups %v before daily and hourly broadcasts", s.warmUpLead)
+
+		if err := s.scheduleWarmUp(DAILY, models.Daily); err != nil {
+			s.logger.Fatalf("Failed to setup daily warm-up: %s", err.Error())
+			return
+		}
+		if err := s.scheduleWarmUp(HOURLY, models.Hourly); err != nil {
+			s.logger.Fatalf("Failed to setup hourly warm-up: %s", err.Error())
+			return
+		}
+	}
 
 	s.logger.Infof("Scheduler setup completed successfully")
 
 }
 
+// scheduleWarmUp warms the cache up warmUpLead before the broadcasts of spec.
+// A warm-up still running when its broadcast starts is cancelled.
+func (s *Scheduler) scheduleWarmUp(spec string, frequency models.Frequency) error {
+	schedule, err := cron.ParseStandard(spec)
+	if err != nil {
+		return err
+	}
+
+	s.cron.Schedule(leadSchedule{schedule: schedule, lead: s.warmUpLead}, cron.FuncJob(func() {
+		s.wg.Add(1)
+		defer s.wg.Done()
+
+		s.logger.Infof("Warm-up for %s broadcast triggered", frequency)
+
+		// The schedule is evaluated in the zone of the time it is given, which
+		// has to be the zone the cron runs in.
+		broadcastAt := schedule.Next(time.Now().In(s.location))
+		ctx, cancel := context.WithDeadline(s.ctx, broadcastAt)
+		defer cancel()
+		s.broadcastService.WarmUp(ctx, frequency, broadcastAt)
+	}))
+
+	return nil
+}
+
 func (s *Scheduler) Run() {
 	s.logger.Infof("Starting scheduler")
 
@@ -79,3 +79,29 @@ func (s *EventSender) SendError(ctx context.Context, info *dto.WeatherMailErrorI
 	log.Debugf("Error Weather event published successfully: email=%s", info.Email)
 
 }
+
+func (s *EventSender) SendAlert(ctx context.Context, info *dto.WeatherAlertInfo) {
+	log := s.logger.WithContext(ctx)
+
+	log.Debugf("Creating weather alert event: email=%s, city=%s, alert=%s", info.Email, info.City, info.Alert.ID)
+	event, err := events.NewWeatherAlert(info)
+	if err != nil {
+		log.Errorf("Failed to create weather alert event for email %s: %v", info.Email, err)
+		return
+	}
+
+	routingKey, err := event.RoutingKey()
+	if err != nil {
+		log.Errorf("Failed to get weather alert event routing key for email %s: %v", info.Email, err)
+		return
+	}
+
+	log.Infof("Publishing weather alert event: email=%s, city=%s, alert=%s", info.Email, info.City, info.Alert.ID)
+	err = s.publisher.Publish(ctx, routingKey, event.Body)
+	if err != nil {
+		log.Errorf("Failed to publish weather alert event for email %s: %v", info.Email, err)
+		return
+	}
+
+	log.Debugf("Weather alert event published successfully: email=%s", info.Email)
+}
@@ -0,0 +1,142 @@
+package services
+
+import (
+	"context"
+	"sync"
+	"time"
+	"weather-broadcast-service/internal/dto"
+	"weather-broadcast-service/internal/models"
+	"weather-forecast/pkg/logger"
+)
+
+type (
+	SeenAlertStore interface {
+		IsSeen(ctx context.Context, city, alertID string) (bool, error)
+		MarkSeen(ctx context.Context, city, alertID string, end time.Time) error
+	}
+
+	// alertTracker remembers the alerts already announced per city, so that
+	// subscribers get one email when an alert appears and not on every poll.
+	// The store keeps them across restarts, the memory answers while the store
+	// is unavailable.
+	alertTracker struct {
+		store SeenAlertStore
+		mu    sync.Mutex
+		seen  map[string]map[string]struct{}
+	}
+)
+
+func newAlertTracker(store SeenAlertStore) *alertTracker {
+	return &alertTracker{
+		store: store,
+		seen:  make(map[string]map[string]struct{}),
+	}
+}
+
+// update replaces the known alerts of the city with the current ones and
+// returns those that were not announced before. Alerts that are no longer
+// current are forgotten in memory, the store forgets them when they end.
+func (t *alertTracker) update(ctx context.Context, log logger.Logger, city string, alerts []dto.Alert) []dto.Alert {
+	t.mu.Lock()
+	previous := t.seen[city]
+	t.mu.Unlock()
+
+	current := make(map[string]struct{}, len(alerts))
+
+	var fresh []dto.Alert
+	for _, alert := range alerts {
+		current[alert.ID] = struct{}{}
+		if _, ok := previous[alert.ID]; ok {
+			continue
+		}
+
+		seen, err := t.store.IsSeen(ctx, city, alert.ID)
+		if err != nil {
+			log.Warnf("Failed to check alert %s for city %s: %v", alert.ID, city, err)
+		}
+		if seen {
+			continue
+		}
+
+		fresh = append(fresh, alert)
+		if err := t.store.MarkSeen(ctx, city, alert.ID, alert.End); err != nil {
+			log.Warnf("Failed to store alert %s for city %s: %v", alert.ID, city, err)
+		}
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if len(current) == 0 {
+		delete(t.seen, city)
+	} else {
+		t.seen[city] = current
+	}
+
+	return fresh
+}
+
+func (s *WeatherBroadcastService) broadcastAlerts(ctx context.Context) {
+	log := s.logger.WithContext(ctx)
+
+	citySubscribers := make(map[string][]string)
+
+	lastID := 0
+	for {
+		query := dto.ListSubscriptionsQuery{
+			Frequency: models.Alerts,
+			LastID:    lastID,
+			PageSize:  PAGE_SIZE,
+		}
+
+		log.Debugf("Getting alert subscription list batch from index %d with page size=%d", query.LastID, query.PageSize)
+		res, err := s.subscriptionClient.ListByFrequency(ctx, query)
+		if err != nil {
+			log.Errorf("Failed to fetch subscriptions for alerts broadcast: %v", err)
+			break
+		}
+
+		if len(res.Subscriptions) == 0 {
+			break
+		}
+		lastID = res.LastIndex
+
+		for _, subscription := range res.Subscriptions {
+			citySubscribers[subscription.City] = append(citySubscribers[subscription.City], subscription.Email)
+		}
+	}
+
+	log.Debugf("Polling alerts for %d cities", len(citySubscribers))
+
+	sem := make(chan struct{}, WORKER_AMOUNT)
+	wg := &sync.WaitGroup{}
+
+	for city, emails := range citySubscribers {
+		sem <- struct{}{}
+		wg.Add(1)
+
+		go func(city string, emails []string) {
+			defer func() { <-sem }()
+			defer wg.Done()
+
+			alerts, err := s.weatherClient.GetAlerts(ctx, city)
+			if err != nil {
+				log.Warnf("Failed to get alerts for city %s: %v", city, err)
+				return
+			}
+
+			for _, alert := range s.alerts.update(ctx, log, city, alerts) {
+				log.Infof("New alert %s for city %s, notifying %d subscribers", alert.ID, city, len(emails))
+				for _, email := range emails {
+					s.weatherMailer.SendAlert(ctx, &dto.WeatherAlertInfo{
+						Email: email,
+						City:  city,
+						Alert: alert,
+					})
+				}
+			}
+		}(city, emails)
+	}
+
+	wg.Wait()
+}
@@ -3,19 +3,24 @@ package services
 import (
 	"context"
 	"sync"
+	"time"
 	"weather-broadcast-service/internal/dto"
 	"weather-broadcast-service/internal/models"
 	"weather-forecast/pkg/logger"
 )
 
 const (
-	PAGE_SIZE     = 100
-	WORKER_AMOUNT = 10
+	PAGE_SIZE          = 100
+	WORKER_AMOUNT      = 10
+	WEATHER_BATCH_SIZE = 50
 )
 
 type (
 	WeatherClient interface {
-		GetWeatherByCity(ctx context.Context, city string) (*dto.Weather, error)
+		GetWeatherBatch(ctx context.Context, cities []string) ([]dto.CityWeather, error)
+		GetAlerts(ctx context.Context, city string) ([]dto.Alert, error)
+		GetAirQuality(ctx context.Context, city string) (*dto.AirQuality, error)
+		PrefetchWeather(ctx context.Context, cities []string, airQualityCities []string, freshUntil time.Time) (*dto.Prefetch, error)
 	}
 
 	SubscriptionClient interface {
@@ -25,21 +30,24 @@ type (
 	WeatherMailer interface {
 		SendWeather(ctx context.Context, info *dto.WeatherMailSuccessInfo)
 		SendError(ctx context.Context, info *dto.WeatherMailErrorInfo)
+		SendAlert(ctx context.Context, info *dto.WeatherAlertInfo)
 	}
 
 	WeatherBroadcastService struct {
 		subscriptionClient SubscriptionClient
 		weatherClient      WeatherClient
 		weatherMailer      WeatherMailer
+		alerts             *alertTracker
 		logger             logger.Logger
 	}
 )
 
-func NewWeatherBroadcastService(subscriptionClient SubscriptionClient, weatherClient WeatherClient, weatherMailer WeatherMailer, logger logger.Logger) *WeatherBroadcastService {
+func NewWeatherBroadcastService(subscriptionClient SubscriptionClient, weatherClient WeatherClient, weatherMailer WeatherMailer, seenAlerts SeenAlertStore, logger logger.Logger) *WeatherBroadcastService {
 	return &WeatherBroadcastService{
 		subscriptionClient: subscriptionClient,
 		weatherClient:      weatherClient,
 		weatherMailer:      weatherMailer,
+		alerts:             newAlertTracker(seenAlerts),
 		logger:             logger,
 	}
 }
@@ -49,7 +57,13 @@ func (s *WeatherBroadcastService) Broadcast(ctx context.Context, frequency model
 
 	log.Debugf("Starting broadcast process for %s subscription", frequency)
 
+	if frequency == models.Alerts {
+		s.broadcastAlerts(ctx)
+		return
+	}
+
 	cityWeatherMap := make(map[string]*dto.Weather)
+	cityAirQualityMap := make(map[string]*dto.AirQuality)
 	sem := make(chan struct{}, WORKER_AMOUNT)
 	wg := &sync.WaitGroup{}
 
@@ -77,28 +91,14 @@ func (s *WeatherBroadcastService) Broadcast(ctx context.Context, frequency model
 			break
 		}
 
-		for _, subscription := range subscriptions {
-
-			if _, ok := cityWeatherMap[subscription.City]; !ok {
-				log.Debugf("Getting weather for new city: %s", subscription.City)
-
-				weather, err := s.weatherClient.GetWeatherByCity(ctx, subscription.City)
-				log.Infof("Weather: %v", weather)
-
-				if err != nil {
-					log.Warnf("Failed to get weather for city %s: %v", subscription.City, err)
-					cityWeatherMap[subscription.City] = nil
-				} else {
-					log.Debugf("Weather fetched successfully for city: %s", subscription.City)
-					cityWeatherMap[subscription.City] = weather
-				}
-
-			}
+		s.fetchNewCities(ctx, subscriptions, cityWeatherMap)
+		s.fetchAirQuality(ctx, subscriptions, cityAirQualityMap)
 
+		for _, subscription := range subscriptions {
 			sem <- struct{}{}
 			wg.Add(1)
 
-			go func(sub dto.Subscription, weather *dto.Weather) {
+			go func(sub dto.Subscription, weather *dto.Weather, airQuality *dto.AirQuality) {
 				defer func() { <-sem }()
 				defer wg.Done()
 				if weather != nil {
@@ -108,6 +108,9 @@ func (s *WeatherBroadcastService) Broadcast(ctx context.Context, frequency model
 						City:    sub.City,
 						Weather: *weather,
 					}
+					if sub.IncludeAirQuality {
+						info.AirQuality = airQuality
+					}
 
 					s.weatherMailer.SendWeather(ctx, info)
 				} else {
@@ -119,8 +122,160 @@ func (s *WeatherBroadcastService) Broadcast(ctx context.Context, frequency model
 
 					s.weatherMailer.SendError(ctx, info)
 				}
-			}(subscription, cityWeatherMap[subscription.City])
+			}(subscription, cityWeatherMap[subscription.City], cityAirQualityMap[subscription.City])
 		}
 	}
 	wg.Wait()
 }
+
+// WarmUp prefetches the weather of every city subscribed with frequency, and
+// the air quality where subscribers opted in for it, so that the following
+// broadcast, at broadcastAt, is served from the weather service cache.
+func (s *WeatherBroadcastService) WarmUp(ctx context.Context, frequency models.Frequency, broadcastAt time.Time) {
+	log := s.logger.WithContext(ctx)
+
+	if frequency == models.Alerts {
+		return
+	}
+
+	log.Debugf("Starting cache warm-up for %s subscription", frequency)
+
+	var cities, airQualityCities []string
+	seen := make(map[string]bool)
+	seenAirQuality := make(map[string]bool)
+
+	lastID := 0
+	for {
+		query := dto.ListSubscriptionsQuery{
+			Frequency: frequency,
+			LastID:    lastID,
+			PageSize:  PAGE_SIZE,
+		}
+
+		res, err := s.subscriptionClient.ListByFrequency(ctx, query)
+		if err != nil {
+			log.Errorf("Failed to fetch subscriptions for %s warm-up: %v", frequency, err)
+			break
+		}
+
+		if len(res.Subscriptions) == 0 {
+			break
+		}
+		lastID = res.LastIndex
+
+		for _, subscription := range res.Subscriptions {
+			if !seen[subscription.City] {
+				seen[subscription.City] = true
+				cities = append(cities, subscription.City)
+			}
+			if subscription.IncludeAirQuality && !seenAirQuality[subscription.City] {
+				seenAirQuality[subscription.City] = true
+				airQualityCities = append(airQualityCities, subscription.City)
+			}
+		}
+	}
+
+	warmed, failed := 0, 0
+	for start := 0; start < max(len(cities), len(airQualityCities)); start += WEATHER_BATCH_SIZE {
+		batch := cities[min(start, len(cities)):min(start+WEATHER_BATCH_SIZE, len(cities))]
+		airQualityBatch := airQualityCities[min(start, len(airQualityCities)):min(start+WEATHER_BATCH_SIZE, len(airQualityCities))]
+
+		prefetch, err := s.weatherClient.PrefetchWeather(ctx, batch, airQualityBatch, broadcastAt)
+		if err != nil {
+			log.Warnf("Failed to prefetch weather for %d cities: %v", len(batch), err)
+			failed += len(batch) + len(airQualityBatch)
+			continue
+		}
+
+		if len(prefetch.Failed) > 0 {
+			log.Warnf("Failed to prefetch weather for cities: %v", prefetch.Failed)
+		}
+		warmed += prefetch.Warmed
+		failed += len(batch) + len(airQualityBatch) - prefetch.Warmed
+	}
+
+	log.Infof("Cache warm-up for %s subscription finished: cities=%d, air_quality_cities=%d, warmed=%d, failed=%d", frequency, len(cities), len(airQualityCities), warmed, failed)
+}
+
+func (s *WeatherBroadcastService) fetchNewCities(ctx context.Context, subscriptions []dto.Subscription, cityWeatherMap map[string]*dto.Weather) {
+	log := s.logger.WithContext(ctx)
+
+	var newCities []string
+	for _, subscription := range subscriptions {
+		if _, ok := cityWeatherMap[subscription.City]; !ok {
+			cityWeatherMap[subscription.City] = nil
+			newCities = append(newCities, subscription.City)
+		}
+	}
+
+	for start := 0; start < len(newCities); start += WEATHER_BATCH_SIZE {
+		cities := newCities[start:min(start+WEATHER_BATCH_SIZE, len(newCities))]
+
+		log.Debugf("Getting weather for %d new cities", len(cities))
+		results, err := s.weatherClient.GetWeatherBatch(ctx, cities)
+		if err != nil {
+			log.Warnf("Failed to get weather for %d cities: %v", len(cities), err)
+			continue
+		}
+
+		for _, result := range results {
+			if result.Err != nil {
+				log.Warnf("Failed to get weather for city %s: %v", result.City, result.Err)
+				continue
+			}
+
+			log.Debugf("Weather fetched successfully for city: %s", result.City)
+			cityWeatherMap[result.City] = result.Weather
+		}
+	}
+}
+
+// fetchAirQuality gets the air quality of the cities whose subscribers opted
+// in for it. A city that failed is not retried within the broadcast, its
+// subscribers get the weather without air quality.
+func (s *WeatherBroadcastService) fetchAirQuality(ctx context.Context, subscriptions []dto.Subscription, cityAirQualityMap map[string]*dto.AirQuality) {
+	log := s.logger.WithContext(ctx)
+
+	var newCities []string
+	for _, subscription := range subscriptions {
+		if !subscription.IncludeAirQuality {
+			continue
+		}
+		if _, ok := cityAirQualityMap[subscription.City]; !ok {
+			cityAirQualityMap[subscription.City] = nil
+			newCities = append(newCities, subscription.City)
+		}
+	}
+
+	if len(newCities) == 0 {
+		return
+	}
+
+	log.Debugf("Getting air quality for %d new cities", len(newCities))
+
+	mu := &sync.Mutex{}
+	sem := make(chan struct{}, WORKER_AMOUNT)
+	wg := &sync.WaitGroup{}
+
+	for _, city := range newCities {
+		sem <- struct{}{}
+		wg.Add(1)
+
+		go func(city string) {
+			defer func() { <-sem }()
+			defer wg.Done()
+
+			airQuality, err := s.weatherClient.GetAirQuality(ctx, city)
+			if err != nil {
+				log.Warnf("Failed to get air quality for city %s: %v", city, err)
+				return
+			}
+
+			mu.Lock()
+			cityAirQualityMap[city] = airQuality
+			mu.Unlock()
+		}(city)
+	}
+
+	wg.Wait()
+}
@@ -0,0 +1,78 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	RedisTimeout = 5 * time.Second
+
+	// alertFallbackTTL keeps an alert without an end time for a day.
+	alertFallbackTTL = 24 * time.Hour
+)
+
+type (
+	// SeenAlerts keeps the IDs of the alerts already announced in Redis until
+	// the alerts end, so that they outlive restarts of the service.
+	SeenAlerts struct {
+		client *redis.Client
+	}
+)
+
+func NewSeenAlerts(url string) (*SeenAlerts, error) {
+	opt, err := redis.ParseURL(url)
+	if err != nil {
+		return nil, err
+	}
+
+	client := redis.NewClient(opt)
+
+	ctx, cancel := context.WithTimeout(context.Background(), RedisTimeout)
+	defer cancel()
+
+	if err := client.Ping(ctx).Err(); err != nil {
+		return nil, err
+	}
+
+	return &SeenAlerts{
+		client: client,
+	}, nil
+}
+
+func (s *SeenAlerts) IsSeen(ctx context.Context, city, alertID string) (bool, error) {
+	err := s.client.Get(ctx, seenAlertKey(city, alertID)).Err()
+	if errors.Is(err, redis.Nil) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (s *SeenAlerts) MarkSeen(ctx context.Context, city, alertID string, end time.Time) error {
+	ttl := time.Until(end)
+	if end.IsZero() {
+		ttl = alertFallbackTTL
+	}
+	if ttl <= 0 {
+		return nil
+	}
+
+	return s.client.Set(ctx, seenAlertKey(city, alertID), 1, ttl).Err()
+}
+
+func (s *SeenAlerts) Close() error {
+	return s.client.Close()
+}
+
+func seenAlertKey(city, alertID string) string {
+	return fmt.Sprintf("alerts:seen:%s:%s", strings.ToLower(strings.TrimSpace(city)), alertID)
+}
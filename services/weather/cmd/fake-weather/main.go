@@ -0,0 +1,58 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"log"
+	"net/http"
+	"os"
+	"weather-forecast/pkg/logger"
+	"weather-service/tests/fakeweather"
+)
+
+func main() {
+	addr := flag.String("addr", ":8089", "address to listen on")
+	fixturesDir := flag.String("fixtures", "./tests/fakeweather/fixtures", "directory with recorded provider responses")
+	scriptPath := flag.String("script", "", "JSON file with a list of scripted failures")
+	record := flag.Bool("record", false, "forward requests to the real providers and save responses as fixtures")
+	logLevel := flag.String("log-level", "info", "log level")
+	flag.Parse()
+
+	logrusLog, err := logger.NewLogrus("fake-weather", *logLevel, logger.NewRateSampler(1))
+	if err != nil {
+		log.Fatalf("Failed to initialize logger with level '%s': %v", *logLevel, err)
+	}
+
+	script := fakeweather.NewScript()
+	if *scriptPath != "" {
+		data, err := os.ReadFile(*scriptPath)
+		if err != nil {
+			logrusLog.Fatalf("Read script: %s", err.Error())
+		}
+
+		var failures []fakeweather.Failure
+		if err := json.Unmarshal(data, &failures); err != nil {
+			logrusLog.Fatalf("Parse script: %s", err.Error())
+		}
+		script.Add(failures...)
+	}
+
+	server := fakeweather.NewServer(fakeweather.NewFixtures(*fixturesDir), script, logrusLog)
+
+	if *record {
+		server.Record(fakeweather.WeatherAPI, fakeweather.Upstream{
+			BaseURL: fakeweather.WeatherAPIUpstream,
+			Key:     os.Getenv("WEATHER_API_KEY"),
+		})
+		server.Record(fakeweather.OpenWeather, fakeweather.Upstream{
+			BaseURL: fakeweather.OpenWeatherUpstream,
+			Key:     os.Getenv("OPEN_WEATHER_KEY"),
+		})
+		logrusLog.Infof("Recording provider responses into %s", *fixturesDir)
+	}
+
+	logrusLog.Infof("Starting fake weather providers on %s", *addr)
+	if err := http.ListenAndServe(*addr, server); err != nil {
+		logrusLog.Fatalf("Fake weather server: %s", err.Error())
+	}
+}
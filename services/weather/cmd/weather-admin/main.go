@@ -0,0 +1,117 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+	"weather-forecast/pkg/proto/weather"
+	"weather-service/internal/presentation/server"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+)
+
+const usage = `Usage: weather-admin [flags] <command> [command flags]
+
+Commands:
+  list        list cached entries, of one city with -city
+  invalidate  remove cached entries of -city, or of every city with -all
+  refresh     fetch the cached entries of -city again
+
+Flags:
+`
+
+func main() {
+	addr := flag.String("addr", "localhost:50051", "address of the weather service")
+	token := flag.String("token", os.Getenv("WEATHER_ADMIN_TOKEN"), "admin token, WEATHER_ADMIN_TOKEN by default")
+	timeout := flag.Duration("timeout", 30*time.Second, "timeout of the call")
+	flag.Usage = func() {
+		fmt.Fprint(flag.CommandLine.Output(), usage)
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	command := flag.Arg(0)
+	commandFlags := flag.NewFlagSet(command, flag.ExitOnError)
+	city := commandFlags.String("city", "", "city name")
+	all := commandFlags.Bool("all", false, "every city, for invalidate")
+	_ = commandFlags.Parse(flag.Args()[1:])
+
+	conn, err := grpc.NewClient(*addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		fail("Connect to weather service: %v", err)
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			fmt.Fprintf(os.Stderr, "Close connection: %v\n", err)
+		}
+	}()
+
+	client := weather.NewWeatherAdminServiceClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+	ctx = metadata.AppendToOutgoingContext(ctx, server.AuthorizationHeader, server.BearerPrefix+*token)
+
+	switch command {
+	case "list":
+		resp, err := client.ListCache(ctx, &weather.ListCacheRequest{City: *city})
+		if err != nil {
+			fail("List cache: %v", err)
+		}
+		printEntries(resp.Entries)
+
+	case "invalidate":
+		resp, err := client.InvalidateCache(ctx, &weather.InvalidateCacheRequest{City: *city, All: *all})
+		if err != nil {
+			fail("Invalidate cache: %v", err)
+		}
+		fmt.Printf("Removed %d entries\n", resp.Removed)
+
+	case "refresh":
+		resp, err := client.RefreshCache(ctx, &weather.RefreshCacheRequest{City: *city})
+		if err != nil {
+			fail("Refresh cache: %v", err)
+		}
+		printEntries(resp.Entries)
+
+	default:
+		fmt.Fprintf(os.Stderr, "Unknown command %q\n\n", command)
+		flag.Usage()
+		os.Exit(2)
+	}
+}
+
+func printEntries(entries []*weather.CacheEntry) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "KEY\tCITY\tSOURCE\tAGE\tSTATE")
+
+	for _, entry := range entries {
+		state := "fresh"
+		if entry.Stale {
+			state = "stale"
+		}
+		source := entry.Source
+		if source == "" {
+			source = "-"
+		}
+		age := (time.Duration(entry.AgeSeconds) * time.Second).String()
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", entry.Key, entry.LocationId, source, age, state)
+	}
+
+	_ = w.Flush()
+}
+
+func fail(format string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, format+"\n", args...)
+	os.Exit(1)
+}
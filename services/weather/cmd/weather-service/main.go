@@ -1,8 +1,8 @@
 package main
 
 import (
+	"context"
 	"log"
-	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
@@ -11,12 +11,13 @@ import (
 	"weather-service/internal/config"
 	"weather-service/internal/domain/usecases"
 	"weather-service/internal/infrastructure/cache"
-	"weather-service/internal/infrastructure/clients/openweather"
-	"weather-service/internal/infrastructure/clients/weatherapi"
+	"weather-service/internal/infrastructure/clients/openmeteo"
+	"weather-service/internal/infrastructure/history"
+	"weather-service/internal/infrastructure/locations"
 	"weather-service/internal/infrastructure/metrics"
 
 	"weather-service/internal/infrastructure/providers"
-	"weather-service/internal/infrastructure/providers/roundtrip"
+	"weather-service/internal/infrastructure/roundtrip"
 	"weather-service/internal/presentation/server"
 	"weather-service/internal/presentation/server/handlers"
 )
@@ -40,36 +41,60 @@ func main() {
 		logrusLog.Fatalf("Connect to redis: %s", err.Error())
 	}
 
-	providerRoundTrip := roundtrip.New(logrusLog)
+	cacheCtx, stopCache := context.WithCancel(context.Background())
 
-	client := http.Client{
-		Timeout:   time.Second * 5,
-		Transport: providerRoundTrip,
+	var weatherCache providers.Cacher = redisCache
+	var cacheStore providers.CacheStore = redisCache
+	if cfg.MemoryCacheSize > 0 {
+		twoTierCache := cache.NewTwoTier(cache.NewLRU(cfg.MemoryCacheSize), redisCache, redisCache, prometheusMetrics, time.Duration(cfg.MemoryCacheTTL)*time.Second, logrusLog)
+		go twoTierCache.Run(cacheCtx)
+		weatherCache = twoTierCache
+		cacheStore = twoTierCache
 	}
 
-	weatherAPIClient := weatherapi.NewClient(cfg, &client, logrusLog)
-	weatherAPIProvider := providers.NewWeatherAPIProvider(weatherAPIClient, logrusLog)
-	cacheableWeatherAPIProvider := providers.NewCacheDecorator(weatherAPIProvider, redisCache, prometheusMetrics, logrusLog)
+	providerTransport := roundtrip.NewTransport(roundtrip.PoolSettings{
+		MaxIdleConnsPerHost: cfg.ProviderMaxIdleConnsPerHost,
+		MaxConnsPerHost:     cfg.ProviderMaxConnsPerHost,
+		IdleConnTimeout:     time.Duration(cfg.ProviderIdleConnTimeout) * time.Second,
+	})
 
-	openWeatherClient := openweather.NewClient(cfg, &client, logrusLog)
-	openWeatherProvider := providers.NewOpenWeatherProvider(openWeatherClient, logrusLog)
-	cacheableOpenWeatherProvider := providers.NewCacheDecorator(openWeatherProvider, redisCache, prometheusMetrics, logrusLog)
+	historyStore := history.NewStore(redisCache, time.Duration(cfg.HistoryRetentionDays)*24*time.Hour, logrusLog)
 
-	cacheWeatherProvider := providers.NewCacheWeather(redisCache, prometheusMetrics, logrusLog)
+	chainBuilder := providers.NewChainBuilder(cfg, weatherCache, redisCache, historyStore, prometheusMetrics, providerTransport, logrusLog)
+	weatherChain, err := chainBuilder.Build()
+	if err != nil {
+		logrusLog.Fatalf("Build weather provider chain: %s", err.Error())
+	}
+
+	cityResolver, err := locations.NewResolver(logrusLog)
+	if err != nil {
+		logrusLog.Fatalf("Load bundled cities: %s", err.Error())
+	}
+
+	var cityGeocoder usecases.CityIndex
+	if cfg.CitySearchGeocoding {
+		openMeteoClient := openmeteo.NewClient(cfg, chainBuilder.HTTPClient(cfg.OpenMeteoTimeout), logrusLog)
+		cityGeocoder = locations.NewGeocoder(openMeteoClient, weatherCache, time.Duration(cfg.CitySearchCacheTTL)*time.Second, logrusLog)
+	}
+	citySearchService := usecases.NewCitySearchService(cityResolver, cityGeocoder, logrusLog)
+
+	coalescingProvider := providers.NewCoalescingProvider(weatherChain, chainBuilder.CallTimeout(), prometheusMetrics, logrusLog)
 
-	weatherAPIChainSection := providers.NewWeatherLink(cacheableWeatherAPIProvider)
-	openWeatherChainSection := providers.NewWeatherLink(cacheableOpenWeatherProvider)
+	weatherService := usecases.NewWeatherService(coalescingProvider, cityResolver, logrusLog)
+	weatherWatcher := usecases.NewWeatherWatcher(weatherService, time.Duration(cfg.WatchRefreshInterval)*time.Second, logrusLog)
+	historyService := usecases.NewHistoryService(historyStore, weatherService, logrusLog)
+	weatherHandler := handlers.NewWeatherHandler(weatherService, weatherWatcher, historyService, citySearchService, logrusLog)
 
-	cacheWeatherProviderChainSection := providers.NewWeatherLink(cacheWeatherProvider)
-	cacheWeatherProviderChainSection.SetNext(weatherAPIChainSection)
-	weatherAPIChainSection.SetNext(openWeatherChainSection)
+	cacheInspector := providers.NewCacheInspector(cacheStore, logrusLog)
+	cacheAdminService := usecases.NewCacheAdminService(cacheInspector, coalescingProvider, cityResolver, logrusLog)
+	adminHandler := handlers.NewAdminHandler(cacheAdminService, logrusLog)
 
-	weatherService := usecases.NewWeatherService(cacheWeatherProviderChainSection, logrusLog)
-	weatherHandler := handlers.NewWeatherHandler(weatherService, logrusLog)
+	watchCtx, stopWatch := context.WithCancel(context.Background())
+	go weatherWatcher.Run(watchCtx)
 
 	go prometheusMetrics.StartMetricsServer(cfg.MetricsServerPort)
 
-	app := server.New(weatherHandler, logrusLog)
+	app := server.New(weatherHandler, adminHandler, cfg.AdminToken, logrusLog)
 	go func() {
 		if err := app.Start(cfg.GRPCPort); err != nil {
 			logrusLog.Fatalf("Failed to start gRPC server: %v", err)
@@ -82,5 +107,7 @@ func main() {
 
 	logrusLog.Infof("Shutting down weather service...")
 	app.Shutdown()
+	stopWatch()
+	stopCache()
 	logrusLog.Infof("Service stopped gracefully")
 }
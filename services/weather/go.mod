@@ -11,6 +11,7 @@ require (
 	github.com/spf13/viper v1.20.1
 	github.com/stretchr/testify v1.10.0
 	github.com/testcontainers/testcontainers-go v0.38.0
+	golang.org/x/sync v0.15.0
 	google.golang.org/grpc v1.73.0
 	gotest.tools v2.2.0+incompatible
 )
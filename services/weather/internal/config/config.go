@@ -7,23 +7,95 @@ import (
 	"github.com/spf13/viper"
 )
 
+const (
+	SequentialStrategy = "sequential"
+	HedgedStrategy     = "hedged"
+	ConsensusStrategy  = "consensus"
+
+	weatherAPIProvider  = "weatherapi"
+	openWeatherProvider = "openweather"
+	openMeteoProvider   = "openmeteo"
+)
+
 type Config struct {
 	GRPCPort          string `mapstructure:"GRPC_PORT"`
 	MetricsServerPort string `mapstructure:"METRICS_SERVER_PORT"`
 
 	RedisSource string `mapstructure:"REDIS_SOURCE"`
 
-	WeatherAPIURL string `mapstructure:"WEATHER_API_URL"`
-	WeatherAPIKey string `mapstructure:"WEATHER_API_KEY"`
+	ProviderChain    []string `mapstructure:"PROVIDER_CHAIN"`
+	ProviderStrategy string   `mapstructure:"PROVIDER_STRATEGY"`
+	HedgeDelayMs     int      `mapstructure:"HEDGE_DELAY_MS"`
+
+	ConsensusTemperatureThreshold float64 `mapstructure:"CONSENSUS_TEMPERATURE_THRESHOLD"`
+
+	WeatherAPIURL              string `mapstructure:"WEATHER_API_URL"`
+	WeatherAPIForecastURL      string `mapstructure:"WEATHER_API_FORECAST_URL"`
+	WeatherAPIAlertsURL        string `mapstructure:"WEATHER_API_ALERTS_URL"`
+	WeatherAPIKey              string `mapstructure:"WEATHER_API_KEY"`
+	WeatherAPITimeout          int    `mapstructure:"WEATHER_API_TIMEOUT"`
+	WeatherAPICacheTTL         int    `mapstructure:"WEATHER_API_CACHE_TTL"`
+	WeatherAPIForecastCacheTTL int    `mapstructure:"WEATHER_API_FORECAST_CACHE_TTL"`
+	WeatherAPIMinuteQuota      int    `mapstructure:"WEATHER_API_MINUTE_QUOTA"`
+	WeatherAPIMonthlyQuota     int    `mapstructure:"WEATHER_API_MONTHLY_QUOTA"`
+
+	OpenWeatherURL              string `mapstructure:"OPEN_WEATHER_URL"`
+	OpenWeatherForecastURL      string `mapstructure:"OPEN_WEATHER_FORECAST_URL"`
+	OpenWeatherAlertsURL        string `mapstructure:"OPEN_WEATHER_ALERTS_URL"`
+	OpenWeatherAirQualityURL    string `mapstructure:"OPEN_WEATHER_AIR_QUALITY_URL"`
+	OpenWeatherKey              string `mapstructure:"OPEN_WEATHER_KEY"`
+	OpenWeatherTimeout          int    `mapstructure:"OPEN_WEATHER_TIMEOUT"`
+	OpenWeatherCacheTTL         int    `mapstructure:"OPEN_WEATHER_CACHE_TTL"`
+	OpenWeatherForecastCacheTTL int    `mapstructure:"OPEN_WEATHER_FORECAST_CACHE_TTL"`
+	OpenWeatherMinuteQuota      int    `mapstructure:"OPEN_WEATHER_MINUTE_QUOTA"`
+	OpenWeatherMonthlyQuota     int    `mapstructure:"OPEN_WEATHER_MONTHLY_QUOTA"`
+
+	OpenMeteoURL              string `mapstructure:"OPEN_METEO_URL"`
+	OpenMeteoGeocodingURL     string `mapstructure:"OPEN_METEO_GEOCODING_URL"`
+	OpenMeteoAirQualityURL    string `mapstructure:"OPEN_METEO_AIR_QUALITY_URL"`
+	OpenMeteoTimeout          int    `mapstructure:"OPEN_METEO_TIMEOUT"`
+	OpenMeteoCacheTTL         int    `mapstructure:"OPEN_METEO_CACHE_TTL"`
+	OpenMeteoForecastCacheTTL int    `mapstructure:"OPEN_METEO_FORECAST_CACHE_TTL"`
+
+	ProviderQuotaReservePercent int `mapstructure:"PROVIDER_QUOTA_RESERVE_PERCENT"`
+
+	ProviderMaxRetries          int `mapstructure:"PROVIDER_MAX_RETRIES"`
+	ProviderCallTimeout         int `mapstructure:"PROVIDER_CALL_TIMEOUT"`
+	ProviderRetryBaseDelayMs    int `mapstructure:"PROVIDER_RETRY_BASE_DELAY_MS"`
+	ProviderRetryMaxDelayMs     int `mapstructure:"PROVIDER_RETRY_MAX_DELAY_MS"`
+	ProviderMaxIdleConnsPerHost int `mapstructure:"PROVIDER_MAX_IDLE_CONNS_PER_HOST"`
+	ProviderMaxConnsPerHost     int `mapstructure:"PROVIDER_MAX_CONNS_PER_HOST"`
+	ProviderIdleConnTimeout     int `mapstructure:"PROVIDER_IDLE_CONN_TIMEOUT"`
+
+	CacheRevalidateWindow int `mapstructure:"CACHE_REVALIDATE_WINDOW"`
+	CacheLastKnownGoodTTL int `mapstructure:"CACHE_LAST_KNOWN_GOOD_TTL"`
+	AlertsCacheTTL        int `mapstructure:"ALERTS_CACHE_TTL"`
+	AirQualityCacheTTL    int `mapstructure:"AIR_QUALITY_CACHE_TTL"`
+
+	MemoryCacheSize int `mapstructure:"MEMORY_CACHE_SIZE"`
+	MemoryCacheTTL  int `mapstructure:"MEMORY_CACHE_TTL"`
+
+	WatchRefreshInterval int `mapstructure:"WATCH_REFRESH_INTERVAL"`
+
+	HistoryRetentionDays int `mapstructure:"HISTORY_RETENTION_DAYS"`
+
+	CitySearchGeocoding bool `mapstructure:"CITY_SEARCH_GEOCODING"`
+	CitySearchCacheTTL  int  `mapstructure:"CITY_SEARCH_CACHE_TTL"`
+
+	AdminToken string `mapstructure:"ADMIN_TOKEN"`
 
-	OpenWeatherURL string `mapstructure:"OPEN_WEATHER_URL"`
-	OpenWeatherKey string `mapstructure:"OPEN_WEATHER_KEY"`
+	CircuitBreakerFailureThreshold int `mapstructure:"CIRCUIT_BREAKER_FAILURE_THRESHOLD"`
+	CircuitBreakerSuccessThreshold int `mapstructure:"CIRCUIT_BREAKER_SUCCESS_THRESHOLD"`
+	CircuitBreakerCoolDown         int `mapstructure:"CIRCUIT_BREAKER_COOL_DOWN"`
 
 	LogFilePath string `mapstructure:"LOG_FILE_PATH"`
 	ServiceName string `mapstructure:"SERVICE_NAME"`
 
 	LogLevel        string `mapstructure:"LOG_LEVEL"`
 	LogSamplingRate int    `mapstructure:"LOG_SAMPLING_RATE"`
+
+	LogBodyMaxBytes   int `mapstructure:"LOG_BODY_MAX_BYTES"`
+	LogBodySampleRate int `mapstructure:"LOG_BODY_SAMPLE_RATE"`
 }
 
 func Load() (*Config, error) {
@@ -50,15 +122,35 @@ func validate(config *Config) error {
 		"GRPC_PORT":           config.GRPCPort,
 		"METRICS_SERVER_PORT": config.MetricsServerPort,
 		"REDIS_SOURCE":        config.RedisSource,
-		"WEATHER_API_URL":     config.WeatherAPIURL,
-		"WEATHER_API_KEY":     config.WeatherAPIKey,
-		"OPEN_WEATHER_URL":    config.OpenWeatherURL,
-		"OPEN_WEATHER_KEY":    config.OpenWeatherKey,
 		"LOG_FILE_PATH":       config.LogFilePath,
 		"SERVICE_NAME":        config.ServiceName,
 		"LOG_LEVEL":           config.LogLevel,
 	}
 
+	// Providers left out of the chain are not called, so their settings may
+	// be left out of the environment too.
+	inChain := chainProviders(config.ProviderChain)
+	if inChain[weatherAPIProvider] {
+		required["WEATHER_API_URL"] = config.WeatherAPIURL
+		required["WEATHER_API_FORECAST_URL"] = config.WeatherAPIForecastURL
+		required["WEATHER_API_ALERTS_URL"] = config.WeatherAPIAlertsURL
+		required["WEATHER_API_KEY"] = config.WeatherAPIKey
+	}
+	if inChain[openWeatherProvider] {
+		required["OPEN_WEATHER_URL"] = config.OpenWeatherURL
+		required["OPEN_WEATHER_FORECAST_URL"] = config.OpenWeatherForecastURL
+		required["OPEN_WEATHER_ALERTS_URL"] = config.OpenWeatherAlertsURL
+		required["OPEN_WEATHER_AIR_QUALITY_URL"] = config.OpenWeatherAirQualityURL
+		required["OPEN_WEATHER_KEY"] = config.OpenWeatherKey
+	}
+	if inChain[openMeteoProvider] {
+		required["OPEN_METEO_URL"] = config.OpenMeteoURL
+		required["OPEN_METEO_AIR_QUALITY_URL"] = config.OpenMeteoAirQualityURL
+	}
+	if inChain[openMeteoProvider] || config.CitySearchGeocoding {
+		required["OPEN_METEO_GEOCODING_URL"] = config.OpenMeteoGeocodingURL
+	}
+
 	var missing []string
 	for name, value := range required {
 		if value == "" {
@@ -69,6 +161,109 @@ func validate(config *Config) error {
 		missing = append(missing, "LOG_SAMPLING_RATE")
 
 	}
+	if len(config.ProviderChain) == 0 {
+		missing = append(missing, "PROVIDER_CHAIN")
+	}
+	switch config.ProviderStrategy {
+	case SequentialStrategy:
+	case HedgedStrategy:
+		if config.HedgeDelayMs < 1 {
+			missing = append(missing, "HEDGE_DELAY_MS")
+		}
+	case ConsensusStrategy:
+		if config.ConsensusTemperatureThreshold <= 0 {
+			missing = append(missing, "CONSENSUS_TEMPERATURE_THRESHOLD")
+		}
+	default:
+		missing = append(missing, "PROVIDER_STRATEGY")
+	}
+	if inChain[weatherAPIProvider] && config.WeatherAPITimeout < 1 {
+		missing = append(missing, "WEATHER_API_TIMEOUT")
+	}
+	if inChain[openWeatherProvider] && config.OpenWeatherTimeout < 1 {
+		missing = append(missing, "OPEN_WEATHER_TIMEOUT")
+	}
+	if (inChain[openMeteoProvider] || config.CitySearchGeocoding) && config.OpenMeteoTimeout < 1 {
+		missing = append(missing, "OPEN_METEO_TIMEOUT")
+	}
+	if inChain[weatherAPIProvider] && config.WeatherAPIMinuteQuota < 0 {
+		missing = append(missing, "WEATHER_API_MINUTE_QUOTA")
+	}
+	if inChain[weatherAPIProvider] && config.WeatherAPIMonthlyQuota < 0 {
+		missing = append(missing, "WEATHER_API_MONTHLY_QUOTA")
+	}
+	if inChain[openWeatherProvider] && config.OpenWeatherMinuteQuota < 0 {
+		missing = append(missing, "OPEN_WEATHER_MINUTE_QUOTA")
+	}
+	if inChain[openWeatherProvider] && config.OpenWeatherMonthlyQuota < 0 {
+		missing = append(missing, "OPEN_WEATHER_MONTHLY_QUOTA")
+	}
+	if config.ProviderQuotaReservePercent < 0 || config.ProviderQuotaReservePercent > 99 {
+		missing = append(missing, "PROVIDER_QUOTA_RESERVE_PERCENT")
+	}
+	if config.ProviderMaxRetries < 0 {
+		missing = append(missing, "PROVIDER_MAX_RETRIES")
+	}
+	if config.ProviderCallTimeout < 1 {
+		missing = append(missing, "PROVIDER_CALL_TIMEOUT")
+	}
+	if config.ProviderMaxRetries > 0 && config.ProviderRetryBaseDelayMs < 1 {
+		missing = append(missing, "PROVIDER_RETRY_BASE_DELAY_MS")
+	}
+	if config.ProviderMaxRetries > 0 && config.ProviderRetryMaxDelayMs < config.ProviderRetryBaseDelayMs {
+		missing = append(missing, "PROVIDER_RETRY_MAX_DELAY_MS")
+	}
+	if config.ProviderMaxIdleConnsPerHost < 0 {
+		missing = append(missing, "PROVIDER_MAX_IDLE_CONNS_PER_HOST")
+	}
+	if config.ProviderMaxConnsPerHost < 0 {
+		missing = append(missing, "PROVIDER_MAX_CONNS_PER_HOST")
+	}
+	if config.ProviderIdleConnTimeout < 0 {
+		missing = append(missing, "PROVIDER_IDLE_CONN_TIMEOUT")
+	}
+	if config.LogBodyMaxBytes < 0 {
+		missing = append(missing, "LOG_BODY_MAX_BYTES")
+	}
+	if config.LogBodySampleRate < 1 {
+		missing = append(missing, "LOG_BODY_SAMPLE_RATE")
+	}
+	if config.CacheRevalidateWindow < 0 {
+		missing = append(missing, "CACHE_REVALIDATE_WINDOW")
+	}
+	if config.CacheLastKnownGoodTTL < 0 {
+		missing = append(missing, "CACHE_LAST_KNOWN_GOOD_TTL")
+	}
+	if config.AlertsCacheTTL < 0 {
+		missing = append(missing, "ALERTS_CACHE_TTL")
+	}
+	if config.AirQualityCacheTTL < 0 {
+		missing = append(missing, "AIR_QUALITY_CACHE_TTL")
+	}
+	if config.MemoryCacheSize < 0 {
+		missing = append(missing, "MEMORY_CACHE_SIZE")
+	}
+	if config.MemoryCacheSize > 0 && config.MemoryCacheTTL < 1 {
+		missing = append(missing, "MEMORY_CACHE_TTL")
+	}
+	if config.WatchRefreshInterval < 1 {
+		missing = append(missing, "WATCH_REFRESH_INTERVAL")
+	}
+	if config.HistoryRetentionDays < 0 {
+		missing = append(missing, "HISTORY_RETENTION_DAYS")
+	}
+	if config.CitySearchCacheTTL < 0 {
+		missing = append(missing, "CITY_SEARCH_CACHE_TTL")
+	}
+	if config.CircuitBreakerFailureThreshold < 1 {
+		missing = append(missing, "CIRCUIT_BREAKER_FAILURE_THRESHOLD")
+	}
+	if config.CircuitBreakerSuccessThreshold < 1 {
+		missing = append(missing, "CIRCUIT_BREAKER_SUCCESS_THRESHOLD")
+	}
+	if config.CircuitBreakerCoolDown < 1 {
+		missing = append(missing, "CIRCUIT_BREAKER_COOL_DOWN")
+	}
 
 	if len(missing) > 0 {
 		return fmt.Errorf("missing required fields: %s", strings.Join(missing, ", "))
@@ -76,3 +271,11 @@ func validate(config *Config) error {
 
 	return nil
 }
+
+func chainProviders(chain []string) map[string]bool {
+	providers := make(map[string]bool, len(chain))
+	for _, name := range chain {
+		providers[strings.ToLower(strings.TrimSpace(name))] = true
+	}
+	return providers
+}
@@ -0,0 +1,21 @@
+package errors
+
+import (
+	"errors"
+)
+
+var (
+	ErrInvalidForecastDays = errors.New("invalid amount of forecast days")
+	ErrInvalidCity         = errors.New("city must not be empty")
+	ErrEmptyBatch          = errors.New("cities must not be empty")
+	ErrBatchTooLarge       = errors.New("too many cities in batch")
+	ErrTooManyWatched      = errors.New("too many cities to watch")
+	ErrInvalidUnits        = errors.New("units must be one of metric, imperial or standard")
+	ErrInvalidLang         = errors.New("invalid language code")
+	ErrInvalidHistoryRange = errors.New("history range must end after it starts and span at most 31 days")
+	ErrInvalidAggregation  = errors.New("aggregation must be one of hourly or daily")
+	ErrInvalidSearchPrefix = errors.New("search prefix must not be empty")
+	ErrInvalidSearchLimit  = errors.New("search limit must be between 1 and 20")
+	ErrInvalidCacheScope   = errors.New("either a city or all cities must be given")
+	ErrInvalidCoordinates  = errors.New("latitude must be between -90 and 90 and longitude between -180 and 180")
+)
@@ -0,0 +1,103 @@
+package models
+
+import "math"
+
+const maxAQI = 500
+
+type (
+	// AirQuality holds pollutant concentrations in µg/m³. AQI is the US EPA
+	// index derived from particulate matter, so it reads the same whichever
+	// provider reported the concentrations.
+	AirQuality struct {
+		AQI      int
+		Category string
+		PM25     float64
+		PM10     float64
+		O3       float64
+		NO2      float64
+		Stale    bool
+	}
+
+	aqiBreakpoint struct {
+		concentrationLow  float64
+		concentrationHigh float64
+		indexLow          float64
+		indexHigh         float64
+	}
+)
+
+var (
+	pm25Breakpoints = []aqiBreakpoint{
+		{0, 9.0, 0, 50},
+		{9.1, 35.4, 51, 100},
+		{35.5, 55.4, 101, 150},
+		{55.5, 125.4, 151, 200},
+		{125.5, 225.4, 201, 300},
+		{225.5, 325.4, 301, 500},
+	}
+
+	pm10Breakpoints = []aqiBreakpoint{
+		{0, 54, 0, 50},
+		{55, 154, 51, 100},
+		{155, 254, 101, 150},
+		{255, 354, 151, 200},
+		{355, 424, 201, 300},
+		{425, 604, 301, 500},
+	}
+
+	aqiCategories = []struct {
+		upTo int
+		name string
+	}{
+		{50, "Good"},
+		{100, "Moderate"},
+		{150, "Unhealthy for Sensitive Groups"},
+		{200, "Unhealthy"},
+		{300, "Very Unhealthy"},
+		{maxAQI, "Hazardous"},
+	}
+)
+
+func NewAirQuality(pm25, pm10, o3, no2 float64) AirQuality {
+	aqi := max(
+		subIndex(math.Floor(pm25*10)/10, pm25Breakpoints),
+		subIndex(math.Floor(pm10), pm10Breakpoints),
+	)
+
+	return AirQuality{
+		AQI:      aqi,
+		Category: AQICategory(aqi),
+		PM25:     round(pm25),
+		PM10:     round(pm10),
+		O3:       round(o3),
+		NO2:      round(no2),
+	}
+}
+
+func AQICategory(aqi int) string {
+	for _, category := range aqiCategories {
+		if aqi <= category.upTo {
+			return category.name
+		}
+	}
+
+	return aqiCategories[len(aqiCategories)-1].name
+}
+
+// subIndex interpolates the concentration within its breakpoint range, values
+// falling between two ranges after truncation take the upper one.
+func subIndex(concentration float64, breakpoints []aqiBreakpoint) int {
+	if concentration <= 0 {
+		return 0
+	}
+
+	for _, bp := range breakpoints {
+		if concentration <= bp.concentrationHigh {
+			concentration = max(concentration, bp.concentrationLow)
+			index := (bp.indexHigh-bp.indexLow)/(bp.concentrationHigh-bp.concentrationLow)*(concentration-bp.concentrationLow) + bp.indexLow
+			return int(math.Round(index))
+		}
+	}
+
+	return maxAQI
+}
@@ -0,0 +1,30 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+type (
+	// Alert is a severe weather warning issued for a location. ID identifies
+	// the same warning across polls and providers.
+	Alert struct {
+		ID          string
+		Event       string
+		Severity    string
+		Headline    string
+		Description string
+		Start       time.Time
+		End         time.Time
+	}
+
+	Alerts struct {
+		Items []Alert
+		Stale bool
+	}
+)
+
+func AlertID(event string, start time.Time) string {
+	return fmt.Sprintf("%s:%d", strings.ToLower(strings.TrimSpace(event)), start.Unix())
+}
@@ -0,0 +1,40 @@
+package models
+
+import "time"
+
+const (
+	CacheKindWeather    CacheKind = "weather"
+	CacheKindForecast   CacheKind = "forecast"
+	CacheKindAlerts     CacheKind = "alerts"
+	CacheKindAirQuality CacheKind = "air_quality"
+)
+
+type (
+	CacheKind string
+
+	// CacheEntry describes a cached provider answer without its value. Options
+	// are set for weather entries and Days for forecast entries, as they are
+	// part of the key.
+	CacheEntry struct {
+		Key        string
+		Kind       CacheKind
+		LocationID string
+		Options    WeatherOptions
+		Days       int
+		Source     string
+		CachedAt   time.Time
+		FreshUntil time.Time
+		StaleUntil time.Time
+	}
+)
+
+func (e CacheEntry) Age(now time.Time) time.Duration {
+	if e.CachedAt.IsZero() {
+		return 0
+	}
+	return now.Sub(e.CachedAt)
+}
+
+func (e CacheEntry) Fresh(now time.Time) bool {
+	return now.Before(e.FreshUntil)
+}
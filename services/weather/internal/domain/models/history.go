@@ -0,0 +1,90 @@
+package models
+
+import "time"
+
+const (
+	AggregationNone   Aggregation = ""
+	AggregationHourly Aggregation = "hourly"
+	AggregationDaily  Aggregation = "daily"
+)
+
+type (
+	Aggregation string
+
+	// Observation is a single weather reading as fetched from a provider,
+	// always kept in metric units.
+	Observation struct {
+		LocationID  string
+		Provider    string
+		ObservedAt  time.Time
+		Temperature float64
+		Humidity    int
+		Description string
+		WindSpeed   float64
+		Pressure    float64
+	}
+
+	HistoryQuery struct {
+		From        time.Time
+		To          time.Time
+		Aggregation Aggregation
+		Units       Units
+	}
+
+	// HistoryPoint is either a single observation or the summary of every
+	// observation in an hour or a day, Samples tells how many. Provider is
+	// empty when the summarised observations come from several providers.
+	HistoryPoint struct {
+		Time           time.Time
+		Provider       string
+		Temperature    float64
+		MinTemperature float64
+		MaxTemperature float64
+		Humidity       int
+		Description    string
+		WindSpeed      float64
+		Pressure       float64
+		Samples        int
+	}
+
+	History struct {
+		Location Location
+		Points   []HistoryPoint
+	}
+)
+
+func NewObservation(locationID, provider string, observedAt time.Time, weather *Weather, units Units) Observation {
+	return Observation{
+		LocationID:  locationID,
+		Provider:    provider,
+		ObservedAt:  observedAt.UTC(),
+		Temperature: round(units.ToCelsius(weather.Temperature)),
+		Humidity:    weather.Humidity,
+		Description: weather.Description,
+		WindSpeed:   round(units.ToKph(weather.WindSpeed)),
+		Pressure:    weather.Pressure,
+	}
+}
+
+func (a Aggregation) Valid() bool {
+	switch a {
+	case AggregationNone, AggregationHourly, AggregationDaily:
+		return true
+	default:
+		return false
+	}
+}
+
+// Bucket returns the start of the UTC hour or day t falls into.
+func (a Aggregation) Bucket(t time.Time) time.Time {
+	t = t.UTC()
+
+	switch a {
+	case AggregationHourly:
+		return t.Truncate(time.Hour)
+	case AggregationDaily:
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	default:
+		return t
+	}
+}
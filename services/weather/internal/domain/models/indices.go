@@ -0,0 +1,72 @@
+package models
+
+import "math"
+
+const (
+	heatIndexThresholdF = 80.0
+	windChillMaxTempC   = 10.0
+	windChillMinWindKph = 4.8
+)
+
+// CalculateIndices derives heat index, wind chill and dew point from the
+// temperature, humidity (%) and wind speed reported by a provider in units.
+// The formulas work in °C and km/h, the indices are reported in units.
+func (w *Weather) CalculateIndices(units Units) {
+	tempC := units.ToCelsius(w.Temperature)
+	windKph := units.ToKph(w.WindSpeed)
+
+	w.HeatIndex = units.FromCelsius(heatIndex(tempC, w.Humidity))
+	w.WindChill = units.FromCelsius(windChill(tempC, windKph))
+	w.DewPoint = units.FromCelsius(dewPoint(tempC, w.Humidity))
+}
+
+// heatIndex uses the NWS Rothfusz regression with its low-humidity and
+// high-humidity adjustments, falling back to Steadman's simple formula below 80°F.
+func heatIndex(tempC float64, humidity int) float64 {
+	t := tempC*9/5 + 32
+	rh := float64(humidity)
+
+	hi := 0.5 * (t + 61 + (t-68)*1.2 + rh*0.094)
+
+	if (hi+t)/2 >= heatIndexThresholdF {
+		hi = -42.379 + 2.04901523*t + 10.14333127*rh -
+			0.22475541*t*rh - 0.00683783*t*t -
+			0.05481717*rh*rh + 0.00122874*t*t*rh +
+			0.00085282*t*rh*rh - 0.00000199*t*t*rh*rh
+
+		switch {
+		case rh < 13 && t >= 80 && t <= 112:
+			hi -= (13 - rh) / 4 * math.Sqrt((17-math.Abs(t-95))/17)
+		case rh > 85 && t >= 80 && t <= 87:
+			hi += (rh - 85) / 10 * (87 - t) / 5
+		}
+	}
+
+	return round((hi - 32) * 5 / 9)
+}
+
+// windChill uses the metric formula of Environment Canada, which is only
+// defined for cold and windy conditions; otherwise the air temperature is returned.
+func windChill(tempC, windKph float64) float64 {
+	if tempC > windChillMaxTempC || windKph <= windChillMinWindKph {
+		return tempC
+	}
+
+	v := math.Pow(windKph, 0.16)
+
+	return round(13.12 + 0.6215*tempC - 11.37*v + 0.3965*tempC*v)
+}
+
+// dewPoint uses the Magnus approximation.
+func dewPoint(tempC float64, humidity int) float64 {
+	const a, b = 17.625, 243.04
+
+	rh := math.Max(float64(humidity), 1)
+	gamma := math.Log(rh/100) + a*tempC/(b+tempC)
+
+	return round(b * gamma / (a - gamma))
+}
+
+func round(value float64) float64 {
+	return math.Round(value*10) / 10
+}
@@ -0,0 +1,56 @@
+package models
+
+import (
+	"math"
+	"strconv"
+)
+
+// CoordinatesPrecision is the number of decimals coordinates are snapped to,
+// a grid of about a kilometre. Points within one cell share the location and
+// its cached weather.
+const CoordinatesPrecision = 2
+
+type (
+	Coordinates struct {
+		Latitude  float64
+		Longitude float64
+	}
+
+	// Location is the canonical form of a city. ID is stable across spellings
+	// of the same city and is used for cache keys, Coordinates are nil
+	// for cities that are not known to the resolver.
+	Location struct {
+		ID          string
+		Name        string
+		Country     string
+		Coordinates *Coordinates
+	}
+)
+
+func (c Coordinates) Valid() bool {
+	return c.Latitude >= -90 && c.Latitude <= 90 && c.Longitude >= -180 && c.Longitude <= 180
+}
+
+// NewCoordinatesLocation makes a location of the grid cell the coordinates
+// fall in, identified and queried by the center of the cell.
+func NewCoordinatesLocation(coordinates Coordinates) Location {
+	snapped := Coordinates{
+		Latitude:  snapToGrid(coordinates.Latitude),
+		Longitude: snapToGrid(coordinates.Longitude),
+	}
+	id := strconv.FormatFloat(snapped.Latitude, 'f', CoordinatesPrecision, 64) + "," +
+		strconv.FormatFloat(snapped.Longitude, 'f', CoordinatesPrecision, 64)
+
+	return Location{
+		ID:          id,
+		Name:        id,
+		Coordinates: &snapped,
+	}
+}
+
+func snapToGrid(value float64) float64 {
+	scale := math.Pow10(CoordinatesPrecision)
+	// Adding zero turns a negative zero into zero, which would be printed
+	// as -0.00.
+	return math.Round(value*scale)/scale + 0
+}
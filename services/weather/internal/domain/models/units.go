@@ -0,0 +1,96 @@
+package models
+
+import "strings"
+
+const (
+	UnitsMetric   Units = "metric"
+	UnitsImperial Units = "imperial"
+	UnitsStandard Units = "standard"
+
+	DefaultLang = "en"
+
+	kelvinOffset      = 273.15
+	kphInMph          = 1.609344
+	kphInMetersPerSec = 3.6
+)
+
+type (
+	// Units follows OpenWeather naming: metric is °C and km/h, imperial is °F
+	// and mph, standard is K and m/s. Pressure and visibility are always
+	// reported in hPa and km.
+	Units string
+
+	WeatherOptions struct {
+		Units Units
+		Lang  string
+	}
+)
+
+func (u Units) Valid() bool {
+	switch u {
+	case UnitsMetric, UnitsImperial, UnitsStandard:
+		return true
+	default:
+		return false
+	}
+}
+
+// WithDefaults fills options left empty by the caller, so equal requests
+// share cache entries however they were spelled.
+func (o WeatherOptions) WithDefaults() WeatherOptions {
+	if o.Units == "" {
+		o.Units = UnitsMetric
+	}
+
+	o.Units = Units(strings.ToLower(string(o.Units)))
+	o.Lang = strings.ToLower(strings.TrimSpace(o.Lang))
+	if o.Lang == "" {
+		o.Lang = DefaultLang
+	}
+
+	return o
+}
+
+func (u Units) FromCelsius(celsius float64) float64 {
+	switch u {
+	case UnitsImperial:
+		return round(celsius*9/5 + 32)
+	case UnitsStandard:
+		return round(celsius + kelvinOffset)
+	default:
+		return celsius
+	}
+}
+
+func (u Units) ToCelsius(value float64) float64 {
+	switch u {
+	case UnitsImperial:
+		return (value - 32) * 5 / 9
+	case UnitsStandard:
+		return value - kelvinOffset
+	default:
+		return value
+	}
+}
+
+func (u Units) FromKph(kph float64) float64 {
+	switch u {
+	case UnitsImperial:
+		return round(kph / kphInMph)
+	case UnitsStandard:
+		return round(kph / kphInMetersPerSec)
+	default:
+		return kph
+	}
+}
+
+func (u Units) ToKph(value float64) float64 {
+	switch u {
+	case UnitsImperial:
+		return value * kphInMph
+	case UnitsStandard:
+		return value * kphInMetersPerSec
+	default:
+		return value
+	}
+}
@@ -2,8 +2,53 @@ package models
 
 type (
 	Weather struct {
-		Temperature float64
-		Humidity    int
-		Description string
+		Temperature   float64
+		Humidity      int
+		Description   string
+		WindSpeed     float64
+		WindDirection int
+		Pressure      float64
+		CloudCover    int
+		Visibility    float64
+		FeelsLike     float64
+		HeatIndex     float64
+		WindChill     float64
+		DewPoint      float64
+		// Stale marks the last known weather served while every provider fails.
+		Stale bool
+		// Disputed marks a consensus of providers whose temperatures differ by
+		// more than the configured threshold.
+		Disputed bool
+	}
+
+	DailyForecast struct {
+		Date                string
+		MinTemperature      float64
+		MaxTemperature      float64
+		PrecipitationChance int
+		Condition           string
+	}
+
+	Forecast struct {
+		Days  []DailyForecast
+		Stale bool
+	}
+
+	WeatherUpdate struct {
+		Location Location
+		Weather  Weather
+	}
+
+	CityWeather struct {
+		City    string
+		Weather *Weather
+		Err     error
+	}
+
+	// Prefetch counts the refreshed cache entries and lists the cities that
+	// could not be refreshed.
+	Prefetch struct {
+		Warmed int
+		Failed []string
 	}
 )
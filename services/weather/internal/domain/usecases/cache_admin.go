@@ -0,0 +1,162 @@
+package usecases
+
+import (
+	"context"
+	"strings"
+	"weather-forecast/pkg/logger"
+	domainerrors "weather-service/internal/domain/errors"
+	"weather-service/internal/domain/models"
+)
+
+type (
+	CacheInspector interface {
+		Entries(ctx context.Context, locationID string) ([]models.CacheEntry, error)
+		Invalidate(ctx context.Context, locationID string) (int, error)
+	}
+
+	// CacheAdminService lets operators look into the weather cache and fix it
+	// city by city.
+	CacheAdminService struct {
+		inspector       CacheInspector
+		weatherProvider WeatherProvider
+		cityResolver    CityResolver
+		logger          logger.Logger
+	}
+)
+
+func NewCacheAdminService(inspector CacheInspector, weatherProvider WeatherProvider, cityResolver CityResolver, logger logger.Logger) *CacheAdminService {
+	return &CacheAdminService{
+		inspector:       inspector,
+		weatherProvider: weatherProvider,
+		cityResolver:    cityResolver,
+		logger:          logger,
+	}
+}
+
+// ListCache lists the cached entries of the city, or of every city when city
+// is empty.
+func (s *CacheAdminService) ListCache(ctx context.Context, city string) ([]models.CacheEntry, error) {
+	log := s.logger.WithContext(ctx)
+
+	locationID := ""
+	if strings.TrimSpace(city) != "" {
+		location, err := s.cityResolver.Resolve(ctx, city)
+		if err != nil {
+			log.Errorf("Failed to resolve city %s: %v", city, err)
+			return nil, err
+		}
+		locationID = location.ID
+	}
+
+	entries, err := s.inspector.Entries(ctx, locationID)
+	if err != nil {
+		log.Errorf("Failed to list cache entries: %v", err)
+		return nil, err
+	}
+
+	log.Infof("Listed %d cache entries", len(entries))
+
+	return entries, nil
+}
+
+// InvalidateCache removes the cached entries of the city, or of every city
+// when all is set. Exactly one of them must be given.
+func (s *CacheAdminService) InvalidateCache(ctx context.Context, city string, all bool) (int, error) {
+	log := s.logger.WithContext(ctx)
+
+	hasCity := strings.TrimSpace(city) != ""
+	if hasCity == all {
+		log.Warnf("Cache invalidation requested with city %q and all=%t", city, all)
+		return 0, domainerrors.ErrInvalidCacheScope
+	}
+
+	locationID := ""
+	if hasCity {
+		location, err := s.cityResolver.Resolve(ctx, city)
+		if err != nil {
+			log.Errorf("Failed to resolve city %s: %v", city, err)
+			return 0, err
+		}
+		locationID = location.ID
+	}
+
+	removed, err := s.inspector.Invalidate(ctx, locationID)
+	if err != nil {
+		log.Errorf("Failed to invalidate cache: %v", err)
+		return 0, err
+	}
+
+	log.Infof("Cache invalidated for city: %q, all: %t, removed: %d", locationID, all, removed)
+
+	return removed, nil
+}
+
+// RefreshCache fetches again every cached entry of the city, or its current
+// weather when nothing of it is cached, and returns the entries afterwards.
+// It fails only when no entry could be refreshed.
+func (s *CacheAdminService) RefreshCache(ctx context.Context, city string) ([]models.CacheEntry, error) {
+	log := s.logger.WithContext(ctx)
+
+	if strings.TrimSpace(city) == "" {
+		log.Warnf("Empty city provided for cache refresh")
+		return nil, domainerrors.ErrInvalidCity
+	}
+
+	location, err := s.cityResolver.Resolve(ctx, city)
+	if err != nil {
+		log.Errorf("Failed to resolve city %s: %v", city, err)
+		return nil, err
+	}
+
+	entries, err := s.inspector.Entries(ctx, location.ID)
+	if err != nil {
+		log.Errorf("Failed to list cache entries of city %s: %v", location.ID, err)
+		return nil, err
+	}
+
+	if len(entries) == 0 {
+		entries = []models.CacheEntry{{
+			Kind:       models.CacheKindWeather,
+			LocationID: location.ID,
+			Options:    models.WeatherOptions{}.WithDefaults(),
+		}}
+	}
+
+	refreshCtx := WithRefresh(ctx)
+
+	refreshed := 0
+	var lastErr error
+	for _, entry := range entries {
+		if err := s.refresh(refreshCtx, *location, entry); err != nil {
+			log.Warnf("Failed to refresh %s of city %s: %v", entry.Kind, location.ID, err)
+			lastErr = err
+			continue
+		}
+		refreshed++
+	}
+
+	if refreshed == 0 {
+		return nil, lastErr
+	}
+
+	log.Infof("Cache refreshed for city: %s, entries: %d, failed: %d", location.ID, refreshed, len(entries)-refreshed)
+
+	return s.inspector.Entries(ctx, location.ID)
+}
+
+func (s *CacheAdminService) refresh(ctx context.Context, location models.Location, entry models.CacheEntry) error {
+	var err error
+
+	switch entry.Kind {
+	case models.CacheKindWeather:
+		_, err = s.weatherProvider.GetWeatherByCity(ctx, location, entry.Options)
+	case models.CacheKindForecast:
+		_, err = s.weatherProvider.GetForecastByCity(ctx, location, entry.Days)
+	case models.CacheKindAlerts:
+		_, err = s.weatherProvider.GetAlertsByCity(ctx, location)
+	case models.CacheKindAirQuality:
+		_, err = s.weatherProvider.GetAirQualityByCity(ctx, location)
+	}
+
+	return err
+}
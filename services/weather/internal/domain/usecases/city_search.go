@@ -0,0 +1,97 @@
+package usecases
+
+import (
+	"context"
+	"strings"
+	"weather-forecast/pkg/logger"
+	domainerrors "weather-service/internal/domain/errors"
+	"weather-service/internal/domain/models"
+)
+
+const (
+	DefaultCitySearchLimit = 10
+	MaxCitySearchLimit     = 20
+)
+
+type (
+	CityIndex interface {
+		Search(ctx context.Context, prefix string, limit int) ([]models.Location, error)
+	}
+
+	// CitySearchService suggests cities for a typed prefix from the bundled
+	// dataset first and tops the list up from the geocoder, when one is set.
+	CitySearchService struct {
+		bundled  CityIndex
+		geocoder CityIndex
+		logger   logger.Logger
+	}
+)
+
+func NewCitySearchService(bundled CityIndex, geocoder CityIndex, logger logger.Logger) *CitySearchService {
+	return &CitySearchService{
+		bundled:  bundled,
+		geocoder: geocoder,
+		logger:   logger,
+	}
+}
+
+func (s *CitySearchService) SearchCities(ctx context.Context, prefix string, limit int) ([]models.Location, error) {
+	log := s.logger.WithContext(ctx)
+
+	if strings.TrimSpace(prefix) == "" {
+		log.Warnf("Empty city search prefix provided")
+		return nil, domainerrors.ErrInvalidSearchPrefix
+	}
+
+	if limit == 0 {
+		limit = DefaultCitySearchLimit
+	}
+	if limit < 0 || limit > MaxCitySearchLimit {
+		log.Warnf("City search limit %d is out of range", limit)
+		return nil, domainerrors.ErrInvalidSearchLimit
+	}
+
+	cities, err := s.bundled.Search(ctx, prefix, limit)
+	if err != nil {
+		log.Errorf("Failed to search bundled cities for %q: %v", prefix, err)
+		return nil, err
+	}
+
+	if len(cities) < limit && s.geocoder != nil {
+		geocoded, err := s.geocoder.Search(ctx, prefix, limit)
+		if err != nil {
+			log.Warnf("Geocoding fallback failed for %q, returning bundled cities only: %v", prefix, err)
+		}
+		cities = appendMissing(cities, geocoded, limit)
+	}
+
+	log.Infof("City search for %q found %d cities", prefix, len(cities))
+
+	return cities, nil
+}
+
+// appendMissing adds extra cities that are not in cities yet, a city is the
+// same when its name and country are.
+func appendMissing(cities, extra []models.Location, limit int) []models.Location {
+	seen := make(map[string]bool, len(cities))
+	for _, city := range cities {
+		seen[cityKey(city)] = true
+	}
+
+	for _, city := range extra {
+		if len(cities) >= limit {
+			break
+		}
+		if seen[cityKey(city)] {
+			continue
+		}
+		seen[cityKey(city)] = true
+		cities = append(cities, city)
+	}
+
+	return cities
+}
+
+func cityKey(city models.Location) string {
+	return strings.ToLower(city.Name) + "|" + strings.ToLower(city.Country)
+}
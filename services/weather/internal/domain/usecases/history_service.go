@@ -0,0 +1,170 @@
+package usecases
+
+import (
+	"context"
+	"math"
+	"strings"
+	"time"
+	"weather-forecast/pkg/logger"
+	domainerrors "weather-service/internal/domain/errors"
+	"weather-service/internal/domain/models"
+)
+
+const (
+	MaxHistoryRange     = 31 * 24 * time.Hour
+	DefaultHistoryRange = 24 * time.Hour
+)
+
+type (
+	ObservationReader interface {
+		Range(ctx context.Context, locationID string, from, to time.Time) ([]models.Observation, error)
+	}
+
+	LocationResolver interface {
+		ResolveCity(ctx context.Context, city string) (*models.Location, error)
+	}
+
+	HistoryService struct {
+		observations ObservationReader
+		resolver     LocationResolver
+		logger       logger.Logger
+	}
+)
+
+func NewHistoryService(observations ObservationReader, resolver LocationResolver, logger logger.Logger) *HistoryService {
+	return &HistoryService{
+		observations: observations,
+		resolver:     resolver,
+		logger:       logger,
+	}
+}
+
+// GetHistory returns the observations of the city recorded between From and
+// To, oldest first. Missing bounds default to the last DefaultHistoryRange.
+func (s *HistoryService) GetHistory(ctx context.Context, city string, query models.HistoryQuery) (*models.History, error) {
+	log := s.logger.WithContext(ctx)
+
+	query, err := s.validateQuery(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	location, err := s.resolver.ResolveCity(ctx, city)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Infof("Getting history for city: %s, from: %s, to: %s, aggregation: %s", location.ID, query.From.Format(time.RFC3339), query.To.Format(time.RFC3339), query.Aggregation)
+
+	observations, err := s.observations.Range(ctx, location.ID, query.From, query.To)
+	if err != nil {
+		log.Errorf("Failed to read history for city %s: %v", location.ID, err)
+		return nil, err
+	}
+
+	points := aggregate(observations, query.Aggregation)
+	for i := range points {
+		points[i].Temperature = query.Units.FromCelsius(points[i].Temperature)
+		points[i].MinTemperature = query.Units.FromCelsius(points[i].MinTemperature)
+		points[i].MaxTemperature = query.Units.FromCelsius(points[i].MaxTemperature)
+		points[i].WindSpeed = query.Units.FromKph(points[i].WindSpeed)
+	}
+
+	log.Infof("History retrieved for city: %s, observations: %d, points: %d", location.ID, len(observations), len(points))
+
+	return &models.History{
+		Location: *location,
+		Points:   points,
+	}, nil
+}
+
+func (s *HistoryService) validateQuery(ctx context.Context, query models.HistoryQuery) (models.HistoryQuery, error) {
+	log := s.logger.WithContext(ctx)
+
+	query.Aggregation = models.Aggregation(strings.ToLower(strings.TrimSpace(string(query.Aggregation))))
+	if !query.Aggregation.Valid() {
+		log.Warnf("Unsupported history aggregation requested: %s", query.Aggregation)
+		return models.HistoryQuery{}, domainerrors.ErrInvalidAggregation
+	}
+
+	query.Units = models.WeatherOptions{Units: query.Units}.WithDefaults().Units
+	if !query.Units.Valid() {
+		log.Warnf("Unsupported units requested: %s", query.Units)
+		return models.HistoryQuery{}, domainerrors.ErrInvalidUnits
+	}
+
+	if query.To.IsZero() {
+		query.To = time.Now()
+	}
+	if query.From.IsZero() {
+		query.From = query.To.Add(-DefaultHistoryRange)
+	}
+
+	if !query.From.Before(query.To) || query.To.Sub(query.From) > MaxHistoryRange {
+		log.Warnf("Invalid history range requested: %s - %s", query.From.Format(time.RFC3339), query.To.Format(time.RFC3339))
+		return models.HistoryQuery{}, domainerrors.ErrInvalidHistoryRange
+	}
+
+	return query, nil
+}
+
+// aggregate summarises observations per hour or day: temperature, humidity,
+// wind and pressure are averaged and the most frequent description is kept.
+func aggregate(observations []models.Observation, aggregation models.Aggregation) []models.HistoryPoint {
+	points := make([]models.HistoryPoint, 0, len(observations))
+	var descriptions map[string]int
+
+	for _, observation := range observations {
+		bucket := aggregation.Bucket(observation.ObservedAt)
+
+		if aggregation == models.AggregationNone || len(points) == 0 || !points[len(points)-1].Time.Equal(bucket) {
+			if len(points) > 0 {
+				finish(&points[len(points)-1])
+			}
+			points = append(points, models.HistoryPoint{
+				Time:           bucket,
+				Provider:       observation.Provider,
+				MinTemperature: observation.Temperature,
+				MaxTemperature: observation.Temperature,
+				Description:    observation.Description,
+			})
+			descriptions = make(map[string]int)
+		}
+
+		point := &points[len(points)-1]
+		if point.Provider != observation.Provider {
+			point.Provider = ""
+		}
+		point.Temperature += observation.Temperature
+		point.MinTemperature = min(point.MinTemperature, observation.Temperature)
+		point.MaxTemperature = max(point.MaxTemperature, observation.Temperature)
+		point.Humidity += observation.Humidity
+		point.WindSpeed += observation.WindSpeed
+		point.Pressure += observation.Pressure
+		point.Samples++
+
+		descriptions[observation.Description]++
+		if descriptions[observation.Description] > descriptions[point.Description] {
+			point.Description = observation.Description
+		}
+	}
+
+	if len(points) > 0 {
+		finish(&points[len(points)-1])
+	}
+
+	return points
+}
+
+func finish(point *models.HistoryPoint) {
+	samples := float64(point.Samples)
+
+	point.Temperature = roundTenth(point.Temperature / samples)
+	point.Humidity = int(math.Round(float64(point.Humidity) / samples))
+	point.WindSpeed = roundTenth(point.WindSpeed / samples)
+	point.Pressure = roundTenth(point.Pressure / samples)
+}
+
+func roundTenth(value float64) float64 {
+	return math.Round(value*10) / 10
+}
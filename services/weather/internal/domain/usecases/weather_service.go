@@ -2,41 +2,354 @@ package usecases
 
 import (
 	"context"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"time"
 	"weather-forecast/pkg/logger"
+	domainerrors "weather-service/internal/domain/errors"
 	"weather-service/internal/domain/models"
 )
 
+const (
+	MaxForecastDays = 5
+	MaxBatchSize    = 100
+	BatchWorkers    = 10
+)
+
+var langPattern = regexp.MustCompile(`^[a-z]{2,3}([_-][a-z]{2,4})?$`)
+
 type (
 	WeatherProvider interface {
-		GetWeatherByCity(ctx context.Context, city string) (*models.Weather, error)
+		GetWeatherByCity(ctx context.Context, location models.Location, options models.WeatherOptions) (*models.Weather, error)
+		GetForecastByCity(ctx context.Context, location models.Location, days int) (*models.Forecast, error)
+		GetAlertsByCity(ctx context.Context, location models.Location) (*models.Alerts, error)
+		GetAirQualityByCity(ctx context.Context, location models.Location) (*models.AirQuality, error)
+	}
+
+	CityResolver interface {
+		Resolve(ctx context.Context, city string) (*models.Location, error)
 	}
 
 	WeatherService struct {
 		weatherProvider WeatherProvider
+		cityResolver    CityResolver
 		logger          logger.Logger
 	}
+
+	refreshKey    struct{}
+	freshUntilKey struct{}
 )
 
-func NewWeatherService(weatherProvider WeatherProvider, logger logger.Logger) *WeatherService {
+// WithRefresh makes the provider chain skip cached answers for requests made
+// with the returned context. The fresh answers are cached as usual.
+func WithRefresh(ctx context.Context) context.Context {
+	return context.WithValue(ctx, refreshKey{}, true)
+}
+
+func RefreshRequested(ctx context.Context) bool {
+	refresh, _ := ctx.Value(refreshKey{}).(bool)
+	return refresh
+}
+
+// WithFreshUntil makes the provider chain skip cached answers that expire
+// before freshUntil for requests made with the returned context.
+func WithFreshUntil(ctx context.Context, freshUntil time.Time) context.Context {
+	return context.WithValue(ctx, freshUntilKey{}, freshUntil)
+}
+
+func FreshUntilRequested(ctx context.Context) (time.Time, bool) {
+	freshUntil, ok := ctx.Value(freshUntilKey{}).(time.Time)
+	return freshUntil, ok
+}
+
+func NewWeatherService(weatherProvider WeatherProvider, cityResolver CityResolver, logger logger.Logger) *WeatherService {
 	return &WeatherService{
 		weatherProvider: weatherProvider,
+		cityResolver:    cityResolver,
 		logger:          logger,
 	}
 }
 
-func (s *WeatherService) GetWeatherByCity(ctx context.Context, city string) (*models.Weather, error) {
+func (s *WeatherService) ResolveCity(ctx context.Context, city string) (*models.Location, error) {
+	log := s.logger.WithContext(ctx)
+
+	if strings.TrimSpace(city) == "" {
+		log.Warnf("Empty city provided")
+		return nil, domainerrors.ErrInvalidCity
+	}
+
+	location, err := s.cityResolver.Resolve(ctx, city)
+	if err != nil {
+		log.Errorf("Failed to resolve city %s: %v", city, err)
+		return nil, err
+	}
+
+	return location, nil
+}
+
+func (s *WeatherService) GetWeatherByCity(ctx context.Context, city string, options models.WeatherOptions) (*models.Weather, error) {
+	options, err := s.validateOptions(ctx, options)
+	if err != nil {
+		return nil, err
+	}
+
+	location, err := s.ResolveCity(ctx, city)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetWeatherByLocation(ctx, *location, options)
+}
+
+func (s *WeatherService) GetWeatherByCoordinates(ctx context.Context, coordinates models.Coordinates, options models.WeatherOptions) (*models.Weather, error) {
+	log := s.logger.WithContext(ctx)
+
+	if !coordinates.Valid() {
+		log.Warnf("Invalid coordinates provided: %f,%f", coordinates.Latitude, coordinates.Longitude)
+		return nil, domainerrors.ErrInvalidCoordinates
+	}
+
+	return s.GetWeatherByLocation(ctx, models.NewCoordinatesLocation(coordinates), options)
+}
+
+func (s *WeatherService) GetWeatherByLocation(ctx context.Context, location models.Location, options models.WeatherOptions) (*models.Weather, error) {
 	log := s.logger.WithContext(ctx)
 
-	log.Infof("Getting weather for city: %s", city)
+	options, err := s.validateOptions(ctx, options)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Infof("Getting weather for city: %s, units: %s, lang: %s", location.ID, options.Units, options.Lang)
 
-	weather, err := s.weatherProvider.GetWeatherByCity(ctx, city)
+	weather, err := s.weatherProvider.GetWeatherByCity(ctx, location, options)
 	if err != nil {
-		log.Errorf("Failed to get weather for city %s: %v", city, err)
+		log.Errorf("Failed to get weather for city %s: %v", location.ID, err)
 
 		return nil, err
 	}
 
-	log.Infof("Weather retrieved successfully for city: %s", city)
+	weather.CalculateIndices(options.Units)
+
+	log.Infof("Weather retrieved successfully for city: %s", location.ID)
 
 	return weather, nil
 }
+
+func (s *WeatherService) GetForecastByCity(ctx context.Context, city string, days int) (*models.Forecast, error) {
+	log := s.logger.WithContext(ctx)
+
+	if days < 1 || days > MaxForecastDays {
+		log.Warnf("Forecast requested for unsupported amount of days: %d", days)
+		return nil, domainerrors.ErrInvalidForecastDays
+	}
+
+	location, err := s.ResolveCity(ctx, city)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Infof("Getting %d-day forecast for city: %s", days, location.ID)
+
+	forecast, err := s.weatherProvider.GetForecastByCity(ctx, *location, days)
+	if err != nil {
+		log.Errorf("Failed to get forecast for city %s: %v", location.ID, err)
+
+		return nil, err
+	}
+
+	log.Infof("Forecast retrieved successfully for city: %s", location.ID)
+
+	return forecast, nil
+}
+
+func (s *WeatherService) GetAlertsByCity(ctx context.Context, city string) (*models.Alerts, error) {
+	log := s.logger.WithContext(ctx)
+
+	location, err := s.ResolveCity(ctx, city)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Infof("Getting alerts for city: %s", location.ID)
+
+	alerts, err := s.weatherProvider.GetAlertsByCity(ctx, *location)
+	if err != nil {
+		log.Errorf("Failed to get alerts for city %s: %v", location.ID, err)
+
+		return nil, err
+	}
+
+	log.Infof("%d alerts retrieved for city: %s", len(alerts.Items), location.ID)
+
+	return alerts, nil
+}
+
+func (s *WeatherService) GetAirQualityByCity(ctx context.Context, city string) (*models.AirQuality, error) {
+	log := s.logger.WithContext(ctx)
+
+	location, err := s.ResolveCity(ctx, city)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Infof("Getting air quality for city: %s", location.ID)
+
+	airQuality, err := s.weatherProvider.GetAirQualityByCity(ctx, *location)
+	if err != nil {
+		log.Errorf("Failed to get air quality for city %s: %v", location.ID, err)
+
+		return nil, err
+	}
+
+	log.Infof("Air quality retrieved for city: %s, aqi=%d", location.ID, airQuality.AQI)
+
+	return airQuality, nil
+}
+
+// GetWeatherBatch fetches weather for every city with at most BatchWorkers
+// lookups in flight. Results keep the order of cities, a failed city carries
+// its error instead of failing the whole batch.
+func (s *WeatherService) GetWeatherBatch(ctx context.Context, cities []string) ([]models.CityWeather, error) {
+	log := s.logger.WithContext(ctx)
+
+	if len(cities) == 0 {
+		log.Warnf("Empty weather batch requested")
+		return nil, domainerrors.ErrEmptyBatch
+	}
+
+	if len(cities) > MaxBatchSize {
+		log.Warnf("Weather batch of %d cities exceeds limit of %d", len(cities), MaxBatchSize)
+		return nil, domainerrors.ErrBatchTooLarge
+	}
+
+	log.Infof("Getting weather batch for %d cities", len(cities))
+
+	results := make([]models.CityWeather, len(cities))
+	jobs := make(chan int)
+	wg := &sync.WaitGroup{}
+
+	for range min(BatchWorkers, len(cities)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				weather, err := s.GetWeatherByCity(ctx, cities[i], models.WeatherOptions{})
+				results[i] = models.CityWeather{
+					City:    cities[i],
+					Weather: weather,
+					Err:     err,
+				}
+			}
+		}()
+	}
+
+	for i := range cities {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	return results, nil
+}
+
+// PrefetchWeather refreshes the cached weather of cities and the cached air
+// quality of airQualityCities, so that the requests that follow are served
+// from cache. Only entries that are missing or expire before freshUntil are
+// fetched. Weather is fetched with default options, as batches do.
+func (s *WeatherService) PrefetchWeather(ctx context.Context, cities []string, airQualityCities []string, freshUntil time.Time) (*models.Prefetch, error) {
+	log := s.logger.WithContext(ctx)
+
+	if len(cities) == 0 && len(airQualityCities) == 0 {
+		log.Warnf("Empty prefetch requested")
+		return nil, domainerrors.ErrEmptyBatch
+	}
+
+	if len(cities) > MaxBatchSize || len(airQualityCities) > MaxBatchSize {
+		log.Warnf("Prefetch of %d cities and %d air quality cities exceeds limit of %d", len(cities), len(airQualityCities), MaxBatchSize)
+		return nil, domainerrors.ErrBatchTooLarge
+	}
+
+	log.Infof("Prefetching weather for %d cities and air quality for %d cities", len(cities), len(airQualityCities))
+
+	ctx = WithFreshUntil(ctx, latest(freshUntil, time.Now()))
+
+	names := make([]string, 0, len(cities)+len(airQualityCities))
+	calls := make([]func() error, 0, len(cities)+len(airQualityCities))
+	for _, city := range cities {
+		names = append(names, city)
+		calls = append(calls, func() error {
+			_, err := s.GetWeatherByCity(ctx, city, models.WeatherOptions{})
+			return err
+		})
+	}
+	for _, city := range airQualityCities {
+		names = append(names, city)
+		calls = append(calls, func() error {
+			_, err := s.GetAirQualityByCity(ctx, city)
+			return err
+		})
+	}
+
+	errs := make([]error, len(calls))
+	jobs := make(chan int)
+	wg := &sync.WaitGroup{}
+
+	for range min(BatchWorkers, len(calls)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				errs[i] = calls[i]()
+			}
+		}()
+	}
+
+	for i := range calls {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	prefetch := &models.Prefetch{}
+	for i, err := range errs {
+		if err == nil {
+			prefetch.Warmed++
+			continue
+		}
+		if !slices.Contains(prefetch.Failed, names[i]) {
+			prefetch.Failed = append(prefetch.Failed, names[i])
+		}
+	}
+
+	log.Infof("Prefetch finished: warmed=%d, failed=%d", prefetch.Warmed, len(prefetch.Failed))
+
+	return prefetch, nil
+}
+
+func (s *WeatherService) validateOptions(ctx context.Context, options models.WeatherOptions) (models.WeatherOptions, error) {
+	log := s.logger.WithContext(ctx)
+
+	options = options.WithDefaults()
+
+	if !options.Units.Valid() {
+		log.Warnf("Unsupported units requested: %s", options.Units)
+		return models.WeatherOptions{}, domainerrors.ErrInvalidUnits
+	}
+
+	if !langPattern.MatchString(options.Lang) {
+		log.Warnf("Unsupported language requested: %s", options.Lang)
+		return models.WeatherOptions{}, domainerrors.ErrInvalidLang
+	}
+
+	return options, nil
+}
+
+func latest(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
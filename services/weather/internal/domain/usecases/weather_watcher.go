@@ -0,0 +1,205 @@
+package usecases
+
+import (
+	"context"
+	"sync"
+	"time"
+	"weather-forecast/pkg/logger"
+	domainerrors "weather-service/internal/domain/errors"
+	"weather-service/internal/domain/models"
+)
+
+const (
+	MaxWatchedCities   = 20
+	watchUpdatesBuffer = 16
+)
+
+type (
+	WeatherFetcher interface {
+		ResolveCity(ctx context.Context, city string) (*models.Location, error)
+		GetWeatherByLocation(ctx context.Context, location models.Location, options models.WeatherOptions) (*models.Weather, error)
+	}
+
+	watchSubscription struct {
+		updates chan models.WeatherUpdate
+	}
+
+	watchedLocation struct {
+		location    models.Location
+		last        *models.Weather
+		subscribers map[*watchSubscription]struct{}
+	}
+
+	// WeatherWatcher keeps the last weather of every watched location and
+	// refreshes all of them from a single loop, no matter how many clients
+	// watch the same city. Subscribers are notified only when the value changes.
+	WeatherWatcher struct {
+		fetcher  WeatherFetcher
+		interval time.Duration
+		mu       sync.Mutex
+		watched  map[string]*watchedLocation
+		logger   logger.Logger
+	}
+)
+
+func NewWeatherWatcher(fetcher WeatherFetcher, interval time.Duration, logger logger.Logger) *WeatherWatcher {
+	return &WeatherWatcher{
+		fetcher:  fetcher,
+		interval: interval,
+		watched:  make(map[string]*watchedLocation),
+		logger:   logger,
+	}
+}
+
+// Watch subscribes to updates of the cities until ctx is done, then the
+// returned channel is closed.
+func (w *WeatherWatcher) Watch(ctx context.Context, cities []string) (<-chan models.WeatherUpdate, error) {
+	log := w.logger.WithContext(ctx)
+
+	if len(cities) == 0 {
+		return nil, domainerrors.ErrEmptyBatch
+	}
+
+	if len(cities) > MaxWatchedCities {
+		log.Warnf("Watch of %d cities exceeds limit of %d", len(cities), MaxWatchedCities)
+		return nil, domainerrors.ErrTooManyWatched
+	}
+
+	locations := make(map[string]models.Location, len(cities))
+	for _, city := range cities {
+		location, err := w.fetcher.ResolveCity(ctx, city)
+		if err != nil {
+			return nil, err
+		}
+		locations[location.ID] = *location
+	}
+
+	subscription := &watchSubscription{
+		updates: make(chan models.WeatherUpdate, watchUpdatesBuffer),
+	}
+
+	var unknown []models.Location
+
+	w.mu.Lock()
+	for id, location := range locations {
+		watched, ok := w.watched[id]
+		if !ok {
+			watched = &watchedLocation{
+				location:    location,
+				subscribers: make(map[*watchSubscription]struct{}),
+			}
+			w.watched[id] = watched
+		}
+		watched.subscribers[subscription] = struct{}{}
+
+		if watched.last != nil {
+			w.notify(ctx, subscription, watched)
+		} else {
+			unknown = append(unknown, location)
+		}
+	}
+	w.mu.Unlock()
+
+	log.Infof("Watching %d locations, %d watched in total", len(locations), w.watchedCount())
+
+	go func() {
+		for _, location := range unknown {
+			w.refresh(ctx, location)
+		}
+	}()
+
+	go func() {
+		<-ctx.Done()
+		w.unsubscribe(subscription, locations)
+	}()
+
+	return subscription.updates, nil
+}
+
+// Run refreshes watched locations every interval until ctx is done.
+func (w *WeatherWatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			w.mu.Lock()
+			locations := make([]models.Location, 0, len(w.watched))
+			for _, watched := range w.watched {
+				locations = append(locations, watched.location)
+			}
+			w.mu.Unlock()
+
+			for _, location := range locations {
+				w.refresh(ctx, location)
+			}
+
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (w *WeatherWatcher) refresh(ctx context.Context, location models.Location) {
+	log := w.logger.WithContext(ctx)
+
+	weather, err := w.fetcher.GetWeatherByLocation(ctx, location, models.WeatherOptions{})
+	if err != nil {
+		log.Warnf("Failed to refresh watched weather for %s: %v", location.ID, err)
+		return
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	watched, ok := w.watched[location.ID]
+	if !ok || (watched.last != nil && *watched.last == *weather) {
+		return
+	}
+
+	watched.last = weather
+	for subscription := range watched.subscribers {
+		w.notify(ctx, subscription, watched)
+	}
+}
+
+// notify must be called with mu held, a slow subscriber misses the update
+// instead of blocking the refresh loop.
+func (w *WeatherWatcher) notify(ctx context.Context, subscription *watchSubscription, watched *watchedLocation) {
+	update := models.WeatherUpdate{
+		Location: watched.location,
+		Weather:  *watched.last,
+	}
+
+	select {
+	case subscription.updates <- update:
+	default:
+		w.logger.WithContext(ctx).Warnf("Watch subscriber is too slow, dropping update for %s", watched.location.ID)
+	}
+}
+
+func (w *WeatherWatcher) unsubscribe(subscription *watchSubscription, locations map[string]models.Location) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	for id := range locations {
+		watched, ok := w.watched[id]
+		if !ok {
+			continue
+		}
+
+		delete(watched.subscribers, subscription)
+		if len(watched.subscribers) == 0 {
+			delete(w.watched, id)
+		}
+	}
+
+	close(subscription.updates)
+}
+
+func (w *WeatherWatcher) watchedCount() int {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return len(w.watched)
+}
@@ -3,6 +3,7 @@ package cache
 import (
 	"context"
 	"encoding/json"
+	"strconv"
 	"time"
 	"weather-forecast/pkg/logger"
 	infraerrors "weather-service/internal/infrastructure/errors"
@@ -10,7 +11,10 @@ import (
 	"github.com/redis/go-redis/v9"
 )
 
-const RedisTimeout = 5 * time.Second
+const (
+	RedisTimeout  = 5 * time.Second
+	scanBatchSize = 500
+)
 
 type (
 	Redis struct {
@@ -49,33 +53,169 @@ func (c *Redis) Set(ctx context.Context, key string, value interface{}, expirati
 		return infraerrors.ErrInternal
 	}
 
-	if err := c.client.Set(ctx, key, data, expiration).Err(); err != nil {
-		log.Warnf("Set cache key %s:%s", key, err.Error())
-		return infraerrors.ErrCache
+	return c.SetRaw(ctx, key, data, expiration)
+}
+
+func (c *Redis) Get(ctx context.Context, key string, value interface{}) error {
+
+	log := c.logger.WithContext(ctx)
+
+	data, err := c.GetRaw(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(data, value); err != nil {
+		log.Warnf("Unmarshal cache:%s", err.Error())
+		return infraerrors.ErrInternal
+
 	}
 
 	return nil
 }
 
-func (c *Redis) Get(ctx context.Context, key string, value interface{}) error {
+func (c *Redis) SetRaw(ctx context.Context, key string, data []byte, expiration time.Duration) error {
+	if err := c.client.Set(ctx, key, data, expiration).Err(); err != nil {
+		c.logger.WithContext(ctx).Warnf("Set cache key %s:%s", key, err.Error())
+		return infraerrors.ErrCache
+	}
 
-	log := c.logger.WithContext(ctx)
+	return nil
+}
 
-	res, err := c.client.Get(ctx, key).Result()
+func (c *Redis) GetRaw(ctx context.Context, key string) ([]byte, error) {
+	data, err := c.client.Get(ctx, key).Bytes()
 	if err != nil {
 		if err == redis.Nil {
-			return infraerrors.ErrCacheMiss
+			return nil, infraerrors.ErrCacheMiss
 		}
 
-		log.Warnf("Get cache key %s:%s", key, err.Error())
+		c.logger.WithContext(ctx).Warnf("Get cache key %s:%s", key, err.Error())
+		return nil, infraerrors.ErrCache
+	}
+
+	return data, nil
+}
+
+// Keys lists the keys matching the glob pattern, walking the keyspace with
+// SCAN so that Redis is not blocked on large databases.
+func (c *Redis) Keys(ctx context.Context, pattern string) ([]string, error) {
+	var keys []string
+
+	iter := c.client.Scan(ctx, 0, pattern, scanBatchSize).Iterator()
+	for iter.Next(ctx) {
+		keys = append(keys, iter.Val())
+	}
+	if err := iter.Err(); err != nil {
+		c.logger.WithContext(ctx).Warnf("Scan cache keys %s:%s", pattern, err.Error())
+		return nil, infraerrors.ErrCache
+	}
+
+	return keys, nil
+}
+
+func (c *Redis) Delete(ctx context.Context, keys ...string) error {
+	if err := c.client.Del(ctx, keys...).Err(); err != nil {
+		c.logger.WithContext(ctx).Warnf("Delete %d cache keys:%s", len(keys), err.Error())
 		return infraerrors.ErrCache
 	}
 
-	if err := json.Unmarshal([]byte(res), value); err != nil {
-		log.Warnf("Unmarshal cache:%s", err.Error())
-		return infraerrors.ErrInternal
+	return nil
+}
 
+func (c *Redis) Publish(ctx context.Context, channel string, message string) error {
+	if err := c.client.Publish(ctx, channel, message).Err(); err != nil {
+		c.logger.WithContext(ctx).Warnf("Publish to %s:%s", channel, err.Error())
+		return infraerrors.ErrCache
 	}
 
 	return nil
 }
+
+func (c *Redis) Subscribe(ctx context.Context, channel string) <-chan string {
+	pubsub := c.client.Subscribe(ctx, channel)
+	messages := make(chan string)
+
+	go func() {
+		defer close(messages)
+		defer pubsub.Close()
+
+		incoming := pubsub.Channel()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case msg, ok := <-incoming:
+				if !ok {
+					return
+				}
+				select {
+				case messages <- msg.Payload:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return messages
+}
+
+func (c *Redis) Increment(ctx context.Context, key string, expiration time.Duration) (int64, error) {
+	var incr *redis.IntCmd
+	_, err := c.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		incr = pipe.Incr(ctx, key)
+		pipe.ExpireNX(ctx, key, expiration)
+		return nil
+	})
+	if err != nil {
+		c.logger.WithContext(ctx).Warnf("Increment counter %s:%s", key, err.Error())
+		return 0, infraerrors.ErrCache
+	}
+
+	return incr.Val(), nil
+}
+
+func (c *Redis) Decrement(ctx context.Context, key string) error {
+	if err := c.client.Decr(ctx, key).Err(); err != nil {
+		c.logger.WithContext(ctx).Warnf("Decrement counter %s:%s", key, err.Error())
+		return infraerrors.ErrCache
+	}
+
+	return nil
+}
+
+// AddToTimeline stores data in the sorted set at key scored by at and drops
+// the entries older than retention.
+func (c *Redis) AddToTimeline(ctx context.Context, key string, at time.Time, data []byte, retention time.Duration) error {
+	_, err := c.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.ZAdd(ctx, key, redis.Z{Score: float64(at.UnixMilli()), Member: data})
+		pipe.ZRemRangeByScore(ctx, key, "-inf", "("+strconv.FormatInt(time.Now().Add(-retention).UnixMilli(), 10))
+		pipe.Expire(ctx, key, retention)
+		return nil
+	})
+	if err != nil {
+		c.logger.WithContext(ctx).Warnf("Add to timeline %s:%s", key, err.Error())
+		return infraerrors.ErrCache
+	}
+
+	return nil
+}
+
+func (c *Redis) ReadTimeline(ctx context.Context, key string, from, to time.Time) ([][]byte, error) {
+	members, err := c.client.ZRangeByScore(ctx, key, &redis.ZRangeBy{
+		Min: strconv.FormatInt(from.UnixMilli(), 10),
+		Max: strconv.FormatInt(to.UnixMilli(), 10),
+	}).Result()
+	if err != nil {
+		c.logger.WithContext(ctx).Warnf("Read timeline %s:%s", key, err.Error())
+		return nil, infraerrors.ErrCache
+	}
+
+	data := make([][]byte, 0, len(members))
+	for _, member := range members {
+		data = append(data, []byte(member))
+	}
+
+	return data, nil
+}
@@ -0,0 +1,160 @@
+package cache
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+	"weather-forecast/pkg/logger"
+	infraerrors "weather-service/internal/infrastructure/errors"
+)
+
+const (
+	MemoryTier = "memory"
+	RedisTier  = "redis"
+
+	InvalidationChannel = "weather:cache:invalidate"
+)
+
+type (
+	RemoteCache interface {
+		GetRaw(ctx context.Context, key string) ([]byte, error)
+		SetRaw(ctx context.Context, key string, data []byte, expiration time.Duration) error
+		Keys(ctx context.Context, pattern string) ([]string, error)
+		Delete(ctx context.Context, keys ...string) error
+	}
+
+	InvalidationBus interface {
+		Publish(ctx context.Context, channel string, message string) error
+		Subscribe(ctx context.Context, channel string) <-chan string
+	}
+
+	TierMetricsRecorder interface {
+		RecordTierHit(tier string)
+		RecordTierMiss(tier string)
+	}
+
+	// TwoTier keeps hot entries in a bounded in-process LRU in front of a shared
+	// remote cache. Writes are announced on InvalidationChannel so that other
+	// replicas drop their local copy instead of serving it until it expires.
+	TwoTier struct {
+		local      *LRU
+		remote     RemoteCache
+		bus        InvalidationBus
+		metrics    TierMetricsRecorder
+		ttl        time.Duration
+		instanceID string
+		logger     logger.Logger
+	}
+)
+
+func NewTwoTier(local *LRU, remote RemoteCache, bus InvalidationBus, metrics TierMetricsRecorder, ttl time.Duration, logger logger.Logger) *TwoTier {
+	return &TwoTier{
+		local:      local,
+		remote:     remote,
+		bus:        bus,
+		metrics:    metrics,
+		ttl:        ttl,
+		instanceID: newInstanceID(),
+		logger:     logger,
+	}
+}
+
+func (c *TwoTier) Get(ctx context.Context, key string, value interface{}) error {
+	if data, ok := c.local.Get(key); ok {
+		c.metrics.RecordTierHit(MemoryTier)
+		return c.decode(ctx, data, value)
+	}
+	c.metrics.RecordTierMiss(MemoryTier)
+
+	data, err := c.remote.GetRaw(ctx, key)
+	if err != nil {
+		if errors.Is(err, infraerrors.ErrCacheMiss) {
+			c.metrics.RecordTierMiss(RedisTier)
+		}
+		return err
+	}
+	c.metrics.RecordTierHit(RedisTier)
+
+	c.local.Set(key, data, c.ttl)
+
+	return c.decode(ctx, data, value)
+}
+
+func (c *TwoTier) Set(ctx context.Context, key string, value interface{}, expiration time.Duration) error {
+	log := c.logger.WithContext(ctx)
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		log.Warnf("Marshal cache:%s", err.Error())
+		return infraerrors.ErrInternal
+	}
+
+	if err := c.remote.SetRaw(ctx, key, data, expiration); err != nil {
+		c.local.Delete(key)
+		return err
+	}
+
+	ttl := c.ttl
+	if expiration > 0 && expiration < ttl {
+		ttl = expiration
+	}
+	c.local.Set(key, data, ttl)
+
+	if err := c.bus.Publish(ctx, InvalidationChannel, c.instanceID+" "+key); err != nil {
+		log.Warnf("Announce cache invalidation for %s:%s", key, err.Error())
+	}
+
+	return nil
+}
+
+func (c *TwoTier) Keys(ctx context.Context, pattern string) ([]string, error) {
+	return c.remote.Keys(ctx, pattern)
+}
+
+// Delete removes the keys from both tiers and announces them, like writes.
+func (c *TwoTier) Delete(ctx context.Context, keys ...string) error {
+	log := c.logger.WithContext(ctx)
+
+	if err := c.remote.Delete(ctx, keys...); err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		c.local.Delete(key)
+
+		if err := c.bus.Publish(ctx, InvalidationChannel, c.instanceID+" "+key); err != nil {
+			log.Warnf("Announce cache invalidation for %s:%s", key, err.Error())
+		}
+	}
+
+	return nil
+}
+
+func (c *TwoTier) Run(ctx context.Context) {
+	for message := range c.bus.Subscribe(ctx, InvalidationChannel) {
+		origin, key, ok := strings.Cut(message, " ")
+		if !ok || origin == c.instanceID {
+			continue
+		}
+		c.local.Delete(key)
+	}
+}
+
+func (c *TwoTier) decode(ctx context.Context, data []byte, value interface{}) error {
+	if err := json.Unmarshal(data, value); err != nil {
+		c.logger.WithContext(ctx).Warnf("Unmarshal cache:%s", err.Error())
+		return infraerrors.ErrInternal
+	}
+
+	return nil
+}
+
+func newInstanceID() string {
+	id := make([]byte, 8)
+	_, _ = rand.Read(id)
+	return hex.EncodeToString(id)
+}
@@ -0,0 +1,326 @@
+package openmeteo
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+	"weather-forecast/pkg/logger"
+	"weather-service/internal/config"
+	"weather-service/internal/domain/models"
+	"weather-service/internal/infrastructure/cache"
+	infraerrors "weather-service/internal/infrastructure/errors"
+)
+
+const (
+	currentVariables    = "temperature_2m,relative_humidity_2m,apparent_temperature,weather_code,wind_speed_10m,wind_direction_10m,surface_pressure,cloud_cover,visibility"
+	dailyVariables      = "weather_code,temperature_2m_max,temperature_2m_min,precipitation_probability_max"
+	airQualityVariables = "pm2_5,pm10,ozone,nitrogen_dioxide"
+	windSpeedUnit       = "kmh"
+	autoTimezone        = "auto"
+
+	// Geocoded cities are kept for a day, at most this many of them.
+	geocodedCapacity = 1000
+	geocodedTTL      = 24 * time.Hour
+)
+
+type (
+	OpenMeteoErrorResponse struct {
+		Error  bool   `json:"error"`
+		Reason string `json:"reason"`
+	}
+
+	OpenMeteoGeocodingResult struct {
+		Name        string  `json:"name"`
+		Latitude    float64 `json:"latitude"`
+		Longitude   float64 `json:"longitude"`
+		Country     string  `json:"country"`
+		CountryCode string  `json:"country_code"`
+	}
+
+	OpenMeteoGeocodingResponse struct {
+		Results []OpenMeteoGeocodingResult `json:"results"`
+	}
+
+	OpenMeteoCurrentResponse struct {
+		Temperature         float64 `json:"temperature_2m"`
+		RelativeHumidity    int     `json:"relative_humidity_2m"`
+		ApparentTemperature float64 `json:"apparent_temperature"`
+		WeatherCode         int     `json:"weather_code"`
+		WindSpeed           float64 `json:"wind_speed_10m"`
+		WindDirection       int     `json:"wind_direction_10m"`
+		SurfacePressure     float64 `json:"surface_pressure"`
+		CloudCover          int     `json:"cloud_cover"`
+		Visibility          float64 `json:"visibility"`
+	}
+
+	OpenMeteoWeatherResponse struct {
+		Current OpenMeteoCurrentResponse `json:"current"`
+	}
+
+	OpenMeteoDailyResponse struct {
+		Time                        []string  `json:"time"`
+		WeatherCode                 []int     `json:"weather_code"`
+		TemperatureMax              []float64 `json:"temperature_2m_max"`
+		TemperatureMin              []float64 `json:"temperature_2m_min"`
+		PrecipitationProbabilityMax []int     `json:"precipitation_probability_max"`
+	}
+
+	OpenMeteoForecastResponse struct {
+		Daily OpenMeteoDailyResponse `json:"daily"`
+	}
+
+	OpenMeteoAirQualityCurrentResponse struct {
+		PM25 float64 `json:"pm2_5"`
+		PM10 float64 `json:"pm10"`
+		O3   float64 `json:"ozone"`
+		NO2  float64 `json:"nitrogen_dioxide"`
+	}
+
+	OpenMeteoAirQualityResponse struct {
+		Current OpenMeteoAirQualityCurrentResponse `json:"current"`
+	}
+
+	OpenMeteoClient struct {
+		apiURL        string
+		geocodingURL  string
+		airQualityURL string
+		client        *http.Client
+		geocoded      *cache.LRU
+		logger        logger.Logger
+	}
+)
+
+func NewClient(cfg *config.Config, client *http.Client, logger logger.Logger) *OpenMeteoClient {
+	return &OpenMeteoClient{
+		apiURL:        cfg.OpenMeteoURL,
+		geocodingURL:  cfg.OpenMeteoGeocodingURL,
+		airQualityURL: cfg.OpenMeteoAirQualityURL,
+		client:        client,
+		geocoded:      cache.NewLRU(geocodedCapacity),
+		logger:        logger,
+	}
+}
+
+func (c *OpenMeteoClient) GetWeather(ctx context.Context, location models.Location) (*OpenMeteoWeatherResponse, error) {
+	log := c.logger.WithContext(ctx)
+
+	log.Infof("Calling Open-Meteo API for city: %s", location.Name)
+
+	coordinates, err := c.coordinates(ctx, location)
+	if err != nil {
+		return nil, err
+	}
+
+	params := url.Values{}
+	params.Set("current", currentVariables)
+
+	var weatherResponse OpenMeteoWeatherResponse
+
+	if err := c.fetchForecast(ctx, coordinates, params, &weatherResponse); err != nil {
+		return nil, err
+	}
+
+	log.Infof("Successfully received weather from Open-Meteo for city: %s", location.Name)
+
+	return &weatherResponse, nil
+}
+
+func (c *OpenMeteoClient) GetForecast(ctx context.Context, location models.Location, days int) (*OpenMeteoForecastResponse, error) {
+	log := c.logger.WithContext(ctx)
+
+	log.Infof("Calling Open-Meteo forecast API for city: %s, days: %d", location.Name, days)
+
+	coordinates, err := c.coordinates(ctx, location)
+	if err != nil {
+		return nil, err
+	}
+
+	params := url.Values{}
+	params.Set("daily", dailyVariables)
+	params.Set("forecast_days", strconv.Itoa(days))
+	params.Set("timezone", autoTimezone)
+
+	var forecastResponse OpenMeteoForecastResponse
+
+	if err := c.fetchForecast(ctx, coordinates, params, &forecastResponse); err != nil {
+		return nil, err
+	}
+
+	log.Infof("Successfully received forecast from Open-Meteo for city: %s", location.Name)
+
+	return &forecastResponse, nil
+}
+
+func (c *OpenMeteoClient) GetAirQuality(ctx context.Context, location models.Location) (*OpenMeteoAirQualityResponse, error) {
+	log := c.logger.WithContext(ctx)
+
+	log.Infof("Calling Open-Meteo air quality API for city: %s", location.Name)
+
+	coordinates, err := c.coordinates(ctx, location)
+	if err != nil {
+		return nil, err
+	}
+
+	params := url.Values{}
+	params.Set("current", airQualityVariables)
+	params.Set("latitude", strconv.FormatFloat(coordinates.Latitude, 'f', -1, 64))
+	params.Set("longitude", strconv.FormatFloat(coordinates.Longitude, 'f', -1, 64))
+
+	var airQualityResponse OpenMeteoAirQualityResponse
+
+	if err := c.fetch(ctx, c.airQualityURL, params, &airQualityResponse); err != nil {
+		return nil, err
+	}
+
+	log.Infof("Successfully received air quality from Open-Meteo for city: %s", location.Name)
+
+	return &airQualityResponse, nil
+}
+
+// SearchCities asks the Open-Meteo geocoding API for at most count cities whose
+// name starts with name.
+func (c *OpenMeteoClient) SearchCities(ctx context.Context, name string, count int) ([]OpenMeteoGeocodingResult, error) {
+	log := c.logger.WithContext(ctx)
+
+	params := url.Values{}
+	params.Set("name", name)
+	params.Set("count", strconv.Itoa(count))
+
+	var geocodingResponse OpenMeteoGeocodingResponse
+
+	if err := c.fetch(ctx, c.geocodingURL, params, &geocodingResponse); err != nil {
+		return nil, err
+	}
+
+	log.Debugf("Open-Meteo geocoding found %d cities for %q", len(geocodingResponse.Results), name)
+
+	return geocodingResponse.Results, nil
+}
+
+// coordinates takes the coordinates of a known location, other cities are
+// geocoded by name and remembered for a while under the normalized name.
+func (c *OpenMeteoClient) coordinates(ctx context.Context, location models.Location) (models.Coordinates, error) {
+	log := c.logger.WithContext(ctx)
+
+	if location.Coordinates != nil {
+		return *location.Coordinates, nil
+	}
+
+	key := strings.ToLower(strings.Join(strings.Fields(location.Name), " "))
+	if data, ok := c.geocoded.Get(key); ok {
+		var coordinates models.Coordinates
+		if err := json.Unmarshal(data, &coordinates); err == nil {
+			return coordinates, nil
+		}
+	}
+
+	params := url.Values{}
+	params.Set("name", location.Name)
+	params.Set("count", "1")
+
+	var geocodingResponse OpenMeteoGeocodingResponse
+
+	if err := c.fetch(ctx, c.geocodingURL, params, &geocodingResponse); err != nil {
+		return models.Coordinates{}, err
+	}
+
+	if len(geocodingResponse.Results) == 0 {
+		log.Warnf("City not found: %s", location.Name)
+		return models.Coordinates{}, infraerrors.ErrCityNotFound
+	}
+
+	result := geocodingResponse.Results[0]
+	coordinates := models.Coordinates{
+		Latitude:  result.Latitude,
+		Longitude: result.Longitude,
+	}
+	if data, err := json.Marshal(coordinates); err == nil {
+		c.geocoded.Set(key, data, geocodedTTL)
+	}
+
+	log.Debugf("City %s geocoded by Open-Meteo as %s, %s", location.Name, result.Name, result.Country)
+
+	return coordinates, nil
+}
+
+func (c *OpenMeteoClient) fetchForecast(ctx context.Context, coordinates models.Coordinates, params url.Values, target interface{}) error {
+	params.Set("latitude", strconv.FormatFloat(coordinates.Latitude, 'f', -1, 64))
+	params.Set("longitude", strconv.FormatFloat(coordinates.Longitude, 'f', -1, 64))
+	params.Set("wind_speed_unit", windSpeedUnit)
+
+	return c.fetch(ctx, c.apiURL, params, target)
+}
+
+func (c *OpenMeteoClient) fetch(ctx context.Context, apiURL string, params url.Values, target interface{}) error {
+	log := c.logger.WithContext(ctx)
+
+	url, err := url.Parse(apiURL)
+	if err != nil {
+		log.Warnf("Form url: %s", err.Error())
+		return infraerrors.ErrGetWeather
+	}
+	queryString := url.Query()
+	for name, values := range params {
+		for _, value := range values {
+			queryString.Add(name, value)
+		}
+	}
+	url.RawQuery = queryString.Encode()
+	stringURL := url.String()
+
+	log.Debugf("Making request to Open-Meteo: %s", stringURL)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, stringURL, nil)
+	if err != nil {
+		log.Warnf("Failed to create get weather request: %s", err.Error())
+		return infraerrors.ErrGetWeather
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		log.Warnf("Failed make get weather request: %s", err.Error())
+		if errors.Is(err, context.DeadlineExceeded) || os.IsTimeout(err) {
+			return infraerrors.ErrProviderTimeout
+		}
+		return infraerrors.ErrGetWeather
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			log.Warnf("Failed to close response body: %s", err.Error())
+		}
+	}()
+
+	log.Debugf("Open-Meteo API responded with status: %d", resp.StatusCode)
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		log.Warnf("Failed to read response body: %s", err.Error())
+		return infraerrors.ErrGetWeather
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		var errResponse OpenMeteoErrorResponse
+
+		if err := json.Unmarshal(body, &errResponse); err != nil {
+			log.Warnf("Failed to unmarshal response body: %s", err.Error())
+			return infraerrors.ErrGetWeather
+		}
+
+		log.Warnf("Error from Open-Meteo: %s", errResponse.Reason)
+		return infraerrors.ErrGetWeather
+	}
+
+	if err := json.Unmarshal(body, target); err != nil {
+		log.Warnf("Failed to unmarshal response body: %s", err.Error())
+		return infraerrors.ErrGetWeather
+	}
+
+	return nil
+}
@@ -3,12 +3,23 @@ package openweather
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"io"
 	"net/http"
 	"net/url"
+	"os"
+	"strconv"
 	"weather-forecast/pkg/logger"
 	"weather-service/internal/config"
+	"weather-service/internal/domain/models"
 	infraerrors "weather-service/internal/infrastructure/errors"
+	"weather-service/internal/infrastructure/roundtrip"
+)
+
+const (
+	notFoundOpenWeatherErrorCode = "404"
+	forecastStepsPerDay          = 8
+	alertsOnlyExclude            = "current,minutely,hourly,daily"
 )
 
 type (
@@ -20,6 +31,17 @@ type (
 	OpenWeatherMainResponse struct {
 		Temperature float64 `json:"temp"`
 		Humidity    int     `json:"humidity"`
+		FeelsLike   float64 `json:"feels_like"`
+		Pressure    float64 `json:"pressure"`
+	}
+
+	OpenWeatherWindResponse struct {
+		Speed  float64 `json:"speed"`
+		Degree int     `json:"deg"`
+	}
+
+	OpenWeatherCloudsResponse struct {
+		All int `json:"all"`
 	}
 
 	OpenWeatherDescriptionResponse struct {
@@ -27,58 +49,213 @@ type (
 	}
 
 	OpenWeatherSuccessResponse struct {
-		Weather []OpenWeatherDescriptionResponse `json:"weather"`
-		Main    OpenWeatherMainResponse          `json:"main"`
+		Weather    []OpenWeatherDescriptionResponse `json:"weather"`
+		Main       OpenWeatherMainResponse          `json:"main"`
+		Wind       OpenWeatherWindResponse          `json:"wind"`
+		Clouds     OpenWeatherCloudsResponse        `json:"clouds"`
+		Visibility int                              `json:"visibility"`
+	}
+
+	OpenWeatherForecastMainResponse struct {
+		TempMin float64 `json:"temp_min"`
+		TempMax float64 `json:"temp_max"`
+	}
+
+	OpenWeatherForecastItemResponse struct {
+		Timestamp                int64                            `json:"dt"`
+		Main                     OpenWeatherForecastMainResponse  `json:"main"`
+		Weather                  []OpenWeatherDescriptionResponse `json:"weather"`
+		PrecipitationProbability float64                          `json:"pop"`
+	}
+
+	OpenWeatherForecastCityResponse struct {
+		Timezone int `json:"timezone"`
+	}
+
+	OpenWeatherForecastResponse struct {
+		List []OpenWeatherForecastItemResponse `json:"list"`
+		City OpenWeatherForecastCityResponse   `json:"city"`
+	}
+
+	OpenWeatherAlertResponse struct {
+		SenderName  string `json:"sender_name"`
+		Event       string `json:"event"`
+		Start       int64  `json:"start"`
+		End         int64  `json:"end"`
+		Description string `json:"description"`
+	}
+
+	OpenWeatherAlertsResponse struct {
+		Alerts []OpenWeatherAlertResponse `json:"alerts"`
+	}
+
+	OpenWeatherAirComponentsResponse struct {
+		PM25 float64 `json:"pm2_5"`
+		PM10 float64 `json:"pm10"`
+		O3   float64 `json:"o3"`
+		NO2  float64 `json:"no2"`
+	}
+
+	OpenWeatherAirQualityItemResponse struct {
+		Components OpenWeatherAirComponentsResponse `json:"components"`
+	}
+
+	OpenWeatherAirQualityResponse struct {
+		List []OpenWeatherAirQualityItemResponse `json:"list"`
 	}
 
 	OpenWeatherClient struct {
-		apiURL string
-		apiKey string
-		client *http.Client
-		logger logger.Logger
+		apiURL        string
+		forecastURL   string
+		alertsURL     string
+		airQualityURL string
+		apiKey        string
+		client        *http.Client
+		logger        logger.Logger
 	}
 )
 
 func NewClient(cfg *config.Config, client *http.Client, logger logger.Logger) *OpenWeatherClient {
 	return &OpenWeatherClient{
-		apiURL: cfg.OpenWeatherURL,
-		apiKey: cfg.OpenWeatherKey,
-		client: client,
-		logger: logger}
+		apiURL:        cfg.OpenWeatherURL,
+		forecastURL:   cfg.OpenWeatherForecastURL,
+		alertsURL:     cfg.OpenWeatherAlertsURL,
+		airQualityURL: cfg.OpenWeatherAirQualityURL,
+		apiKey:        cfg.OpenWeatherKey,
+		client:        client,
+		logger:        logger}
+}
+
+func (c *OpenWeatherClient) GetWeather(ctx context.Context, location models.Location, options models.WeatherOptions) (*OpenWeatherSuccessResponse, error) {
+	log := c.logger.WithContext(ctx)
+
+	log.Infof("Calling OpenWeather API for city: %s", location.Name)
+
+	params := url.Values{}
+	params.Set("units", string(options.Units))
+	params.Set("lang", options.Lang)
+
+	var weatherResponse OpenWeatherSuccessResponse
+
+	if err := c.fetch(ctx, c.apiURL, location, params, &weatherResponse); err != nil {
+		return nil, err
+	}
+
+	log.Infof("Successfully received weather from OpenWeather for city: %s", location.Name)
+
+	return &weatherResponse, nil
+
+}
+
+// GetForecast requests the 3-hour step forecast, so the amount of steps
+// is derived from the requested amount of days.
+func (c *OpenWeatherClient) GetForecast(ctx context.Context, location models.Location, days int) (*OpenWeatherForecastResponse, error) {
+	log := c.logger.WithContext(ctx)
+
+	log.Infof("Calling OpenWeather forecast API for city: %s, days: %d", location.Name, days)
+
+	params := url.Values{}
+	params.Set("cnt", strconv.Itoa(days*forecastStepsPerDay))
+	params.Set("units", string(models.UnitsMetric))
+
+	var forecastResponse OpenWeatherForecastResponse
+
+	if err := c.fetch(ctx, c.forecastURL, location, params, &forecastResponse); err != nil {
+		return nil, err
+	}
+
+	log.Infof("Successfully received forecast from OpenWeather for city: %s", location.Name)
+
+	return &forecastResponse, nil
 }
 
-func (c *OpenWeatherClient) GetWeather(ctx context.Context, city string) (*OpenWeatherSuccessResponse, error) {
+// GetAlerts uses the One Call API, which only accepts coordinates, so alerts
+// for cities unknown to the resolver are not supported.
+func (c *OpenWeatherClient) GetAlerts(ctx context.Context, location models.Location) (*OpenWeatherAlertsResponse, error) {
 	log := c.logger.WithContext(ctx)
 
-	log.Infof("Calling OpenWeather API for city: %s", city)
+	if location.Coordinates == nil {
+		log.Debugf("OpenWeather alerts require coordinates, city: %s", location.Name)
+		return nil, infraerrors.ErrAlertsUnsupported
+	}
+
+	log.Infof("Calling OpenWeather alerts API for city: %s", location.Name)
+
+	params := url.Values{}
+	params.Set("exclude", alertsOnlyExclude)
+
+	var alertsResponse OpenWeatherAlertsResponse
+
+	if err := c.fetch(ctx, c.alertsURL, location, params, &alertsResponse); err != nil {
+		return nil, err
+	}
+
+	log.Infof("Successfully received %d alerts from OpenWeather for city: %s", len(alertsResponse.Alerts), location.Name)
 
-	const notFoundOpenWeatherErrorCode = "404"
-	const metricUnits = "metric"
+	return &alertsResponse, nil
+}
 
-	url, err := url.Parse(c.apiURL)
+// GetAirQuality uses the Air Pollution API, which like One Call only accepts coordinates.
+func (c *OpenWeatherClient) GetAirQuality(ctx context.Context, location models.Location) (*OpenWeatherAirQualityResponse, error) {
+	log := c.logger.WithContext(ctx)
+
+	if location.Coordinates == nil {
+		log.Debugf("OpenWeather air quality requires coordinates, city: %s", location.Name)
+		return nil, infraerrors.ErrAirQualityUnsupported
+	}
+
+	log.Infof("Calling OpenWeather air pollution API for city: %s", location.Name)
+
+	var airQualityResponse OpenWeatherAirQualityResponse
+
+	if err := c.fetch(ctx, c.airQualityURL, location, url.Values{}, &airQualityResponse); err != nil {
+		return nil, err
+	}
+
+	log.Infof("Successfully received air quality from OpenWeather for city: %s", location.Name)
+
+	return &airQualityResponse, nil
+}
+
+func (c *OpenWeatherClient) fetch(ctx context.Context, apiURL string, location models.Location, params url.Values, target interface{}) error {
+	log := c.logger.WithContext(ctx)
+
+	url, err := url.Parse(apiURL)
 	if err != nil {
 		log.Warnf("Form url: %s", err.Error())
-		return nil, infraerrors.ErrGetWeather
+		return infraerrors.ErrGetWeather
 	}
 	queryString := url.Query()
-	queryString.Set("q", city)
+	if location.Coordinates != nil {
+		queryString.Set("lat", strconv.FormatFloat(location.Coordinates.Latitude, 'f', -1, 64))
+		queryString.Set("lon", strconv.FormatFloat(location.Coordinates.Longitude, 'f', -1, 64))
+	} else {
+		queryString.Set("q", location.Name)
+	}
 	queryString.Set("appid", c.apiKey)
-	queryString.Set("units", metricUnits)
+	for name, values := range params {
+		for _, value := range values {
+			queryString.Add(name, value)
+		}
+	}
 	url.RawQuery = queryString.Encode()
 	stringURL := url.String()
 
-	log.Debugf("Making request to OpenWeather: %s", stringURL)
+	log.Debugf("Making request to OpenWeather: %s", roundtrip.RedactURL(url))
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, stringURL, nil)
 	if err != nil {
 		log.Warnf("Failed to create get weather request: %s", err.Error())
-		return nil, infraerrors.ErrGetWeather
+		return infraerrors.ErrGetWeather
 	}
 
 	resp, err := c.client.Do(req)
 	if err != nil {
-		log.Warnf("Failed make get weather request: %s", err.Error())
-		return nil, infraerrors.ErrGetWeather
+		log.Warnf("Failed make get weather request: %s", roundtrip.RedactError(err).Error())
+		if errors.Is(err, context.DeadlineExceeded) || os.IsTimeout(err) {
+			return infraerrors.ErrProviderTimeout
+		}
+		return infraerrors.ErrGetWeather
 	}
 	defer func() {
 		if err := resp.Body.Close(); err != nil {
@@ -91,7 +268,7 @@ func (c *OpenWeatherClient) GetWeather(ctx context.Context, city string) (*OpenW
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
 		log.Warnf("Failed to read response body: %s", err.Error())
-		return nil, infraerrors.ErrGetWeather
+		return infraerrors.ErrGetWeather
 	}
 
 	if resp.StatusCode != http.StatusOK {
@@ -99,29 +276,24 @@ func (c *OpenWeatherClient) GetWeather(ctx context.Context, city string) (*OpenW
 
 		if err := json.Unmarshal(body, &errResponse); err != nil {
 			log.Warnf("Failed to unmarshal response body: %s", err.Error())
-			return nil, infraerrors.ErrGetWeather
+			return infraerrors.ErrGetWeather
 		}
 
 		if errResponse.Cod == notFoundOpenWeatherErrorCode {
 
-			log.Warnf("City not found: %s", city)
-			return nil, infraerrors.ErrCityNotFound
+			log.Warnf("City not found: %s", location.Name)
+			return infraerrors.ErrCityNotFound
 		} else {
 			log.Warnf("Error from open weather: %s", errResponse.Message)
-			return nil, infraerrors.ErrGetWeather
+			return infraerrors.ErrGetWeather
 		}
 
 	}
 
-	var weatherResponse OpenWeatherSuccessResponse
-
-	if err := json.Unmarshal(body, &weatherResponse); err != nil {
+	if err := json.Unmarshal(body, target); err != nil {
 		log.Warnf("Failed to unmarshal response body: %s", err.Error())
-		return nil, infraerrors.ErrGetWeather
+		return infraerrors.ErrGetWeather
 	}
 
-	log.Infof("Successfully received weather from OpenWeather for city: %s", city)
-
-	return &weatherResponse, nil
-
+	return nil
 }
@@ -3,29 +3,95 @@ package weatherapi
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"io"
 	"net/http"
 	"net/url"
+	"os"
+	"strconv"
 	"weather-forecast/pkg/logger"
 	"weather-service/internal/config"
+	"weather-service/internal/domain/models"
 	infraerrors "weather-service/internal/infrastructure/errors"
+	"weather-service/internal/infrastructure/roundtrip"
 )
 
+const notFoundWeatherAPIErrorCode = 1006
+
 type (
 	WeatherConditionResponse struct {
 		Text string `json:"text"`
 	}
 
 	WeatherCurrentResponse struct {
-		TempC     float64                  `json:"temp_c"`
-		Condition WeatherConditionResponse `json:"condition"`
-		Humidity  int                      `json:"humidity"`
+		TempC      float64                  `json:"temp_c"`
+		Condition  WeatherConditionResponse `json:"condition"`
+		Humidity   int                      `json:"humidity"`
+		WindKph    float64                  `json:"wind_kph"`
+		WindDegree int                      `json:"wind_degree"`
+		PressureMb float64                  `json:"pressure_mb"`
+		Cloud      int                      `json:"cloud"`
+		VisKm      float64                  `json:"vis_km"`
+		FeelsLikeC float64                  `json:"feelslike_c"`
 	}
 
 	WeatherSuccessResponse struct {
 		Current WeatherCurrentResponse `json:"current"`
 	}
 
+	WeatherForecastDayDetailsResponse struct {
+		MaxTempC          float64                  `json:"maxtemp_c"`
+		MinTempC          float64                  `json:"mintemp_c"`
+		DailyChanceOfRain int                      `json:"daily_chance_of_rain"`
+		DailyChanceOfSnow int                      `json:"daily_chance_of_snow"`
+		Condition         WeatherConditionResponse `json:"condition"`
+	}
+
+	WeatherForecastDayResponse struct {
+		Date string                            `json:"date"`
+		Day  WeatherForecastDayDetailsResponse `json:"day"`
+	}
+
+	WeatherForecastDaysResponse struct {
+		ForecastDay []WeatherForecastDayResponse `json:"forecastday"`
+	}
+
+	WeatherForecastResponse struct {
+		Forecast WeatherForecastDaysResponse `json:"forecast"`
+	}
+
+	WeatherAirQualityResponse struct {
+		PM25 float64 `json:"pm2_5"`
+		PM10 float64 `json:"pm10"`
+		O3   float64 `json:"o3"`
+		NO2  float64 `json:"no2"`
+	}
+
+	WeatherAirQualityCurrentResponse struct {
+		AirQuality WeatherAirQualityResponse `json:"air_quality"`
+	}
+
+	WeatherAirQualitySuccessResponse struct {
+		Current WeatherAirQualityCurrentResponse `json:"current"`
+	}
+
+	WeatherAlertResponse struct {
+		Headline    string `json:"headline"`
+		Severity    string `json:"severity"`
+		Event       string `json:"event"`
+		Effective   string `json:"effective"`
+		Expires     string `json:"expires"`
+		Description string `json:"desc"`
+	}
+
+	WeatherAlertListResponse struct {
+		Alert []WeatherAlertResponse `json:"alert"`
+	}
+
+	WeatherAlertsResponse struct {
+		Alerts WeatherAlertListResponse `json:"alerts"`
+	}
+
 	WeatherErrorDetails struct {
 		Code    int    `json:"code"`
 		Message string `json:"message"`
@@ -36,52 +102,136 @@ type (
 	}
 
 	WeatherAPIClient struct {
-		apiURL string
-		apiKey string
-		client *http.Client
-		logger logger.Logger
+		apiURL      string
+		forecastURL string
+		alertsURL   string
+		apiKey      string
+		client      *http.Client
+		logger      logger.Logger
 	}
 )
 
 func NewClient(cfg *config.Config, httpClient *http.Client, logger logger.Logger) *WeatherAPIClient {
 	return &WeatherAPIClient{
-		apiURL: cfg.WeatherAPIURL,
-		apiKey: cfg.WeatherAPIKey,
-		client: httpClient,
-		logger: logger,
+		apiURL:      cfg.WeatherAPIURL,
+		forecastURL: cfg.WeatherAPIForecastURL,
+		alertsURL:   cfg.WeatherAPIAlertsURL,
+		apiKey:      cfg.WeatherAPIKey,
+		client:      httpClient,
+		logger:      logger,
 	}
 }
 
-func (c *WeatherAPIClient) GetWeather(ctx context.Context, city string) (*WeatherSuccessResponse, error) {
+// GetWeather always reports metric values, lang only affects the condition text.
+func (c *WeatherAPIClient) GetWeather(ctx context.Context, location models.Location, lang string) (*WeatherSuccessResponse, error) {
 	log := c.logger.WithContext(ctx)
 
-	log.Infof("Calling WeatherAPI for city: %s", city)
+	log.Infof("Calling WeatherAPI for city: %s", location.Name)
 
-	const notFoundWeatherAPIErrorCode = 1006
+	params := url.Values{}
+	params.Set("lang", lang)
+
+	var weather WeatherSuccessResponse
 
-	url, err := url.Parse(c.apiURL)
+	if err := c.fetch(ctx, c.apiURL, location, params, &weather); err != nil {
+		return nil, err
+	}
+
+	log.Infof("Successfully received weather from WeatherAPI for city: %s", location.Name)
+
+	return &weather, nil
+}
+
+func (c *WeatherAPIClient) GetForecast(ctx context.Context, location models.Location, days int) (*WeatherForecastResponse, error) {
+	log := c.logger.WithContext(ctx)
+
+	log.Infof("Calling WeatherAPI forecast for city: %s, days: %d", location.Name, days)
+
+	params := url.Values{}
+	params.Set("days", strconv.Itoa(days))
+
+	var forecast WeatherForecastResponse
+
+	if err := c.fetch(ctx, c.forecastURL, location, params, &forecast); err != nil {
+		return nil, err
+	}
+
+	log.Infof("Successfully received forecast from WeatherAPI for city: %s", location.Name)
+
+	return &forecast, nil
+}
+
+func (c *WeatherAPIClient) GetAlerts(ctx context.Context, location models.Location) (*WeatherAlertsResponse, error) {
+	log := c.logger.WithContext(ctx)
+
+	log.Infof("Calling WeatherAPI alerts for city: %s", location.Name)
+
+	var alerts WeatherAlertsResponse
+
+	if err := c.fetch(ctx, c.alertsURL, location, url.Values{}, &alerts); err != nil {
+		return nil, err
+	}
+
+	log.Infof("Successfully received %d alerts from WeatherAPI for city: %s", len(alerts.Alerts.Alert), location.Name)
+
+	return &alerts, nil
+}
+
+// GetAirQuality asks the current weather endpoint to include air quality,
+// WeatherAPI has no separate endpoint for it.
+func (c *WeatherAPIClient) GetAirQuality(ctx context.Context, location models.Location) (*WeatherAirQualitySuccessResponse, error) {
+	log := c.logger.WithContext(ctx)
+
+	log.Infof("Calling WeatherAPI air quality for city: %s", location.Name)
+
+	params := url.Values{}
+	params.Set("aqi", "yes")
+
+	var airQuality WeatherAirQualitySuccessResponse
+
+	if err := c.fetch(ctx, c.apiURL, location, params, &airQuality); err != nil {
+		return nil, err
+	}
+
+	log.Infof("Successfully received air quality from WeatherAPI for city: %s", location.Name)
+
+	return &airQuality, nil
+}
+
+func (c *WeatherAPIClient) fetch(ctx context.Context, apiURL string, location models.Location, params url.Values, target interface{}) error {
+	log := c.logger.WithContext(ctx)
+
+	url, err := url.Parse(apiURL)
 	if err != nil {
 		log.Warnf("Form url: %s", err.Error())
-		return nil, infraerrors.ErrGetWeather
+		return infraerrors.ErrGetWeather
 	}
 	queryString := url.Query()
 	queryString.Set("key", c.apiKey)
-	queryString.Set("q", city)
+	queryString.Set("q", weatherAPIQuery(location))
+	for name, values := range params {
+		for _, value := range values {
+			queryString.Add(name, value)
+		}
+	}
 	url.RawQuery = queryString.Encode()
 	stringURL := url.String()
 
-	log.Debugf("Making request to WeatherAPI: %s", stringURL)
+	log.Debugf("Making request to WeatherAPI: %s", roundtrip.RedactURL(url))
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, stringURL, nil)
 	if err != nil {
 		log.Warnf("Failed to create get weather request: %s", err.Error())
-		return nil, infraerrors.ErrGetWeather
+		return infraerrors.ErrGetWeather
 	}
 
 	resp, err := c.client.Do(req)
 	if err != nil {
-		log.Warnf("Failed make get weather request: %s", err.Error())
-		return nil, infraerrors.ErrGetWeather
+		log.Warnf("Failed make get weather request: %s", roundtrip.RedactError(err).Error())
+		if errors.Is(err, context.DeadlineExceeded) || os.IsTimeout(err) {
+			return infraerrors.ErrProviderTimeout
+		}
+		return infraerrors.ErrGetWeather
 	}
 	defer func() {
 		if err := resp.Body.Close(); err != nil {
@@ -94,7 +244,7 @@ func (c *WeatherAPIClient) GetWeather(ctx context.Context, city string) (*Weathe
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
 		log.Warnf("Failed to read response body: %s", err.Error())
-		return nil, infraerrors.ErrGetWeather
+		return infraerrors.ErrGetWeather
 	}
 
 	if resp.StatusCode != http.StatusOK {
@@ -103,27 +253,34 @@ func (c *WeatherAPIClient) GetWeather(ctx context.Context, city string) (*Weathe
 
 		if err := json.Unmarshal(body, &errResponse); err != nil {
 			log.Warnf("Failed to unmarshal response body: %s", err.Error())
-			return nil, infraerrors.ErrGetWeather
+			return infraerrors.ErrGetWeather
 		}
 
 		if errResponse.Error.Code == notFoundWeatherAPIErrorCode {
-			log.Warnf("City not found: %s", city)
-			return nil, infraerrors.ErrCityNotFound
+			log.Warnf("City not found: %s", location.Name)
+			return infraerrors.ErrCityNotFound
 		} else {
 			log.Warnf("Error from weather api: %s", errResponse.Error.Message)
-			return nil, infraerrors.ErrGetWeather
+			return infraerrors.ErrGetWeather
 		}
 
 	}
 
-	var weather WeatherSuccessResponse
-
-	if err := json.Unmarshal(body, &weather); err != nil {
+	if err := json.Unmarshal(body, target); err != nil {
 		log.Warnf("Failed to unmarshal response body: %s", err.Error())
-		return nil, infraerrors.ErrGetWeather
+		return infraerrors.ErrGetWeather
 	}
 
-	log.Infof("Successfully received weather from WeatherAPI for city: %s", city)
+	return nil
+}
 
-	return &weather, nil
+// weatherAPIQuery prefers coordinates over the city name, since WeatherAPI
+// may pick a different city sharing the same name.
+func weatherAPIQuery(location models.Location) string {
+	if location.Coordinates == nil {
+		return location.Name
+	}
+
+	return strconv.FormatFloat(location.Coordinates.Latitude, 'f', -1, 64) + "," +
+		strconv.FormatFloat(location.Coordinates.Longitude, 'f', -1, 64)
 }
@@ -2,12 +2,18 @@ package errors
 
 import (
 	"errors"
+	"fmt"
 )
 
 var (
-	ErrGetWeather   = errors.New("failed to get weather")
-	ErrCityNotFound = errors.New("there is no city with such name")
-	ErrCache        = errors.New("failed to interact with cache")
-	ErrCacheMiss    = errors.New("cache miss")
-	ErrInternal     = errors.New("internal server error")
+	ErrGetWeather            = errors.New("failed to get weather")
+	ErrCityNotFound          = errors.New("there is no city with such name")
+	ErrCache                 = errors.New("failed to interact with cache")
+	ErrCacheMiss             = errors.New("cache miss")
+	ErrInternal              = errors.New("internal server error")
+	ErrProviderUnavailable   = errors.New("weather provider is temporarily unavailable")
+	ErrAlertsUnsupported     = errors.New("weather alerts are not supported by the provider")
+	ErrAirQualityUnsupported = errors.New("air quality is not supported by the provider")
+	ErrQuotaExhausted        = errors.New("weather provider quota is exhausted")
+	ErrProviderTimeout       = fmt.Errorf("weather provider did not respond in time: %w", ErrGetWeather)
 )
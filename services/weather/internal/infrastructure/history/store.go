@@ -0,0 +1,66 @@
+package history
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+	"weather-forecast/pkg/logger"
+	"weather-service/internal/domain/models"
+	infraerrors "weather-service/internal/infrastructure/errors"
+)
+
+const keyPrefix = "history:"
+
+type (
+	Timeline interface {
+		AddToTimeline(ctx context.Context, key string, at time.Time, data []byte, retention time.Duration) error
+		ReadTimeline(ctx context.Context, key string, from, to time.Time) ([][]byte, error)
+	}
+
+	// Store keeps observations per location in a timeline ordered by the time
+	// they were made, observations older than retention are dropped on write.
+	Store struct {
+		timeline  Timeline
+		retention time.Duration
+		logger    logger.Logger
+	}
+)
+
+func NewStore(timeline Timeline, retention time.Duration, logger logger.Logger) *Store {
+	return &Store{
+		timeline:  timeline,
+		retention: retention,
+		logger:    logger,
+	}
+}
+
+func (s *Store) Append(ctx context.Context, observation models.Observation) error {
+	data, err := json.Marshal(observation)
+	if err != nil {
+		s.logger.WithContext(ctx).Warnf("Marshal observation: %s", err.Error())
+		return infraerrors.ErrInternal
+	}
+
+	return s.timeline.AddToTimeline(ctx, keyPrefix+observation.LocationID, observation.ObservedAt, data, s.retention)
+}
+
+func (s *Store) Range(ctx context.Context, locationID string, from, to time.Time) ([]models.Observation, error) {
+	log := s.logger.WithContext(ctx)
+
+	entries, err := s.timeline.ReadTimeline(ctx, keyPrefix+locationID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	observations := make([]models.Observation, 0, len(entries))
+	for _, data := range entries {
+		var observation models.Observation
+		if err := json.Unmarshal(data, &observation); err != nil {
+			log.Warnf("Skip malformed observation of %s: %s", locationID, err.Error())
+			continue
+		}
+		observations = append(observations, observation)
+	}
+
+	return observations, nil
+}
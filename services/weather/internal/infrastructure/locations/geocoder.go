@@ -0,0 +1,107 @@
+package locations
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+	"weather-forecast/pkg/logger"
+	"weather-service/internal/domain/models"
+	"weather-service/internal/infrastructure/clients/openmeteo"
+)
+
+const (
+	// MinGeocodingPrefix is the shortest prefix the geocoding API answers.
+	MinGeocodingPrefix = 2
+	// geocodingResults are fetched and cached per prefix whatever the limit,
+	// so that one cache entry serves every limit.
+	geocodingResults = 20
+
+	geocodingKeyPrefix = "cities:"
+)
+
+type (
+	GeocodingClient interface {
+		SearchCities(ctx context.Context, name string, count int) ([]openmeteo.OpenMeteoGeocodingResult, error)
+	}
+
+	GeocodingCache interface {
+		Get(ctx context.Context, key string, value interface{}) error
+		Set(ctx context.Context, key string, value interface{}, expiration time.Duration) error
+	}
+
+	// Geocoder looks up cities missing from the bundled dataset. Answers are
+	// cached by prefix, since the same prefixes are typed over and over.
+	Geocoder struct {
+		client GeocodingClient
+		cache  GeocodingCache
+		ttl    time.Duration
+		logger logger.Logger
+	}
+)
+
+func NewGeocoder(client GeocodingClient, cache GeocodingCache, ttl time.Duration, logger logger.Logger) *Geocoder {
+	return &Geocoder{
+		client: client,
+		cache:  cache,
+		ttl:    ttl,
+		logger: logger,
+	}
+}
+
+func (g *Geocoder) Search(ctx context.Context, prefix string, limit int) ([]models.Location, error) {
+	log := g.logger.WithContext(ctx)
+
+	name := strings.Join(strings.Fields(prefix), " ")
+	if len([]rune(name)) < MinGeocodingPrefix {
+		return nil, nil
+	}
+
+	key := geocodingKeyPrefix + normalize(name)
+
+	var locations []models.Location
+	if err := g.cache.Get(ctx, key, &locations); err == nil {
+		log.Debugf("Geocoded cities for %q served from cache", name)
+		return locations[:min(limit, len(locations))], nil
+	}
+
+	results, err := g.client.SearchCities(ctx, name, geocodingResults)
+	if err != nil {
+		return nil, err
+	}
+
+	locations = make([]models.Location, 0, len(results))
+	for _, result := range results {
+		locations = append(locations, models.Location{
+			ID:      geocodedID(result),
+			Name:    result.Name,
+			Country: result.CountryCode,
+			Coordinates: &models.Coordinates{
+				Latitude:  result.Latitude,
+				Longitude: result.Longitude,
+			},
+		})
+	}
+
+	if g.ttl > 0 {
+		if err := g.cache.Set(ctx, key, locations, g.ttl); err != nil {
+			log.Warnf("Failed to cache geocoded cities for %q: %v", name, err)
+		}
+	}
+
+	return locations[:min(limit, len(locations))], nil
+}
+
+// geocodedID tells apart cities of the same name by their country and their
+// coordinates, rounded to the grid of coordinates lookups.
+func geocodedID(result openmeteo.OpenMeteoGeocodingResult) string {
+	parts := []string{fallbackID(normalize(result.Name))}
+	if result.CountryCode != "" {
+		parts = append(parts, strings.ToLower(result.CountryCode))
+	}
+	parts = append(parts,
+		strconv.FormatFloat(result.Latitude, 'f', models.CoordinatesPrecision, 64)+","+
+			strconv.FormatFloat(result.Longitude, 'f', models.CoordinatesPrecision, 64))
+
+	return strings.Join(parts, "-")
+}
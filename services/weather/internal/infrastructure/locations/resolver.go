@@ -0,0 +1,153 @@
+package locations
+
+import (
+	"cmp"
+	"context"
+	_ "embed"
+	"encoding/json"
+	"slices"
+	"strings"
+	"weather-forecast/pkg/logger"
+	"weather-service/internal/domain/models"
+)
+
+//go:embed cities.json
+var bundledCities []byte
+
+type (
+	cityRecord struct {
+		ID        string   `json:"id"`
+		Name      string   `json:"name"`
+		Country   string   `json:"country"`
+		Latitude  float64  `json:"latitude"`
+		Longitude float64  `json:"longitude"`
+		Aliases   []string `json:"aliases"`
+	}
+
+	searchEntry struct {
+		key      string
+		alias    bool
+		location models.Location
+	}
+
+	Resolver struct {
+		locations map[string]models.Location
+		entries   []searchEntry
+		logger    logger.Logger
+	}
+)
+
+func NewResolver(logger logger.Logger) (*Resolver, error) {
+	var records []cityRecord
+	if err := json.Unmarshal(bundledCities, &records); err != nil {
+		return nil, err
+	}
+
+	locations := make(map[string]models.Location)
+	var entries []searchEntry
+	for _, record := range records {
+		location := models.Location{
+			ID:      record.ID,
+			Name:    record.Name,
+			Country: record.Country,
+			Coordinates: &models.Coordinates{
+				Latitude:  record.Latitude,
+				Longitude: record.Longitude,
+			},
+		}
+
+		locations[normalize(record.Name)] = location
+		entries = append(entries, searchEntry{key: normalize(record.Name), location: location})
+		for _, alias := range record.Aliases {
+			locations[normalize(alias)] = location
+			entries = append(entries, searchEntry{key: normalize(alias), alias: true, location: location})
+		}
+	}
+
+	return &Resolver{
+		locations: locations,
+		entries:   entries,
+		logger:    logger,
+	}, nil
+}
+
+// Resolve maps free-text city input to its canonical location. Cities missing
+// from the bundled dataset are still given a stable ID built from the
+// normalized input, so that differently typed names share cache entries.
+func (r *Resolver) Resolve(ctx context.Context, city string) (*models.Location, error) {
+	log := r.logger.WithContext(ctx)
+
+	key := normalize(city)
+
+	if location, ok := r.locations[key]; ok {
+		log.Debugf("City %q resolved to %s", city, location.ID)
+		return &location, nil
+	}
+
+	log.Debugf("City %q is not in the bundled dataset, using normalized name", city)
+
+	return &models.Location{
+		ID:   fallbackID(key),
+		Name: strings.Join(strings.Fields(city), " "),
+	}, nil
+}
+
+// Search returns at most limit bundled cities whose name or alias starts with
+// prefix. Exact names come first, then name matches, then alias matches.
+func (r *Resolver) Search(ctx context.Context, prefix string, limit int) ([]models.Location, error) {
+	key := normalize(prefix)
+	if key == "" {
+		return nil, nil
+	}
+
+	ranks := make(map[string]int)
+	var matches []models.Location
+	for _, entry := range r.entries {
+		if !strings.HasPrefix(entry.key, key) {
+			continue
+		}
+
+		rank := matchRank(entry, key)
+		best, seen := ranks[entry.location.ID]
+		if !seen {
+			matches = append(matches, entry.location)
+		}
+		if !seen || rank < best {
+			ranks[entry.location.ID] = rank
+		}
+	}
+
+	slices.SortStableFunc(matches, func(a, b models.Location) int {
+		if byRank := cmp.Compare(ranks[a.ID], ranks[b.ID]); byRank != 0 {
+			return byRank
+		}
+		return cmp.Compare(a.Name, b.Name)
+	})
+
+	r.logger.WithContext(ctx).Debugf("Bundled dataset has %d cities matching %q", len(matches), prefix)
+
+	return matches[:min(limit, len(matches))], nil
+}
+
+func matchRank(entry searchEntry, key string) int {
+	switch {
+	case entry.alias:
+		return 2
+	case entry.key == key:
+		return 0
+	default:
+		return 1
+	}
+}
+
+// fallbackID builds the ID of a city missing from the bundled dataset.
+func fallbackID(key string) string {
+	return strings.ReplaceAll(key, " ", "-")
+}
+
+func normalize(city string) string {
+	city = strings.ToLower(city)
+	city = strings.NewReplacer("'", "", "’", "", "ʼ", "", "-", " ").Replace(city)
+
+	return strings.Join(strings.Fields(city), " ")
+}
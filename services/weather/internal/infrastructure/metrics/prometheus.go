@@ -2,7 +2,9 @@ package metrics
 
 import (
 	"fmt"
+	"time"
 	"weather-forecast/pkg/logger"
+	"weather-service/internal/infrastructure/providers"
 
 	"github.com/gin-gonic/gin"
 	"github.com/prometheus/client_golang/prometheus"
@@ -14,6 +16,20 @@ type (
 		cacheHits   prometheus.Counter
 		cacheMisses prometheus.Counter
 		cacheErrors prometheus.Counter
+		cacheStale  prometheus.Counter
+		lastKnown   prometheus.Counter
+		circuit     *prometheus.GaugeVec
+		hedgeFired  prometheus.Counter
+		hedgeWins   *prometheus.CounterVec
+		spread      prometheus.Histogram
+		disputed    prometheus.Counter
+		coalesced   prometheus.Counter
+		tierHits    *prometheus.CounterVec
+		tierMisses  *prometheus.CounterVec
+		quota       *prometheus.GaugeVec
+		latency     *prometheus.HistogramVec
+		outcomes    *prometheus.CounterVec
+		servedBy    *prometheus.CounterVec
 		logger      logger.Logger
 	}
 )
@@ -33,6 +49,64 @@ func NewPrometheus(logger logger.Logger) *Prometheus {
 			Name: "weather_cache_errors_total",
 			Help: "Total number of cache errors",
 		}),
+		cacheStale: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "weather_cache_stale_hits_total",
+			Help: "Total number of soft-expired cache entries served while being revalidated",
+		}),
+		lastKnown: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "weather_cache_last_known_good_total",
+			Help: "Total number of last known values served because all providers failed",
+		}),
+		circuit: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "weather_provider_circuit_state",
+			Help: "Circuit breaker state of a weather provider (0 - closed, 1 - half-open, 2 - open)",
+		}, []string{"provider"}),
+		hedgeFired: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "weather_hedge_fired_total",
+			Help: "Total number of hedged requests sent to the secondary provider after the delay",
+		}),
+		hedgeWins: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "weather_hedge_wins_total",
+			Help: "Total number of hedged requests won by a provider",
+		}, []string{"provider"}),
+		spread: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Name:    "weather_consensus_temperature_spread_celsius",
+			Help:    "Difference between the highest and the lowest temperature reported by providers asked for consensus",
+			Buckets: []float64{0.5, 1, 2, 3, 5, 10},
+		}),
+		disputed: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "weather_consensus_disputed_total",
+			Help: "Total number of consensus readings with temperatures further apart than the threshold",
+		}),
+		coalesced: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "weather_coalesced_requests_total",
+			Help: "Total number of requests served by an upstream call already in flight for the same city",
+		}),
+		tierHits: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "weather_cache_tier_hits_total",
+			Help: "Total number of cache hits per cache tier",
+		}, []string{"tier"}),
+		tierMisses: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "weather_cache_tier_misses_total",
+			Help: "Total number of cache misses per cache tier",
+		}, []string{"tier"}),
+		quota: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "weather_provider_quota_remaining",
+			Help: "Calls left in the budget of a weather provider API key for the current window",
+		}, []string{"provider", "window"}),
+		latency: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "weather_provider_request_duration_seconds",
+			Help:    "Duration of calls to a weather provider API",
+			Buckets: []float64{0.05, 0.1, 0.25, 0.5, 1, 2, 5, 10},
+		}, []string{"provider"}),
+		outcomes: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "weather_provider_requests_total",
+			Help: "Total number of calls to a weather provider API by outcome (success, not_found, error, timeout, canceled)",
+		}, []string{"provider", "outcome"}),
+		servedBy: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "weather_served_by_total",
+			Help: "Total number of requests answered by a link of the provider chain",
+		}, []string{"provider", "operation"}),
 		logger: logger,
 	}
 
@@ -40,6 +114,20 @@ func NewPrometheus(logger logger.Logger) *Prometheus {
 		metricManager.cacheHits,
 		metricManager.cacheMisses,
 		metricManager.cacheErrors,
+		metricManager.cacheStale,
+		metricManager.lastKnown,
+		metricManager.circuit,
+		metricManager.hedgeFired,
+		metricManager.hedgeWins,
+		metricManager.spread,
+		metricManager.disputed,
+		metricManager.coalesced,
+		metricManager.tierHits,
+		metricManager.tierMisses,
+		metricManager.quota,
+		metricManager.latency,
+		metricManager.outcomes,
+		metricManager.servedBy,
 	)
 
 	return metricManager
@@ -68,3 +156,55 @@ func (m *Prometheus) RecordCacheMiss() {
 func (m *Prometheus) RecordCacheError() {
 	m.cacheErrors.Inc()
 }
+
+func (m *Prometheus) RecordCacheStaleHit() {
+	m.cacheStale.Inc()
+}
+
+func (m *Prometheus) RecordLastKnownGood() {
+	m.lastKnown.Inc()
+}
+
+func (m *Prometheus) RecordCircuitState(provider string, state providers.CircuitState) {
+	m.circuit.WithLabelValues(provider).Set(float64(state))
+}
+
+func (m *Prometheus) RecordHedgeFired() {
+	m.hedgeFired.Inc()
+}
+
+func (m *Prometheus) RecordHedgeWin(provider string) {
+	m.hedgeWins.WithLabelValues(provider).Inc()
+}
+
+func (m *Prometheus) RecordConsensus(spread float64, disputed bool) {
+	m.spread.Observe(spread)
+	if disputed {
+		m.disputed.Inc()
+	}
+}
+
+func (m *Prometheus) RecordCoalesced() {
+	m.coalesced.Inc()
+}
+
+func (m *Prometheus) RecordTierHit(tier string) {
+	m.tierHits.WithLabelValues(tier).Inc()
+}
+
+func (m *Prometheus) RecordTierMiss(tier string) {
+	m.tierMisses.WithLabelValues(tier).Inc()
+}
+
+func (m *Prometheus) RecordQuotaRemaining(provider, window string, remaining int) {
+	m.quota.WithLabelValues(provider, window).Set(float64(remaining))
+}
+
+func (m *Prometheus) RecordProviderCall(provider, outcome string, duration time.Duration) {
+	m.latency.WithLabelValues(provider).Observe(duration.Seconds())
+	m.outcomes.WithLabelValues(provider, outcome).Inc()
+}
+
+func (m *Prometheus) RecordServedBy(provider, operation string) {
+	m.servedBy.WithLabelValues(provider, operation).Inc()
+}
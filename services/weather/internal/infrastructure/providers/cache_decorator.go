@@ -2,15 +2,35 @@ package providers
 
 import (
 	"context"
+	"fmt"
 	"time"
 	"weather-forecast/pkg/logger"
 	"weather-service/internal/domain/models"
 	"weather-service/internal/domain/usecases"
 )
 
-const cacheTTL = 10 * time.Minute
-
 type (
+	// CacheTTL holds soft expiration of cached results, a zero TTL disables
+	// caching of the corresponding result. Soft-expired results are served for
+	// RevalidateWindow more while being refreshed, and are kept for
+	// LastKnownGood since they were stored to be served when all providers fail.
+	CacheTTL struct {
+		Weather          time.Duration
+		Forecast         time.Duration
+		Alerts           time.Duration
+		AirQuality       time.Duration
+		RevalidateWindow time.Duration
+		LastKnownGood    time.Duration
+	}
+
+	cacheEntry[T any] struct {
+		Value      T         `json:"value"`
+		Source     string    `json:"source,omitempty"`
+		CachedAt   time.Time `json:"cached_at"`
+		FreshUntil time.Time `json:"fresh_until"`
+		StaleUntil time.Time `json:"stale_until"`
+	}
+
 	CacheWriter interface {
 		Set(ctx context.Context, key string, value interface{}, expiration time.Duration) error
 	}
@@ -20,41 +40,165 @@ type (
 	}
 
 	CacheDecorator struct {
+		name     string
 		provider usecases.WeatherProvider
 		cache    CacheWriter
 		metrics  CacheErrorRecorder
+		ttl      CacheTTL
 		logger   logger.Logger
 	}
 )
 
-func NewCacheDecorator(provider usecases.WeatherProvider, cache CacheWriter, metrics CacheErrorRecorder, logger logger.Logger) *CacheDecorator {
+func NewCacheDecorator(name string, provider usecases.WeatherProvider, cache CacheWriter, metrics CacheErrorRecorder, ttl CacheTTL, logger logger.Logger) *CacheDecorator {
 	return &CacheDecorator{
+		name:     name,
 		provider: provider,
 		cache:    cache,
 		metrics:  metrics,
+		ttl:      ttl,
 		logger:   logger,
 	}
 }
 
-func (d *CacheDecorator) GetWeatherByCity(ctx context.Context, city string) (*models.Weather, error) {
+func (d *CacheDecorator) GetWeatherByCity(ctx context.Context, location models.Location, options models.WeatherOptions) (*models.Weather, error) {
 
 	log := d.logger.WithContext(ctx)
 
-	log.Debugf("Getting weather and caching for city: %s", city)
+	log.Debugf("Getting weather and caching for city: %s", location.ID)
 
-	weather, err := d.provider.GetWeatherByCity(ctx, city)
+	weather, err := d.provider.GetWeatherByCity(ctx, location, options)
 
 	if err != nil {
 		return nil, err
 	}
 
-	if err := d.cache.Set(ctx, city, weather, cacheTTL); err != nil {
+	if d.ttl.Weather <= 0 {
+		return weather, nil
+	}
+
+	entry, expiration := newCacheEntry(weather, d.name, d.ttl.Weather, d.ttl)
+	if err := d.cache.Set(ctx, weatherCacheKey(location, options), entry, expiration); err != nil {
 		d.metrics.RecordCacheError()
-		log.Warnf("Failed to cache weather for city %s: %v", city, err)
+		log.Warnf("Failed to cache weather for city %s: %v", location.ID, err)
 	}
 
-	log.Debugf("Weather cached successfully for city: %s", city)
+	log.Debugf("Weather cached successfully for city: %s", location.ID)
 
 	return weather, nil
 
 }
+
+func (d *CacheDecorator) GetForecastByCity(ctx context.Context, location models.Location, days int) (*models.Forecast, error) {
+
+	log := d.logger.WithContext(ctx)
+
+	log.Debugf("Getting forecast and caching for city: %s", location.ID)
+
+	forecast, err := d.provider.GetForecastByCity(ctx, location, days)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if d.ttl.Forecast <= 0 {
+		return forecast, nil
+	}
+
+	entry, expiration := newCacheEntry(forecast, d.name, d.ttl.Forecast, d.ttl)
+	if err := d.cache.Set(ctx, forecastCacheKey(location, days), entry, expiration); err != nil {
+		d.metrics.RecordCacheError()
+		log.Warnf("Failed to cache forecast for city %s: %v", location.ID, err)
+	}
+
+	log.Debugf("Forecast cached successfully for city: %s", location.ID)
+
+	return forecast, nil
+
+}
+
+func (d *CacheDecorator) GetAlertsByCity(ctx context.Context, location models.Location) (*models.Alerts, error) {
+
+	log := d.logger.WithContext(ctx)
+
+	log.Debugf("Getting alerts and caching for city: %s", location.ID)
+
+	alerts, err := d.provider.GetAlertsByCity(ctx, location)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if d.ttl.Alerts <= 0 {
+		return alerts, nil
+	}
+
+	entry, expiration := newCacheEntry(alerts, d.name, d.ttl.Alerts, d.ttl)
+	if err := d.cache.Set(ctx, alertsCacheKey(location), entry, expiration); err != nil {
+		d.metrics.RecordCacheError()
+		log.Warnf("Failed to cache alerts for city %s: %v", location.ID, err)
+	}
+
+	log.Debugf("Alerts cached successfully for city: %s", location.ID)
+
+	return alerts, nil
+
+}
+
+func (d *CacheDecorator) GetAirQualityByCity(ctx context.Context, location models.Location) (*models.AirQuality, error) {
+
+	log := d.logger.WithContext(ctx)
+
+	log.Debugf("Getting air quality and caching for city: %s", location.ID)
+
+	airQuality, err := d.provider.GetAirQualityByCity(ctx, location)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if d.ttl.AirQuality <= 0 {
+		return airQuality, nil
+	}
+
+	entry, expiration := newCacheEntry(airQuality, d.name, d.ttl.AirQuality, d.ttl)
+	if err := d.cache.Set(ctx, airQualityCacheKey(location), entry, expiration); err != nil {
+		d.metrics.RecordCacheError()
+		log.Warnf("Failed to cache air quality for city %s: %v", location.ID, err)
+	}
+
+	log.Debugf("Air quality cached successfully for city: %s", location.ID)
+
+	return airQuality, nil
+
+}
+
+func newCacheEntry[T any](value T, source string, softTTL time.Duration, ttl CacheTTL) (cacheEntry[T], time.Duration) {
+	now := time.Now()
+	hardTTL := softTTL + ttl.RevalidateWindow
+
+	entry := cacheEntry[T]{
+		Value:      value,
+		Source:     source,
+		CachedAt:   now,
+		FreshUntil: now.Add(softTTL),
+		StaleUntil: now.Add(hardTTL),
+	}
+
+	return entry, max(hardTTL, ttl.LastKnownGood)
+}
+
+func weatherCacheKey(location models.Location, options models.WeatherOptions) string {
+	return fmt.Sprintf("weather:%s:%s:%s", location.ID, options.Units, options.Lang)
+}
+
+func forecastCacheKey(location models.Location, days int) string {
+	return fmt.Sprintf("forecast:%s:%d", location.ID, days)
+}
+
+func alertsCacheKey(location models.Location) string {
+	return fmt.Sprintf("alerts:%s", location.ID)
+}
+
+func airQualityCacheKey(location models.Location) string {
+	return fmt.Sprintf("air_quality:%s", location.ID)
+}
@@ -0,0 +1,182 @@
+package providers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+	"weather-forecast/pkg/logger"
+	"weather-service/internal/domain/models"
+	infraerrors "weather-service/internal/infrastructure/errors"
+)
+
+var cacheKinds = []models.CacheKind{
+	models.CacheKindWeather,
+	models.CacheKindForecast,
+	models.CacheKindAlerts,
+	models.CacheKindAirQuality,
+}
+
+type (
+	CacheStore interface {
+		Keys(ctx context.Context, pattern string) ([]string, error)
+		Get(ctx context.Context, key string, value interface{}) error
+		Delete(ctx context.Context, keys ...string) error
+	}
+
+	// CacheInspector lists and removes the entries written by the cache
+	// decorators, it knows their keys and reads entries without their values.
+	CacheInspector struct {
+		store  CacheStore
+		logger logger.Logger
+	}
+)
+
+func NewCacheInspector(store CacheStore, logger logger.Logger) *CacheInspector {
+	return &CacheInspector{
+		store:  store,
+		logger: logger,
+	}
+}
+
+// Entries lists the cached entries of the location, or of every location when
+// locationID is empty, ordered by key.
+func (i *CacheInspector) Entries(ctx context.Context, locationID string) ([]models.CacheEntry, error) {
+	log := i.logger.WithContext(ctx)
+
+	keys, err := i.keys(ctx, locationID)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]models.CacheEntry, 0, len(keys))
+	for _, key := range keys {
+		var stored cacheEntry[json.RawMessage]
+		if err := i.store.Get(ctx, key, &stored); err != nil {
+			if errors.Is(err, infraerrors.ErrCacheMiss) {
+				continue
+			}
+			return nil, err
+		}
+
+		entry, ok := parseCacheKey(key)
+		if !ok {
+			log.Warnf("Cache key %s has unexpected format, skipping it", key)
+			continue
+		}
+		entry.Source = stored.Source
+		entry.CachedAt = stored.CachedAt
+		entry.FreshUntil = stored.FreshUntil
+		entry.StaleUntil = stored.StaleUntil
+
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+// Invalidate removes the cached entries of the location, or of every location
+// when locationID is empty, and returns how many were removed.
+func (i *CacheInspector) Invalidate(ctx context.Context, locationID string) (int, error) {
+	keys, err := i.keys(ctx, locationID)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(keys) == 0 {
+		return 0, nil
+	}
+
+	if err := i.store.Delete(ctx, keys...); err != nil {
+		return 0, err
+	}
+
+	i.logger.WithContext(ctx).Infof("Removed %d cache entries", len(keys))
+
+	return len(keys), nil
+}
+
+func (i *CacheInspector) keys(ctx context.Context, locationID string) ([]string, error) {
+	var keys []string
+	for _, kind := range cacheKinds {
+		found, err := i.store.Keys(ctx, cacheKeyPattern(kind, locationID))
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, found...)
+	}
+
+	sort.Strings(keys)
+
+	return keys, nil
+}
+
+func cacheKeyPattern(kind models.CacheKind, locationID string) string {
+	if locationID == "" {
+		return string(kind) + ":*"
+	}
+
+	pattern := string(kind) + ":" + escapePattern(locationID)
+	if kind == models.CacheKindWeather || kind == models.CacheKindForecast {
+		pattern += ":*"
+	}
+
+	return pattern
+}
+
+// parseCacheKey reverses the key functions of the cache decorator. Options
+// and days are cut from the end, as location IDs may contain colons.
+func parseCacheKey(key string) (models.CacheEntry, bool) {
+	kind, rest, ok := strings.Cut(key, ":")
+	if !ok || rest == "" {
+		return models.CacheEntry{}, false
+	}
+
+	entry := models.CacheEntry{Key: key, Kind: models.CacheKind(kind)}
+
+	switch entry.Kind {
+	case models.CacheKindWeather:
+		parts := strings.Split(rest, ":")
+		if len(parts) < 3 {
+			return models.CacheEntry{}, false
+		}
+		entry.LocationID = strings.Join(parts[:len(parts)-2], ":")
+		entry.Options = models.WeatherOptions{
+			Units: models.Units(parts[len(parts)-2]),
+			Lang:  parts[len(parts)-1],
+		}
+
+	case models.CacheKindForecast:
+		separator := strings.LastIndex(rest, ":")
+		if separator < 0 {
+			return models.CacheEntry{}, false
+		}
+		days, err := strconv.Atoi(rest[separator+1:])
+		if err != nil {
+			return models.CacheEntry{}, false
+		}
+		entry.LocationID = rest[:separator]
+		entry.Days = days
+
+	case models.CacheKindAlerts, models.CacheKindAirQuality:
+		entry.LocationID = rest
+
+	default:
+		return models.CacheEntry{}, false
+	}
+
+	return entry, entry.LocationID != ""
+}
+
+func escapePattern(value string) string {
+	var escaped strings.Builder
+	for _, r := range value {
+		if strings.ContainsRune(`*?[]\`, r) {
+			escaped.WriteRune('\\')
+		}
+		escaped.WriteRune(r)
+	}
+	return escaped.String()
+}
@@ -3,26 +3,38 @@ package providers
 import (
 	"context"
 	"errors"
+	"sync"
+	"time"
 	"weather-forecast/pkg/logger"
 	"weather-service/internal/domain/models"
+	"weather-service/internal/domain/usecases"
 	infraerrors "weather-service/internal/infrastructure/errors"
 )
 
+const revalidateTimeout = 30 * time.Second
+
 type (
 	MetricsRecorder interface {
 		RecordCacheHit()
 		RecordCacheMiss()
 		RecordCacheError()
+		RecordCacheStaleHit()
+		RecordLastKnownGood()
 	}
 
 	CacheReader interface {
 		Get(ctx context.Context, key string, value interface{}) error
 	}
 
+	// CacheWeatherProvider is the cache link of the chain. Unlike other links
+	// it keeps calling the rest of the chain after a hit, to refresh
+	// soft-expired entries and to fall back to the last known value.
 	CacheWeatherProvider struct {
-		cache   CacheReader
-		metrics MetricsRecorder
-		logger  logger.Logger
+		cache       CacheReader
+		metrics     MetricsRecorder
+		nextSection WeatherChainLink
+		refreshing  sync.Map
+		logger      logger.Logger
 	}
 )
 
@@ -34,15 +46,72 @@ func NewCacheWeather(cache CacheReader, metrics MetricsRecorder, logger logger.L
 	}
 }
 
-func (p *CacheWeatherProvider) GetWeatherByCity(ctx context.Context, city string) (*models.Weather, error) {
+func (p *CacheWeatherProvider) SetNext(section WeatherChainLink) {
+	p.nextSection = section
+}
+
+func (p *CacheWeatherProvider) GetWeatherByCity(ctx context.Context, location models.Location, options models.WeatherOptions) (*models.Weather, error) {
+	weather, err := readThrough(ctx, p, weatherCacheKey(location, options), func(ctx context.Context) (*models.Weather, error) {
+		return p.nextSection.GetWeatherByCity(ctx, location, options)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return weather, nil
+}
+
+func (p *CacheWeatherProvider) GetForecastByCity(ctx context.Context, location models.Location, days int) (*models.Forecast, error) {
+	forecast, err := readThrough(ctx, p, forecastCacheKey(location, days), func(ctx context.Context) (*models.Forecast, error) {
+		return p.nextSection.GetForecastByCity(ctx, location, days)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return forecast, nil
+}
+
+func (p *CacheWeatherProvider) GetAlertsByCity(ctx context.Context, location models.Location) (*models.Alerts, error) {
+	alerts, err := readThrough(ctx, p, alertsCacheKey(location), func(ctx context.Context) (*models.Alerts, error) {
+		return p.nextSection.GetAlertsByCity(ctx, location)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return alerts, nil
+}
+
+func (p *CacheWeatherProvider) GetAirQualityByCity(ctx context.Context, location models.Location) (*models.AirQuality, error) {
+	airQuality, err := readThrough(ctx, p, airQualityCacheKey(location), func(ctx context.Context) (*models.AirQuality, error) {
+		return p.nextSection.GetAirQualityByCity(ctx, location)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return airQuality, nil
+}
+
+func readThrough[T models.Weather | models.Forecast | models.Alerts | models.AirQuality](ctx context.Context, p *CacheWeatherProvider, key string, fetch func(context.Context) (*T, error)) (*T, error) {
 	log := p.logger.WithContext(ctx)
 
-	cachedWeather := &models.Weather{}
-	err := p.cache.Get(ctx, city, cachedWeather)
+	if usecases.RefreshRequested(ctx) && p.nextSection != nil {
+		log.Debugf("Refresh requested, bypassing cache for key %s", key)
+		return fetch(ctx)
+	}
+
+	var entry cacheEntry[*T]
+	err := p.cache.Get(ctx, key, &entry)
+	if err == nil && entry.Value == nil {
+		log.Warnf("Cache entry %s has unexpected format, ignoring it", key)
+		err = infraerrors.ErrCacheMiss
+	}
 	if err != nil {
 
 		if errors.Is(err, infraerrors.ErrCache) {
-			log.Errorf("Cache error for city %s: %v", city, err)
+			log.Errorf("Cache error for key %s: %v", key, err)
 			p.metrics.RecordCacheError()
 		}
 
@@ -50,10 +119,81 @@ func (p *CacheWeatherProvider) GetWeatherByCity(ctx context.Context, city string
 			p.metrics.RecordCacheMiss()
 		}
 
-		return nil, err
+		if p.nextSection == nil {
+			return nil, err
+		}
+
+		return fetch(ctx)
+	}
+
+	if freshUntil, ok := usecases.FreshUntilRequested(ctx); ok && p.nextSection != nil && !freshUntil.Before(entry.FreshUntil) {
+		log.Debugf("Cache entry %s expires before %s, fetching it again", key, freshUntil.Format(time.RFC3339))
+		return fetch(ctx)
+	}
+
+	now := time.Now()
+
+	if now.Before(entry.FreshUntil) {
+		p.metrics.RecordCacheHit()
+		markServedBy(ctx, CacheProviderName)
+		return entry.Value, nil
+	}
+
+	if now.Before(entry.StaleUntil) {
+		p.metrics.RecordCacheStaleHit()
+		markServedBy(ctx, CacheProviderName)
+		if p.nextSection != nil {
+			revalidate(ctx, p, key, fetch)
+		}
+		return entry.Value, nil
+	}
 
+	p.metrics.RecordCacheMiss()
+
+	if p.nextSection == nil {
+		return nil, infraerrors.ErrCacheMiss
+	}
+
+	value, err := fetch(ctx)
+	if err != nil {
+		log.Warnf("All providers failed for key %s, serving last known value: %v", key, err)
+		p.metrics.RecordLastKnownGood()
+		markServedBy(ctx, CacheProviderName)
+		markStale(entry.Value)
+		return entry.Value, nil
+	}
+
+	return value, nil
+}
+
+// revalidate refreshes the entry through the rest of the chain in background,
+// the cache decorators of the providers store the fresh value.
+func revalidate[T any](ctx context.Context, p *CacheWeatherProvider, key string, fetch func(context.Context) (*T, error)) {
+	if _, inFlight := p.refreshing.LoadOrStore(key, struct{}{}); inFlight {
+		return
 	}
 
-	p.metrics.RecordCacheHit()
-	return cachedWeather, nil
+	refreshCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), revalidateTimeout)
+
+	go func() {
+		defer cancel()
+		defer p.refreshing.Delete(key)
+
+		if _, err := fetch(refreshCtx); err != nil {
+			p.logger.WithContext(refreshCtx).Warnf("Failed to revalidate cache key %s: %v", key, err)
+		}
+	}()
+}
+
+func markStale[T models.Weather | models.Forecast | models.Alerts | models.AirQuality](value *T) {
+	switch v := any(value).(type) {
+	case *models.Weather:
+		v.Stale = true
+	case *models.Forecast:
+		v.Stale = true
+	case *models.Alerts:
+		v.Stale = true
+	case *models.AirQuality:
+		v.Stale = true
+	}
 }
@@ -29,13 +29,13 @@ func (c *WeatherLink) SetNext(section WeatherChainLink) {
 	c.nextSection = section
 }
 
-func (c *WeatherLink) GetWeatherByCity(ctx context.Context, city string) (*models.Weather, error) {
+func (c *WeatherLink) GetWeatherByCity(ctx context.Context, location models.Location, options models.WeatherOptions) (*models.Weather, error) {
 
-	weather, err := c.provider.GetWeatherByCity(ctx, city)
+	weather, err := c.provider.GetWeatherByCity(ctx, location, options)
 
 	if err != nil {
 		if c.nextSection != nil {
-			return c.nextSection.GetWeatherByCity(ctx, city)
+			return c.nextSection.GetWeatherByCity(ctx, location, options)
 		}
 
 		return nil, err
@@ -44,3 +44,51 @@ func (c *WeatherLink) GetWeatherByCity(ctx context.Context, city string) (*model
 	return weather, nil
 
 }
+
+func (c *WeatherLink) GetForecastByCity(ctx context.Context, location models.Location, days int) (*models.Forecast, error) {
+
+	forecast, err := c.provider.GetForecastByCity(ctx, location, days)
+
+	if err != nil {
+		if c.nextSection != nil {
+			return c.nextSection.GetForecastByCity(ctx, location, days)
+		}
+
+		return nil, err
+	}
+
+	return forecast, nil
+
+}
+
+func (c *WeatherLink) GetAlertsByCity(ctx context.Context, location models.Location) (*models.Alerts, error) {
+
+	alerts, err := c.provider.GetAlertsByCity(ctx, location)
+
+	if err != nil {
+		if c.nextSection != nil {
+			return c.nextSection.GetAlertsByCity(ctx, location)
+		}
+
+		return nil, err
+	}
+
+	return alerts, nil
+
+}
+
+func (c *WeatherLink) GetAirQualityByCity(ctx context.Context, location models.Location) (*models.AirQuality, error) {
+
+	airQuality, err := c.provider.GetAirQualityByCity(ctx, location)
+
+	if err != nil {
+		if c.nextSection != nil {
+			return c.nextSection.GetAirQualityByCity(ctx, location)
+		}
+
+		return nil, err
+	}
+
+	return airQuality, nil
+
+}
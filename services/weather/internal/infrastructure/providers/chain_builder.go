@@ -0,0 +1,337 @@
+package providers
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+	"weather-forecast/pkg/logger"
+	"weather-service/internal/config"
+	"weather-service/internal/domain/usecases"
+	"weather-service/internal/infrastructure/clients/openmeteo"
+	"weather-service/internal/infrastructure/clients/openweather"
+	"weather-service/internal/infrastructure/clients/weatherapi"
+	"weather-service/internal/infrastructure/roundtrip"
+)
+
+const (
+	CacheProviderName       = "cache"
+	WeatherAPIProviderName  = "weatherapi"
+	OpenWeatherProviderName = "openweather"
+	OpenMeteoProviderName   = "openmeteo"
+	ConsensusProviderName   = "consensus"
+)
+
+type (
+	Cacher interface {
+		CacheReader
+		CacheWriter
+	}
+
+	ChainMetricsRecorder interface {
+		MetricsRecorder
+		CircuitStateRecorder
+		HedgeRecorder
+		ConsensusRecorder
+		QuotaRecorder
+		ProviderMetricsRecorder
+		ServedByRecorder
+	}
+
+	ChainBuilder struct {
+		cfg       *config.Config
+		cache     Cacher
+		quota     QuotaCounter
+		history   ObservationWriter
+		metrics   ChainMetricsRecorder
+		transport http.RoundTripper
+		breaker   CircuitBreakerSettings
+		logger    logger.Logger
+	}
+)
+
+func NewChainBuilder(cfg *config.Config, cache Cacher, quota QuotaCounter, history ObservationWriter, metrics ChainMetricsRecorder, transport http.RoundTripper, logger logger.Logger) *ChainBuilder {
+	return &ChainBuilder{
+		cfg:       cfg,
+		cache:     cache,
+		quota:     quota,
+		history:   history,
+		metrics:   metrics,
+		transport: transport,
+		breaker: CircuitBreakerSettings{
+			FailureThreshold: cfg.CircuitBreakerFailureThreshold,
+			SuccessThreshold: cfg.CircuitBreakerSuccessThreshold,
+			CoolDown:         time.Duration(cfg.CircuitBreakerCoolDown) * time.Second,
+		},
+		logger: logger,
+	}
+}
+
+// Build links providers in the order of PROVIDER_CHAIN and returns the head of
+// the chain, wrapped to record which link answered.
+// With the hedged strategy the first two providers are raced against each other
+// in place of the first one, the rest of the chain stays sequential. With the
+// consensus strategy all providers are asked at once in place of the first one.
+func (b *ChainBuilder) Build() (WeatherChainLink, error) {
+	var names []string
+	var links []WeatherChainLink
+	seen := make(map[string]bool)
+
+	for _, rawName := range b.cfg.ProviderChain {
+		name := strings.ToLower(strings.TrimSpace(rawName))
+		if seen[name] {
+			return nil, fmt.Errorf("provider %q is listed in chain more than once", name)
+		}
+		seen[name] = true
+
+		link, err := b.buildLink(name)
+		if err != nil {
+			return nil, err
+		}
+
+		names = append(names, name)
+		links = append(links, link)
+	}
+
+	if len(links) == 0 {
+		return nil, fmt.Errorf("provider chain is empty")
+	}
+
+	switch b.cfg.ProviderStrategy {
+	case config.HedgedStrategy:
+		var err error
+		if names, links, err = b.hedge(names, links); err != nil {
+			return nil, err
+		}
+	case config.ConsensusStrategy:
+		var err error
+		if names, links, err = b.consensus(names, links); err != nil {
+			return nil, err
+		}
+	}
+
+	for i := 1; i < len(links); i++ {
+		links[i-1].SetNext(links[i])
+	}
+
+	b.logger.Infof("Weather provider chain built: %s", strings.Join(names, " -> "))
+
+	return NewServedByLink(links[0], b.metrics), nil
+}
+
+func (b *ChainBuilder) hedge(names []string, links []WeatherChainLink) ([]string, []WeatherChainLink, error) {
+	primary, secondary := -1, -1
+	for i, name := range names {
+		if name == CacheProviderName {
+			continue
+		}
+		if primary < 0 {
+			primary = i
+		} else {
+			secondary = i
+			break
+		}
+	}
+
+	if secondary < 0 {
+		return nil, nil, fmt.Errorf("hedged strategy requires at least two providers in chain")
+	}
+
+	hedgedLink := NewHedgedLink(
+		NamedProvider{Name: names[primary], Provider: links[primary]},
+		NamedProvider{Name: names[secondary], Provider: links[secondary]},
+		time.Duration(b.cfg.HedgeDelayMs)*time.Millisecond,
+		b.metrics,
+		b.logger,
+	)
+
+	hedgedNames := make([]string, 0, len(names)-1)
+	hedgedLinks := make([]WeatherChainLink, 0, len(links)-1)
+	for i := range links {
+		switch i {
+		case primary:
+			hedgedNames = append(hedgedNames, names[primary]+"|"+names[secondary])
+			hedgedLinks = append(hedgedLinks, hedgedLink)
+		case secondary:
+		default:
+			hedgedNames = append(hedgedNames, names[i])
+			hedgedLinks = append(hedgedLinks, links[i])
+		}
+	}
+
+	return hedgedNames, hedgedLinks, nil
+}
+
+// consensus replaces the providers with one link asking all of them. Their
+// circuit breakers keep failing providers out, and the merged weather is
+// cached over what each of them cached for the shortest of their TTLs.
+func (b *ChainBuilder) consensus(names []string, links []WeatherChainLink) ([]string, []WeatherChainLink, error) {
+	var members []NamedProvider
+	var memberNames []string
+	first := -1
+	weatherTTL := 0
+	for i, name := range names {
+		if name == CacheProviderName {
+			continue
+		}
+		if first < 0 {
+			first = i
+		}
+		members = append(members, NamedProvider{Name: name, Provider: links[i]})
+		memberNames = append(memberNames, name)
+		if ttl := b.weatherCacheTTL(name); ttl > 0 && (weatherTTL == 0 || ttl < weatherTTL) {
+			weatherTTL = ttl
+		}
+	}
+
+	if len(members) < 2 {
+		return nil, nil, fmt.Errorf("consensus strategy requires at least two providers in chain")
+	}
+
+	var provider usecases.WeatherProvider = NewConsensusProvider(members, b.cfg.ConsensusTemperatureThreshold, b.metrics, b.logger)
+	if weatherTTL > 0 {
+		ttl := CacheTTL{
+			Weather:          time.Duration(weatherTTL) * time.Second,
+			RevalidateWindow: time.Duration(b.cfg.CacheRevalidateWindow) * time.Second,
+			LastKnownGood:    time.Duration(b.cfg.CacheLastKnownGoodTTL) * time.Second,
+		}
+		provider = NewCacheDecorator(ConsensusProviderName, provider, b.cache, b.metrics, ttl, b.logger)
+	}
+
+	consensusNames := make([]string, 0, len(names)-len(members)+1)
+	consensusLinks := make([]WeatherChainLink, 0, len(links)-len(members)+1)
+	for i, name := range names {
+		if i == first {
+			consensusNames = append(consensusNames, strings.Join(memberNames, "+"))
+			consensusLinks = append(consensusLinks, NewWeatherLink(provider))
+		} else if name == CacheProviderName {
+			consensusNames = append(consensusNames, name)
+			consensusLinks = append(consensusLinks, links[i])
+		}
+	}
+
+	return consensusNames, consensusLinks, nil
+}
+
+func (b *ChainBuilder) weatherCacheTTL(name string) int {
+	switch name {
+	case WeatherAPIProviderName:
+		return b.cfg.WeatherAPICacheTTL
+	case OpenWeatherProviderName:
+		return b.cfg.OpenWeatherCacheTTL
+	case OpenMeteoProviderName:
+		return b.cfg.OpenMeteoCacheTTL
+	default:
+		return 0
+	}
+}
+
+func (b *ChainBuilder) buildLink(name string) (WeatherChainLink, error) {
+	switch name {
+	case CacheProviderName:
+		return NewCacheWeather(b.cache, b.metrics, b.logger), nil
+
+	case WeatherAPIProviderName:
+		client := weatherapi.NewClient(b.cfg, b.HTTPClient(b.cfg.WeatherAPITimeout), b.logger)
+		ttl := CacheTTL{
+			Weather:          time.Duration(b.cfg.WeatherAPICacheTTL) * time.Second,
+			Forecast:         time.Duration(b.cfg.WeatherAPIForecastCacheTTL) * time.Second,
+			Alerts:           time.Duration(b.cfg.AlertsCacheTTL) * time.Second,
+			AirQuality:       time.Duration(b.cfg.AirQualityCacheTTL) * time.Second,
+			RevalidateWindow: time.Duration(b.cfg.CacheRevalidateWindow) * time.Second,
+			LastKnownGood:    time.Duration(b.cfg.CacheLastKnownGoodTTL) * time.Second,
+		}
+		quota := QuotaLimits{
+			PerMinute:      b.cfg.WeatherAPIMinuteQuota,
+			PerMonth:       b.cfg.WeatherAPIMonthlyQuota,
+			ReservePercent: b.cfg.ProviderQuotaReservePercent,
+		}
+		return b.providerLink(name, NewWeatherAPIProvider(client, b.logger), ttl, b.cfg.WeatherAPIKey, quota), nil
+
+	case OpenWeatherProviderName:
+		client := openweather.NewClient(b.cfg, b.HTTPClient(b.cfg.OpenWeatherTimeout), b.logger)
+		ttl := CacheTTL{
+			Weather:          time.Duration(b.cfg.OpenWeatherCacheTTL) * time.Second,
+			Forecast:         time.Duration(b.cfg.OpenWeatherForecastCacheTTL) * time.Second,
+			Alerts:           time.Duration(b.cfg.AlertsCacheTTL) * time.Second,
+			AirQuality:       time.Duration(b.cfg.AirQualityCacheTTL) * time.Second,
+			RevalidateWindow: time.Duration(b.cfg.CacheRevalidateWindow) * time.Second,
+			LastKnownGood:    time.Duration(b.cfg.CacheLastKnownGoodTTL) * time.Second,
+		}
+		quota := QuotaLimits{
+			PerMinute:      b.cfg.OpenWeatherMinuteQuota,
+			PerMonth:       b.cfg.OpenWeatherMonthlyQuota,
+			ReservePercent: b.cfg.ProviderQuotaReservePercent,
+		}
+		return b.providerLink(name, NewOpenWeatherProvider(client, b.logger), ttl, b.cfg.OpenWeatherKey, quota), nil
+
+	case OpenMeteoProviderName:
+		client := openmeteo.NewClient(b.cfg, b.HTTPClient(b.cfg.OpenMeteoTimeout), b.logger)
+		ttl := CacheTTL{
+			Weather:          time.Duration(b.cfg.OpenMeteoCacheTTL) * time.Second,
+			Forecast:         time.Duration(b.cfg.OpenMeteoForecastCacheTTL) * time.Second,
+			AirQuality:       time.Duration(b.cfg.AirQualityCacheTTL) * time.Second,
+			RevalidateWindow: time.Duration(b.cfg.CacheRevalidateWindow) * time.Second,
+			LastKnownGood:    time.Duration(b.cfg.CacheLastKnownGoodTTL) * time.Second,
+		}
+		return b.providerLink(name, NewOpenMeteoProvider(client, b.logger), ttl, "", QuotaLimits{}), nil
+
+	default:
+		return nil, fmt.Errorf("unknown provider %q in chain", name)
+	}
+}
+
+// providerLink wraps the provider so that only calls reaching its API are
+// measured, recorded to history and counted against the quota, and only fresh
+// results are cached.
+func (b *ChainBuilder) providerLink(name string, provider usecases.WeatherProvider, ttl CacheTTL, apiKey string, quota QuotaLimits) WeatherChainLink {
+	provider = NewInstrumentedProvider(name, provider, b.metrics)
+
+	if b.cfg.HistoryRetentionDays > 0 {
+		provider = NewHistoryProvider(name, provider, b.history, b.logger)
+	}
+
+	if quota.PerMinute > 0 || quota.PerMonth > 0 {
+		provider = NewQuotaProvider(name, apiKey, provider, quota, b.quota, b.metrics, b.logger)
+	}
+
+	if ttl.Weather > 0 || ttl.Forecast > 0 || ttl.Alerts > 0 || ttl.AirQuality > 0 {
+		provider = NewCacheDecorator(name, provider, b.cache, b.metrics, ttl, b.logger)
+	}
+
+	return NewCircuitBreakerLink(name, provider, b.breaker, b.metrics, b.logger)
+}
+
+// CallTimeout bounds one request through the whole chain: every provider in it
+// may use up its PROVIDER_CALL_TIMEOUT before the next one is tried.
+func (b *ChainBuilder) CallTimeout() time.Duration {
+	providers := 0
+	for _, name := range b.cfg.ProviderChain {
+		if strings.ToLower(strings.TrimSpace(name)) != CacheProviderName {
+			providers++
+		}
+	}
+
+	return time.Duration(max(providers, 1)*b.cfg.ProviderCallTimeout) * time.Second
+}
+
+// HTTPClient applies the provider timeout to every attempt and
+// PROVIDER_CALL_TIMEOUT to the whole call with its retries, so a provider that
+// keeps timing out cannot hold the chain for longer.
+func (b *ChainBuilder) HTTPClient(timeoutSeconds int) *http.Client {
+	retry := roundtrip.RetrySettings{
+		MaxRetries:     b.cfg.ProviderMaxRetries,
+		AttemptTimeout: time.Duration(timeoutSeconds) * time.Second,
+		BaseDelay:      time.Duration(b.cfg.ProviderRetryBaseDelayMs) * time.Millisecond,
+		MaxDelay:       time.Duration(b.cfg.ProviderRetryMaxDelayMs) * time.Millisecond,
+	}
+	logSettings := roundtrip.LogSettings{
+		MaxBodyBytes:   b.cfg.LogBodyMaxBytes,
+		BodySampleRate: b.cfg.LogBodySampleRate,
+	}
+
+	return &http.Client{
+		Transport: roundtrip.NewStack(b.transport, retry, logSettings, b.logger),
+		Timeout:   time.Duration(b.cfg.ProviderCallTimeout) * time.Second,
+	}
+}
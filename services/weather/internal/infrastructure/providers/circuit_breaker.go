@@ -0,0 +1,272 @@
+package providers
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+	"weather-forecast/pkg/logger"
+	"weather-service/internal/domain/models"
+	"weather-service/internal/domain/usecases"
+	infraerrors "weather-service/internal/infrastructure/errors"
+)
+
+const (
+	CircuitClosed CircuitState = iota
+	CircuitHalfOpen
+	CircuitOpen
+)
+
+type (
+	CircuitState int
+
+	CircuitStateRecorder interface {
+		RecordCircuitState(provider string, state CircuitState)
+	}
+
+	CircuitBreakerSettings struct {
+		// FailureThreshold is the amount of consecutive failures that opens the circuit.
+		FailureThreshold int
+		// SuccessThreshold is the amount of successful trial calls in half-open
+		// state that closes the circuit again.
+		SuccessThreshold int
+		CoolDown         time.Duration
+	}
+
+	CircuitBreakerLink struct {
+		name        string
+		provider    usecases.WeatherProvider
+		settings    CircuitBreakerSettings
+		nextSection WeatherChainLink
+		metrics     CircuitStateRecorder
+		logger      logger.Logger
+
+		mu            sync.Mutex
+		state         CircuitState
+		failures      int
+		successes     int
+		openedAt      time.Time
+		trialInFlight bool
+	}
+)
+
+func (s CircuitState) String() string {
+	switch s {
+	case CircuitClosed:
+		return "closed"
+	case CircuitHalfOpen:
+		return "half-open"
+	case CircuitOpen:
+		return "open"
+	default:
+		return "unknown"
+	}
+}
+
+func NewCircuitBreakerLink(name string, provider usecases.WeatherProvider, settings CircuitBreakerSettings, metrics CircuitStateRecorder, logger logger.Logger) *CircuitBreakerLink {
+	metrics.RecordCircuitState(name, CircuitClosed)
+
+	return &CircuitBreakerLink{
+		name:     name,
+		provider: provider,
+		settings: settings,
+		metrics:  metrics,
+		logger:   logger,
+		state:    CircuitClosed,
+	}
+}
+
+func (c *CircuitBreakerLink) SetNext(section WeatherChainLink) {
+	c.nextSection = section
+}
+
+func (c *CircuitBreakerLink) GetWeatherByCity(ctx context.Context, location models.Location, options models.WeatherOptions) (*models.Weather, error) {
+	if !c.allow(ctx) {
+		if c.nextSection != nil {
+			return c.nextSection.GetWeatherByCity(ctx, location, options)
+		}
+
+		return nil, infraerrors.ErrProviderUnavailable
+	}
+
+	weather, err := c.provider.GetWeatherByCity(ctx, location, options)
+	c.report(ctx, err)
+
+	if err != nil {
+		if c.nextSection != nil {
+			return c.nextSection.GetWeatherByCity(ctx, location, options)
+		}
+
+		return nil, err
+	}
+
+	return weather, nil
+}
+
+func (c *CircuitBreakerLink) GetForecastByCity(ctx context.Context, location models.Location, days int) (*models.Forecast, error) {
+	if !c.allow(ctx) {
+		if c.nextSection != nil {
+			return c.nextSection.GetForecastByCity(ctx, location, days)
+		}
+
+		return nil, infraerrors.ErrProviderUnavailable
+	}
+
+	forecast, err := c.provider.GetForecastByCity(ctx, location, days)
+	c.report(ctx, err)
+
+	if err != nil {
+		if c.nextSection != nil {
+			return c.nextSection.GetForecastByCity(ctx, location, days)
+		}
+
+		return nil, err
+	}
+
+	return forecast, nil
+}
+
+func (c *CircuitBreakerLink) GetAlertsByCity(ctx context.Context, location models.Location) (*models.Alerts, error) {
+	if !c.allow(ctx) {
+		if c.nextSection != nil {
+			return c.nextSection.GetAlertsByCity(ctx, location)
+		}
+
+		return nil, infraerrors.ErrProviderUnavailable
+	}
+
+	alerts, err := c.provider.GetAlertsByCity(ctx, location)
+	c.report(ctx, err)
+
+	if err != nil {
+		if c.nextSection != nil {
+			return c.nextSection.GetAlertsByCity(ctx, location)
+		}
+
+		return nil, err
+	}
+
+	return alerts, nil
+}
+
+func (c *CircuitBreakerLink) GetAirQualityByCity(ctx context.Context, location models.Location) (*models.AirQuality, error) {
+	if !c.allow(ctx) {
+		if c.nextSection != nil {
+			return c.nextSection.GetAirQualityByCity(ctx, location)
+		}
+
+		return nil, infraerrors.ErrProviderUnavailable
+	}
+
+	airQuality, err := c.provider.GetAirQualityByCity(ctx, location)
+	c.report(ctx, err)
+
+	if err != nil {
+		if c.nextSection != nil {
+			return c.nextSection.GetAirQualityByCity(ctx, location)
+		}
+
+		return nil, err
+	}
+
+	return airQuality, nil
+}
+
+// allow reports whether the wrapped provider may be called. Once the cool-down
+// of an open circuit has passed, a single trial call at a time is let through.
+func (c *CircuitBreakerLink) allow(ctx context.Context) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	switch c.state {
+	case CircuitOpen:
+		if time.Since(c.openedAt) < c.settings.CoolDown {
+			c.logger.WithContext(ctx).Debugf("Circuit of provider %s is open, skipping", c.name)
+			return false
+		}
+		c.setState(ctx, CircuitHalfOpen)
+		c.trialInFlight = true
+		return true
+
+	case CircuitHalfOpen:
+		if c.trialInFlight {
+			return false
+		}
+		c.trialInFlight = true
+		return true
+
+	default:
+		return true
+	}
+}
+
+func (c *CircuitBreakerLink) report(ctx context.Context, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.state == CircuitOpen {
+		return
+	}
+
+	// Calls cancelled by the caller, e.g. the losing side of a hedged request,
+	// say nothing about the provider health: they are neither a success nor
+	// a failure and only give up the trial slot.
+	if errors.Is(err, context.Canceled) || ctx.Err() != nil {
+		if c.state == CircuitHalfOpen {
+			c.trialInFlight = false
+		}
+		return
+	}
+
+	failed := err != nil && !isProviderHealthy(err)
+
+	if c.state == CircuitHalfOpen {
+		c.trialInFlight = false
+
+		if failed {
+			c.open(ctx)
+			return
+		}
+
+		c.successes++
+		if c.successes >= c.settings.SuccessThreshold {
+			c.failures = 0
+			c.setState(ctx, CircuitClosed)
+		}
+		return
+	}
+
+	if !failed {
+		c.failures = 0
+		return
+	}
+
+	c.failures++
+	if c.failures >= c.settings.FailureThreshold {
+		c.open(ctx)
+	}
+}
+
+func (c *CircuitBreakerLink) open(ctx context.Context) {
+	c.openedAt = time.Now()
+	c.successes = 0
+	c.setState(ctx, CircuitOpen)
+}
+
+func (c *CircuitBreakerLink) setState(ctx context.Context, state CircuitState) {
+	if c.state == state {
+		return
+	}
+
+	c.logger.WithContext(ctx).Warnf("Circuit of provider %s changed state: %s -> %s", c.name, c.state, state)
+	c.state = state
+	c.metrics.RecordCircuitState(c.name, state)
+}
+
+// isProviderHealthy tells apart errors that do not indicate a provider outage.
+func isProviderHealthy(err error) bool {
+	return errors.Is(err, infraerrors.ErrCityNotFound) ||
+		errors.Is(err, infraerrors.ErrAlertsUnsupported) ||
+		errors.Is(err, infraerrors.ErrAirQualityUnsupported) ||
+		errors.Is(err, infraerrors.ErrQuotaExhausted)
+}
@@ -0,0 +1,119 @@
+package providers
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"time"
+	"weather-forecast/pkg/logger"
+	"weather-service/internal/domain/models"
+	"weather-service/internal/domain/usecases"
+
+	"golang.org/x/sync/singleflight"
+)
+
+type (
+	CoalescingRecorder interface {
+		RecordCoalesced()
+	}
+
+	// CoalescingProvider shares one upstream call between concurrent requests
+	// for the same location. The shared call is detached from the caller that
+	// started it, so a cancelled caller does not fail the others waiting on it,
+	// and bounded by its own timeout instead.
+	CoalescingProvider struct {
+		provider usecases.WeatherProvider
+		timeout  time.Duration
+		group    singleflight.Group
+		metrics  CoalescingRecorder
+		logger   logger.Logger
+	}
+)
+
+func NewCoalescingProvider(provider usecases.WeatherProvider, timeout time.Duration, metrics CoalescingRecorder, logger logger.Logger) *CoalescingProvider {
+	return &CoalescingProvider{
+		provider: provider,
+		timeout:  timeout,
+		metrics:  metrics,
+		logger:   logger,
+	}
+}
+
+func (p *CoalescingProvider) GetWeatherByCity(ctx context.Context, location models.Location, options models.WeatherOptions) (*models.Weather, error) {
+	return coalesce(ctx, p, weatherCacheKey(location, options), func(ctx context.Context) (*models.Weather, error) {
+		return p.provider.GetWeatherByCity(ctx, location, options)
+	})
+}
+
+func (p *CoalescingProvider) GetForecastByCity(ctx context.Context, location models.Location, days int) (*models.Forecast, error) {
+	return coalesce(ctx, p, forecastCacheKey(location, days), func(ctx context.Context) (*models.Forecast, error) {
+		return p.provider.GetForecastByCity(ctx, location, days)
+	})
+}
+
+func (p *CoalescingProvider) GetAlertsByCity(ctx context.Context, location models.Location) (*models.Alerts, error) {
+	return coalesce(ctx, p, alertsCacheKey(location), func(ctx context.Context) (*models.Alerts, error) {
+		return p.provider.GetAlertsByCity(ctx, location)
+	})
+}
+
+func (p *CoalescingProvider) GetAirQualityByCity(ctx context.Context, location models.Location) (*models.AirQuality, error) {
+	return coalesce(ctx, p, airQualityCacheKey(location), func(ctx context.Context) (*models.AirQuality, error) {
+		return p.provider.GetAirQualityByCity(ctx, location)
+	})
+}
+
+func coalesce[T any](ctx context.Context, p *CoalescingProvider, key string, call func(context.Context) (*T, error)) (*T, error) {
+	leader := false
+	if usecases.RefreshRequested(ctx) {
+		// A refresh must not be answered by a read that may come from cache.
+		key += ":refresh"
+	}
+	if freshUntil, ok := usecases.FreshUntilRequested(ctx); ok {
+		// Nor may a read that accepts entries expiring before freshUntil.
+		key += ":fresh-until:" + strconv.FormatInt(freshUntil.Unix(), 10)
+	}
+
+	results := p.group.DoChan(key, func() (interface{}, error) {
+		leader = true
+		callCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), p.timeout)
+		defer cancel()
+		return call(callCtx)
+	})
+
+	select {
+	case result := <-results:
+		if !leader {
+			p.logger.WithContext(ctx).Debugf("Request for key %s coalesced with one in flight", key)
+			p.metrics.RecordCoalesced()
+		}
+
+		if result.Err != nil {
+			return nil, result.Err
+		}
+
+		shared := result.Val.(*T)
+		if shared == nil {
+			return nil, nil
+		}
+
+		// Callers may modify what they get, so each of them receives its own copy.
+		return cloneResult(shared), nil
+
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func cloneResult[T any](shared *T) *T {
+	value := *shared
+
+	switch v := any(&value).(type) {
+	case *models.Forecast:
+		v.Days = slices.Clone(v.Days)
+	case *models.Alerts:
+		v.Items = slices.Clone(v.Items)
+	}
+
+	return &value
+}
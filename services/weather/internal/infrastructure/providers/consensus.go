@@ -0,0 +1,189 @@
+package providers
+
+import (
+	"context"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"weather-forecast/pkg/logger"
+	"weather-service/internal/domain/models"
+	"weather-service/internal/domain/usecases"
+)
+
+type (
+	ConsensusRecorder interface {
+		RecordConsensus(spread float64, disputed bool)
+	}
+
+	// ConsensusProvider asks every member for the current weather at once and
+	// merges their answers. Forecast, alerts and air quality are not merged,
+	// they come from the first member that answers, in chain order.
+	ConsensusProvider struct {
+		members   []NamedProvider
+		threshold float64
+		metrics   ConsensusRecorder
+		logger    logger.Logger
+	}
+)
+
+func NewConsensusProvider(members []NamedProvider, threshold float64, metrics ConsensusRecorder, logger logger.Logger) *ConsensusProvider {
+	return &ConsensusProvider{
+		members:   members,
+		threshold: threshold,
+		metrics:   metrics,
+		logger:    logger,
+	}
+}
+
+// GetWeatherByCity merges the answers of the members that did not fail: the
+// median temperature, humidity and feels-like temperature, the description
+// given by most of them, the indices derived from these and the rest from the
+// first of them in chain order. The weather is stale when any answer is and
+// disputed when the temperatures are more than the threshold, in °C, apart.
+func (p *ConsensusProvider) GetWeatherByCity(ctx context.Context, location models.Location, options models.WeatherOptions) (*models.Weather, error) {
+	log := p.logger.WithContext(ctx)
+
+	// Members answer at the same time, so the first of them is not the link
+	// that served the request.
+	memberCtx := context.WithValue(ctx, servedByKey{}, nil)
+
+	answers := make([]*models.Weather, len(p.members))
+	errs := make([]error, len(p.members))
+
+	var wg sync.WaitGroup
+	for i, member := range p.members {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			answers[i], errs[i] = member.Provider.GetWeatherByCity(memberCtx, location, options)
+		}()
+	}
+	wg.Wait()
+
+	var weathers []models.Weather
+	var names []string
+	var firstErr error
+	for i, member := range p.members {
+		if errs[i] != nil {
+			log.Debugf("Provider %s left out of consensus for city %s: %v", member.Name, location.ID, errs[i])
+			if firstErr == nil {
+				firstErr = errs[i]
+			}
+			continue
+		}
+		weathers = append(weathers, *answers[i])
+		names = append(names, member.Name)
+	}
+
+	if len(weathers) == 0 {
+		log.Warnf("No provider answered for consensus, city: %s", location.ID)
+		return nil, firstErr
+	}
+
+	merged := weathers[0]
+	merged.Temperature = median(weathers, func(w models.Weather) float64 { return w.Temperature })
+	merged.Humidity = int(math.Round(median(weathers, func(w models.Weather) float64 { return float64(w.Humidity) })))
+	merged.FeelsLike = median(weathers, func(w models.Weather) float64 { return w.FeelsLike })
+	merged.Description = majorityDescription(weathers)
+	merged.CalculateIndices(options.Units)
+	for _, weather := range weathers {
+		merged.Stale = merged.Stale || weather.Stale
+	}
+
+	if len(weathers) > 1 {
+		spread := temperatureSpread(weathers, options.Units)
+		merged.Disputed = spread > p.threshold
+		p.metrics.RecordConsensus(spread, merged.Disputed)
+
+		if merged.Disputed {
+			log.Warnf("Providers %s disagree on temperature in city %s by %.1f°C", strings.Join(names, ", "), location.ID, spread)
+		}
+	}
+
+	markServedBy(ctx, ConsensusProviderName)
+
+	return &merged, nil
+}
+
+func (p *ConsensusProvider) GetForecastByCity(ctx context.Context, location models.Location, days int) (*models.Forecast, error) {
+	return firstAnswer(p.members, func(provider usecases.WeatherProvider) (*models.Forecast, error) {
+		return provider.GetForecastByCity(ctx, location, days)
+	})
+}
+
+func (p *ConsensusProvider) GetAlertsByCity(ctx context.Context, location models.Location) (*models.Alerts, error) {
+	return firstAnswer(p.members, func(provider usecases.WeatherProvider) (*models.Alerts, error) {
+		return provider.GetAlertsByCity(ctx, location)
+	})
+}
+
+func (p *ConsensusProvider) GetAirQualityByCity(ctx context.Context, location models.Location) (*models.AirQuality, error) {
+	return firstAnswer(p.members, func(provider usecases.WeatherProvider) (*models.AirQuality, error) {
+		return provider.GetAirQualityByCity(ctx, location)
+	})
+}
+
+func firstAnswer[T any](members []NamedProvider, call func(usecases.WeatherProvider) (*T, error)) (*T, error) {
+	var firstErr error
+	for _, member := range members {
+		value, err := call(member.Provider)
+		if err == nil {
+			return value, nil
+		}
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	return nil, firstErr
+}
+
+func median(weathers []models.Weather, field func(models.Weather) float64) float64 {
+	values := make([]float64, 0, len(weathers))
+	for _, weather := range weathers {
+		values = append(values, field(weather))
+	}
+	sort.Float64s(values)
+
+	middle := len(values) / 2
+	if len(values)%2 == 0 {
+		return (values[middle-1] + values[middle]) / 2
+	}
+
+	return values[middle]
+}
+
+// majorityDescription compares descriptions regardless of case and spacing and
+// returns the most common one as its first provider worded it. A tie goes to
+// the description seen first.
+func majorityDescription(weathers []models.Weather) string {
+	counts := make(map[string]int, len(weathers))
+	for _, weather := range weathers {
+		counts[normalizeDescription(weather.Description)]++
+	}
+
+	description, bestCount := "", 0
+	for _, weather := range weathers {
+		if count := counts[normalizeDescription(weather.Description)]; count > bestCount {
+			description, bestCount = weather.Description, count
+		}
+	}
+
+	return description
+}
+
+func normalizeDescription(description string) string {
+	return strings.ToLower(strings.Join(strings.Fields(description), " "))
+}
+
+func temperatureSpread(weathers []models.Weather, units models.Units) float64 {
+	lowest, highest := math.Inf(1), math.Inf(-1)
+	for _, weather := range weathers {
+		temperature := units.ToCelsius(weather.Temperature)
+		lowest = math.Min(lowest, temperature)
+		highest = math.Max(highest, temperature)
+	}
+
+	return highest - lowest
+}
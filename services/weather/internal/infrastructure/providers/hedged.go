@@ -0,0 +1,183 @@
+package providers
+
+import (
+	"context"
+	"time"
+	"weather-forecast/pkg/logger"
+	"weather-service/internal/domain/models"
+	"weather-service/internal/domain/usecases"
+)
+
+type (
+	HedgeRecorder interface {
+		RecordHedgeFired()
+		RecordHedgeWin(provider string)
+	}
+
+	NamedProvider struct {
+		Name     string
+		Provider usecases.WeatherProvider
+	}
+
+	HedgedLink struct {
+		primary     NamedProvider
+		secondary   NamedProvider
+		delay       time.Duration
+		nextSection WeatherChainLink
+		metrics     HedgeRecorder
+		logger      logger.Logger
+	}
+
+	hedgeResult[T any] struct {
+		provider string
+		value    *T
+		err      error
+	}
+)
+
+func NewHedgedLink(primary, secondary NamedProvider, delay time.Duration, metrics HedgeRecorder, logger logger.Logger) *HedgedLink {
+	return &HedgedLink{
+		primary:   primary,
+		secondary: secondary,
+		delay:     delay,
+		metrics:   metrics,
+		logger:    logger,
+	}
+}
+
+func (h *HedgedLink) SetNext(section WeatherChainLink) {
+	h.nextSection = section
+}
+
+func (h *HedgedLink) GetWeatherByCity(ctx context.Context, location models.Location, options models.WeatherOptions) (*models.Weather, error) {
+	weather, err := hedge(ctx, h, func(ctx context.Context, provider usecases.WeatherProvider) (*models.Weather, error) {
+		return provider.GetWeatherByCity(ctx, location, options)
+	})
+
+	if err != nil {
+		if h.nextSection != nil {
+			return h.nextSection.GetWeatherByCity(ctx, location, options)
+		}
+
+		return nil, err
+	}
+
+	return weather, nil
+}
+
+func (h *HedgedLink) GetForecastByCity(ctx context.Context, location models.Location, days int) (*models.Forecast, error) {
+	forecast, err := hedge(ctx, h, func(ctx context.Context, provider usecases.WeatherProvider) (*models.Forecast, error) {
+		return provider.GetForecastByCity(ctx, location, days)
+	})
+
+	if err != nil {
+		if h.nextSection != nil {
+			return h.nextSection.GetForecastByCity(ctx, location, days)
+		}
+
+		return nil, err
+	}
+
+	return forecast, nil
+}
+
+func (h *HedgedLink) GetAlertsByCity(ctx context.Context, location models.Location) (*models.Alerts, error) {
+	alerts, err := hedge(ctx, h, func(ctx context.Context, provider usecases.WeatherProvider) (*models.Alerts, error) {
+		return provider.GetAlertsByCity(ctx, location)
+	})
+
+	if err != nil {
+		if h.nextSection != nil {
+			return h.nextSection.GetAlertsByCity(ctx, location)
+		}
+
+		return nil, err
+	}
+
+	return alerts, nil
+}
+
+func (h *HedgedLink) GetAirQualityByCity(ctx context.Context, location models.Location) (*models.AirQuality, error) {
+	airQuality, err := hedge(ctx, h, func(ctx context.Context, provider usecases.WeatherProvider) (*models.AirQuality, error) {
+		return provider.GetAirQualityByCity(ctx, location)
+	})
+
+	if err != nil {
+		if h.nextSection != nil {
+			return h.nextSection.GetAirQualityByCity(ctx, location)
+		}
+
+		return nil, err
+	}
+
+	return airQuality, nil
+}
+
+// hedge calls the primary provider and fires the secondary one if the primary
+// has not answered within the delay or has already failed. The first success
+// wins and the call still in flight is cancelled. When both fail, the error of
+// the primary is returned.
+func hedge[T any](ctx context.Context, h *HedgedLink, call func(context.Context, usecases.WeatherProvider) (*T, error)) (*T, error) {
+	log := h.logger.WithContext(ctx)
+
+	hedgeCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	results := make(chan hedgeResult[T], 2)
+	run := func(named NamedProvider) {
+		value, err := call(hedgeCtx, named.Provider)
+		results <- hedgeResult[T]{provider: named.Name, value: value, err: err}
+	}
+
+	go run(h.primary)
+
+	timer := time.NewTimer(h.delay)
+	defer timer.Stop()
+
+	secondaryFired := false
+	fireSecondary := func() {
+		secondaryFired = true
+		h.metrics.RecordHedgeFired()
+		go run(h.secondary)
+	}
+
+	var primaryErr, secondaryErr error
+	pending := 1
+
+	for pending > 0 {
+		select {
+		case <-timer.C:
+			if !secondaryFired {
+				log.Debugf("Provider %s did not answer within %s, hedging with %s", h.primary.Name, h.delay, h.secondary.Name)
+				fireSecondary()
+				pending++
+			}
+
+		case result := <-results:
+			pending--
+
+			if result.err == nil {
+				log.Debugf("Hedged request won by provider %s", result.provider)
+				h.metrics.RecordHedgeWin(result.provider)
+				return result.value, nil
+			}
+
+			if result.provider == h.primary.Name {
+				primaryErr = result.err
+				if !secondaryFired {
+					fireSecondary()
+					pending++
+				}
+			} else {
+				secondaryErr = result.err
+			}
+
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	log.Warnf("Both hedged providers failed: %s: %v, %s: %v", h.primary.Name, primaryErr, h.secondary.Name, secondaryErr)
+
+	return nil, primaryErr
+}
@@ -0,0 +1,59 @@
+package providers
+
+import (
+	"context"
+	"time"
+	"weather-forecast/pkg/logger"
+	"weather-service/internal/domain/models"
+	"weather-service/internal/domain/usecases"
+)
+
+type (
+	ObservationWriter interface {
+		Append(ctx context.Context, observation models.Observation) error
+	}
+
+	// HistoryProvider records every weather fetched from the provider API.
+	// A failed write is logged and never fails the call.
+	HistoryProvider struct {
+		name         string
+		provider     usecases.WeatherProvider
+		observations ObservationWriter
+		logger       logger.Logger
+	}
+)
+
+func NewHistoryProvider(name string, provider usecases.WeatherProvider, observations ObservationWriter, logger logger.Logger) *HistoryProvider {
+	return &HistoryProvider{
+		name:         name,
+		provider:     provider,
+		observations: observations,
+		logger:       logger,
+	}
+}
+
+func (p *HistoryProvider) GetWeatherByCity(ctx context.Context, location models.Location, options models.WeatherOptions) (*models.Weather, error) {
+	weather, err := p.provider.GetWeatherByCity(ctx, location, options)
+	if err != nil {
+		return nil, err
+	}
+
+	observation := models.NewObservation(location.ID, p.name, time.Now(), weather, options.WithDefaults().Units)
+	if err := p.observations.Append(ctx, observation); err != nil {
+		p.logger.WithContext(ctx).Warnf("Failed to record %s observation for %s: %v", p.name, location.ID, err)
+	}
+
+	return weather, nil
+}
+
+func (p *HistoryProvider) GetForecastByCity(ctx context.Context, location models.Location, days int) (*models.Forecast, error) {
+	return p.provider.GetForecastByCity(ctx, location, days)
+}
+
+func (p *HistoryProvider) GetAlertsByCity(ctx context.Context, location models.Location) (*models.Alerts, error) {
+	return p.provider.GetAlertsByCity(ctx, location)
+}
+
+func (p *HistoryProvider) GetAirQualityByCity(ctx context.Context, location models.Location) (*models.AirQuality, error) {
+	return p.provider.GetAirQualityByCity(ctx, location)
+}
@@ -0,0 +1,95 @@
+package providers
+
+import (
+	"context"
+	"errors"
+	"time"
+	"weather-service/internal/domain/models"
+	"weather-service/internal/domain/usecases"
+	infraerrors "weather-service/internal/infrastructure/errors"
+)
+
+const (
+	OutcomeSuccess  = "success"
+	OutcomeNotFound = "not_found"
+	OutcomeError    = "error"
+	OutcomeTimeout  = "timeout"
+	OutcomeCanceled = "canceled"
+)
+
+type (
+	ProviderMetricsRecorder interface {
+		RecordProviderCall(provider, outcome string, duration time.Duration)
+	}
+
+	// InstrumentedProvider measures every call made to a weather provider API.
+	InstrumentedProvider struct {
+		name     string
+		provider usecases.WeatherProvider
+		metrics  ProviderMetricsRecorder
+	}
+)
+
+func NewInstrumentedProvider(name string, provider usecases.WeatherProvider, metrics ProviderMetricsRecorder) *InstrumentedProvider {
+	return &InstrumentedProvider{
+		name:     name,
+		provider: provider,
+		metrics:  metrics,
+	}
+}
+
+func (p *InstrumentedProvider) GetWeatherByCity(ctx context.Context, location models.Location, options models.WeatherOptions) (*models.Weather, error) {
+	return instrument(ctx, p, func() (*models.Weather, error) {
+		return p.provider.GetWeatherByCity(ctx, location, options)
+	})
+}
+
+func (p *InstrumentedProvider) GetForecastByCity(ctx context.Context, location models.Location, days int) (*models.Forecast, error) {
+	return instrument(ctx, p, func() (*models.Forecast, error) {
+		return p.provider.GetForecastByCity(ctx, location, days)
+	})
+}
+
+func (p *InstrumentedProvider) GetAlertsByCity(ctx context.Context, location models.Location) (*models.Alerts, error) {
+	return instrument(ctx, p, func() (*models.Alerts, error) {
+		return p.provider.GetAlertsByCity(ctx, location)
+	})
+}
+
+func (p *InstrumentedProvider) GetAirQualityByCity(ctx context.Context, location models.Location) (*models.AirQuality, error) {
+	return instrument(ctx, p, func() (*models.AirQuality, error) {
+		return p.provider.GetAirQualityByCity(ctx, location)
+	})
+}
+
+func instrument[T any](ctx context.Context, p *InstrumentedProvider, call func() (*T, error)) (*T, error) {
+	start := time.Now()
+	value, err := call()
+
+	// Unsupported operations are refused before reaching the provider API.
+	if errors.Is(err, infraerrors.ErrAlertsUnsupported) || errors.Is(err, infraerrors.ErrAirQualityUnsupported) {
+		return value, err
+	}
+
+	p.metrics.RecordProviderCall(p.name, callOutcome(ctx, err), time.Since(start))
+	if err == nil {
+		markServedBy(ctx, p.name)
+	}
+
+	return value, err
+}
+
+func callOutcome(ctx context.Context, err error) string {
+	switch {
+	case err == nil:
+		return OutcomeSuccess
+	case errors.Is(err, infraerrors.ErrCityNotFound):
+		return OutcomeNotFound
+	case errors.Is(err, infraerrors.ErrProviderTimeout) || errors.Is(ctx.Err(), context.DeadlineExceeded):
+		return OutcomeTimeout
+	case errors.Is(ctx.Err(), context.Canceled):
+		return OutcomeCanceled
+	default:
+		return OutcomeError
+	}
+}
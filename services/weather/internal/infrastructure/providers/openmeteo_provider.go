@@ -0,0 +1,169 @@
+package providers
+
+import (
+	"context"
+	"weather-service/internal/domain/models"
+	"weather-service/internal/infrastructure/clients/openmeteo"
+	infraerrors "weather-service/internal/infrastructure/errors"
+
+	"weather-forecast/pkg/logger"
+)
+
+const unknownWeatherCode = "Unknown"
+
+// wmoWeatherCodes describes WMO weather interpretation codes used by Open-Meteo.
+var wmoWeatherCodes = map[int]string{
+	0:  "Clear sky",
+	1:  "Mainly clear",
+	2:  "Partly cloudy",
+	3:  "Overcast",
+	45: "Fog",
+	48: "Depositing rime fog",
+	51: "Light drizzle",
+	53: "Moderate drizzle",
+	55: "Dense drizzle",
+	56: "Light freezing drizzle",
+	57: "Dense freezing drizzle",
+	61: "Slight rain",
+	63: "Moderate rain",
+	65: "Heavy rain",
+	66: "Light freezing rain",
+	67: "Heavy freezing rain",
+	71: "Slight snow fall",
+	73: "Moderate snow fall",
+	75: "Heavy snow fall",
+	77: "Snow grains",
+	80: "Slight rain showers",
+	81: "Moderate rain showers",
+	82: "Violent rain showers",
+	85: "Slight snow showers",
+	86: "Heavy snow showers",
+	95: "Thunderstorm",
+	96: "Thunderstorm with slight hail",
+	99: "Thunderstorm with heavy hail",
+}
+
+type (
+	OpenMeteoProvider struct {
+		client *openmeteo.OpenMeteoClient
+		logger logger.Logger
+	}
+)
+
+func NewOpenMeteoProvider(client *openmeteo.OpenMeteoClient, logger logger.Logger) *OpenMeteoProvider {
+	return &OpenMeteoProvider{
+		client: client,
+		logger: logger,
+	}
+}
+
+func (p *OpenMeteoProvider) GetWeatherByCity(ctx context.Context, location models.Location, options models.WeatherOptions) (*models.Weather, error) {
+
+	log := p.logger.WithContext(ctx)
+
+	log.Debugf("Requesting weather data from Open-Meteo for city: %s", location.Name)
+
+	weatherResponse, err := p.client.GetWeather(ctx, location)
+	log.Debugf("Processing Open-Meteo response for city: %s", location.Name)
+
+	if err != nil {
+		return nil, err
+	}
+
+	current := weatherResponse.Current
+	result := models.Weather{
+		Temperature:   options.Units.FromCelsius(current.Temperature),
+		Humidity:      current.RelativeHumidity,
+		Description:   describeWeatherCode(current.WeatherCode),
+		WindSpeed:     options.Units.FromKph(current.WindSpeed),
+		WindDirection: current.WindDirection,
+		Pressure:      current.SurfacePressure,
+		CloudCover:    current.CloudCover,
+		Visibility:    current.Visibility / metersInKilometer,
+		FeelsLike:     options.Units.FromCelsius(current.ApparentTemperature),
+	}
+
+	log.Infof("Open-Meteo data processed successfully for city: %s", location.Name)
+
+	return &result, nil
+}
+
+func (p *OpenMeteoProvider) GetForecastByCity(ctx context.Context, location models.Location, days int) (*models.Forecast, error) {
+
+	log := p.logger.WithContext(ctx)
+
+	log.Debugf("Requesting forecast data from Open-Meteo for city: %s", location.Name)
+
+	forecastResponse, err := p.client.GetForecast(ctx, location, days)
+	log.Debugf("Processing Open-Meteo forecast response for city: %s", location.Name)
+
+	if err != nil {
+		return nil, err
+	}
+
+	daily := forecastResponse.Daily
+	result := models.Forecast{
+		Days: make([]models.DailyForecast, 0, len(daily.Time)),
+	}
+
+	for i, date := range daily.Time {
+		if i >= len(daily.TemperatureMin) || i >= len(daily.TemperatureMax) {
+			log.Warnf("Open-Meteo returned incomplete forecast for city: %s", location.Name)
+			break
+		}
+
+		day := models.DailyForecast{
+			Date:           date,
+			MinTemperature: daily.TemperatureMin[i],
+			MaxTemperature: daily.TemperatureMax[i],
+			Condition:      unknownWeatherCode,
+		}
+		if i < len(daily.PrecipitationProbabilityMax) {
+			day.PrecipitationChance = daily.PrecipitationProbabilityMax[i]
+		}
+		if i < len(daily.WeatherCode) {
+			day.Condition = describeWeatherCode(daily.WeatherCode[i])
+		}
+
+		result.Days = append(result.Days, day)
+	}
+
+	log.Infof("Open-Meteo forecast processed successfully for city: %s", location.Name)
+
+	return &result, nil
+}
+
+func describeWeatherCode(code int) string {
+	if description, ok := wmoWeatherCodes[code]; ok {
+		return description
+	}
+
+	return unknownWeatherCode
+}
+
+func (p *OpenMeteoProvider) GetAlertsByCity(ctx context.Context, location models.Location) (*models.Alerts, error) {
+	p.logger.WithContext(ctx).Debugf("Open-Meteo does not provide alerts, city: %s", location.Name)
+
+	return nil, infraerrors.ErrAlertsUnsupported
+}
+
+func (p *OpenMeteoProvider) GetAirQualityByCity(ctx context.Context, location models.Location) (*models.AirQuality, error) {
+
+	log := p.logger.WithContext(ctx)
+
+	log.Debugf("Requesting air quality from Open-Meteo for city: %s", location.Name)
+
+	airQualityResponse, err := p.client.GetAirQuality(ctx, location)
+	log.Debugf("Processing Open-Meteo air quality response for city: %s", location.Name)
+
+	if err != nil {
+		return nil, err
+	}
+
+	current := airQualityResponse.Current
+	result := models.NewAirQuality(current.PM25, current.PM10, current.O3, current.NO2)
+
+	log.Infof("Open-Meteo air quality processed successfully for city: %s", location.Name)
+
+	return &result, nil
+}
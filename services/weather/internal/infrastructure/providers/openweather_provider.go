@@ -2,12 +2,20 @@ package providers
 
 import (
 	"context"
+	"math"
+	"time"
 	"weather-service/internal/domain/models"
 	"weather-service/internal/infrastructure/clients/openweather"
+	infraerrors "weather-service/internal/infrastructure/errors"
 
 	"weather-forecast/pkg/logger"
 )
 
+const (
+	metersPerSecondToKph = 3.6
+	metersInKilometer    = 1000
+)
+
 type (
 	OpenWeatherProvider struct {
 		client *openweather.OpenWeatherClient
@@ -22,14 +30,14 @@ func NewOpenWeatherProvider(client *openweather.OpenWeatherClient, logger logger
 	}
 }
 
-func (p *OpenWeatherProvider) GetWeatherByCity(ctx context.Context, city string) (*models.Weather, error) {
+func (p *OpenWeatherProvider) GetWeatherByCity(ctx context.Context, location models.Location, options models.WeatherOptions) (*models.Weather, error) {
 
 	log := p.logger.WithContext(ctx)
 
-	log.Debugf("Requesting weather data from OpenWeather for city: %s", city)
+	log.Debugf("Requesting weather data from OpenWeather for city: %s", location.Name)
 
-	weatherResponse, err := p.client.GetWeather(ctx, city)
-	log.Debugf("Processing OpenWeather response for city: %s", city)
+	weatherResponse, err := p.client.GetWeather(ctx, location, options)
+	log.Debugf("Processing OpenWeather response for city: %s", location.Name)
 	if err != nil {
 		return nil, err
 	}
@@ -39,17 +47,160 @@ func (p *OpenWeatherProvider) GetWeatherByCity(ctx context.Context, city string)
 	if len(weatherResponse.Weather) > 0 {
 		weatherDesc = weatherResponse.Weather[0].Description
 	} else {
-		log.Warnf("OpenWeather did not provide weather description for city: %s", city)
+		log.Warnf("OpenWeather did not provide weather description for city: %s", location.Name)
 	}
 
 	result := models.Weather{
-		Temperature: weatherResponse.Main.Temperature,
-		Humidity:    weatherResponse.Main.Humidity,
-		Description: weatherDesc,
+		Temperature:   weatherResponse.Main.Temperature,
+		Humidity:      weatherResponse.Main.Humidity,
+		Description:   weatherDesc,
+		WindSpeed:     openWeatherWindSpeed(weatherResponse.Wind.Speed, options.Units),
+		WindDirection: weatherResponse.Wind.Degree,
+		Pressure:      weatherResponse.Main.Pressure,
+		CloudCover:    weatherResponse.Clouds.All,
+		Visibility:    float64(weatherResponse.Visibility) / metersInKilometer,
+		FeelsLike:     weatherResponse.Main.FeelsLike,
 	}
 
-	log.Infof("OpenWeather data processed successfully for city: %s", city)
+	log.Infof("OpenWeather data processed successfully for city: %s", location.Name)
 
 	return &result, nil
 
 }
+
+func (p *OpenWeatherProvider) GetForecastByCity(ctx context.Context, location models.Location, days int) (*models.Forecast, error) {
+
+	log := p.logger.WithContext(ctx)
+
+	log.Debugf("Requesting forecast data from OpenWeather for city: %s", location.Name)
+
+	forecastResponse, err := p.client.GetForecast(ctx, location, days)
+	log.Debugf("Processing OpenWeather forecast response for city: %s", location.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	result := models.Forecast{
+		Days: aggregateOpenWeatherForecast(forecastResponse, days),
+	}
+
+	log.Infof("OpenWeather forecast processed successfully for city: %s", location.Name)
+
+	return &result, nil
+}
+
+// aggregateOpenWeatherForecast folds 3-hour forecast steps into days of the
+// city's local time. The condition of a day is the most frequent one among its steps.
+func aggregateOpenWeatherForecast(forecastResponse *openweather.OpenWeatherForecastResponse, days int) []models.DailyForecast {
+	const dateLayout = "2006-01-02"
+
+	location := time.FixedZone("", forecastResponse.City.Timezone)
+	result := make([]models.DailyForecast, 0, days)
+	conditionCounts := make(map[string]int)
+
+	for _, item := range forecastResponse.List {
+		date := time.Unix(item.Timestamp, 0).In(location).Format(dateLayout)
+		precipitationChance := int(math.Round(item.PrecipitationProbability * 100))
+
+		if len(result) == 0 || result[len(result)-1].Date != date {
+			if len(result) == days {
+				break
+			}
+			result = append(result, models.DailyForecast{
+				Date:                date,
+				MinTemperature:      item.Main.TempMin,
+				MaxTemperature:      item.Main.TempMax,
+				PrecipitationChance: precipitationChance,
+			})
+			clear(conditionCounts)
+		}
+
+		day := &result[len(result)-1]
+		day.MinTemperature = math.Min(day.MinTemperature, item.Main.TempMin)
+		day.MaxTemperature = math.Max(day.MaxTemperature, item.Main.TempMax)
+		day.PrecipitationChance = max(day.PrecipitationChance, precipitationChance)
+
+		if len(item.Weather) > 0 {
+			description := item.Weather[0].Description
+			conditionCounts[description]++
+			if day.Condition == "" || conditionCounts[description] > conditionCounts[day.Condition] {
+				day.Condition = description
+			}
+		}
+	}
+
+	return result
+}
+
+// openWeatherWindSpeed converts the wind speed that OpenWeather reports in m/s
+// for metric units, imperial and standard units are already reported as requested.
+func openWeatherWindSpeed(speed float64, units models.Units) float64 {
+	if units == models.UnitsMetric {
+		return speed * metersPerSecondToKph
+	}
+
+	return speed
+}
+
+func (p *OpenWeatherProvider) GetAlertsByCity(ctx context.Context, location models.Location) (*models.Alerts, error) {
+
+	log := p.logger.WithContext(ctx)
+
+	log.Debugf("Requesting alerts from OpenWeather for city: %s", location.Name)
+
+	alertsResponse, err := p.client.GetAlerts(ctx, location)
+	log.Debugf("Processing OpenWeather alerts response for city: %s", location.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	result := models.Alerts{
+		Items: make([]models.Alert, 0, len(alertsResponse.Alerts)),
+	}
+
+	for _, alert := range alertsResponse.Alerts {
+		start := time.Unix(alert.Start, 0).UTC()
+		headline := alert.Event
+		if alert.SenderName != "" {
+			headline += " issued by " + alert.SenderName
+		}
+
+		result.Items = append(result.Items, models.Alert{
+			ID:          models.AlertID(alert.Event, start),
+			Event:       alert.Event,
+			Headline:    headline,
+			Description: alert.Description,
+			Start:       start,
+			End:         time.Unix(alert.End, 0).UTC(),
+		})
+	}
+
+	log.Infof("OpenWeather alerts processed successfully for city: %s", location.Name)
+
+	return &result, nil
+}
+
+func (p *OpenWeatherProvider) GetAirQualityByCity(ctx context.Context, location models.Location) (*models.AirQuality, error) {
+
+	log := p.logger.WithContext(ctx)
+
+	log.Debugf("Requesting air quality from OpenWeather for city: %s", location.Name)
+
+	airQualityResponse, err := p.client.GetAirQuality(ctx, location)
+	log.Debugf("Processing OpenWeather air quality response for city: %s", location.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(airQualityResponse.List) == 0 {
+		log.Warnf("OpenWeather returned no air quality data for city: %s", location.Name)
+		return nil, infraerrors.ErrGetWeather
+	}
+
+	components := airQualityResponse.List[0].Components
+	result := models.NewAirQuality(components.PM25, components.PM10, components.O3, components.NO2)
+
+	log.Infof("OpenWeather air quality processed successfully for city: %s", location.Name)
+
+	return &result, nil
+}
@@ -0,0 +1,174 @@
+package providers
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+	"weather-forecast/pkg/logger"
+	"weather-service/internal/domain/models"
+	"weather-service/internal/domain/usecases"
+	infraerrors "weather-service/internal/infrastructure/errors"
+	"weather-service/internal/infrastructure/roundtrip"
+)
+
+const (
+	QuotaMinuteWindow = "minute"
+	QuotaMonthWindow  = "month"
+)
+
+type (
+	// QuotaLimits is the call budget of a provider API key, a zero limit is not
+	// tracked. A call is refused once less than ReservePercent of a budget is left.
+	QuotaLimits struct {
+		PerMinute      int
+		PerMonth       int
+		ReservePercent int
+	}
+
+	QuotaCounter interface {
+		Increment(ctx context.Context, key string, expiration time.Duration) (int64, error)
+		Decrement(ctx context.Context, key string) error
+	}
+
+	QuotaRecorder interface {
+		RecordQuotaRemaining(provider, window string, remaining int)
+	}
+
+	quotaWindow struct {
+		name       string
+		limit      int
+		period     string
+		expiration time.Duration
+	}
+
+	// QuotaProvider counts calls of a provider in counters shared by all
+	// replicas and refuses calls that would exhaust the budget, so the chain
+	// moves on to the next provider.
+	QuotaProvider struct {
+		name     string
+		keyID    string
+		provider usecases.WeatherProvider
+		limits   QuotaLimits
+		counter  QuotaCounter
+		metrics  QuotaRecorder
+		logger   logger.Logger
+	}
+)
+
+func NewQuotaProvider(name, apiKey string, provider usecases.WeatherProvider, limits QuotaLimits, counter QuotaCounter, metrics QuotaRecorder, logger logger.Logger) *QuotaProvider {
+	keyHash := sha256.Sum256([]byte(apiKey))
+
+	return &QuotaProvider{
+		name:     name,
+		keyID:    hex.EncodeToString(keyHash[:6]),
+		provider: provider,
+		limits:   limits,
+		counter:  counter,
+		metrics:  metrics,
+		logger:   logger,
+	}
+}
+
+func (p *QuotaProvider) GetWeatherByCity(ctx context.Context, location models.Location, options models.WeatherOptions) (*models.Weather, error) {
+	if err := p.acquire(ctx); err != nil {
+		return nil, err
+	}
+
+	return p.provider.GetWeatherByCity(p.guardRetries(ctx), location, options)
+}
+
+func (p *QuotaProvider) GetForecastByCity(ctx context.Context, location models.Location, days int) (*models.Forecast, error) {
+	if err := p.acquire(ctx); err != nil {
+		return nil, err
+	}
+
+	return p.provider.GetForecastByCity(p.guardRetries(ctx), location, days)
+}
+
+func (p *QuotaProvider) GetAlertsByCity(ctx context.Context, location models.Location) (*models.Alerts, error) {
+	if err := p.acquire(ctx); err != nil {
+		return nil, err
+	}
+
+	return p.provider.GetAlertsByCity(p.guardRetries(ctx), location)
+}
+
+func (p *QuotaProvider) GetAirQualityByCity(ctx context.Context, location models.Location) (*models.AirQuality, error) {
+	if err := p.acquire(ctx); err != nil {
+		return nil, err
+	}
+
+	return p.provider.GetAirQualityByCity(p.guardRetries(ctx), location)
+}
+
+// guardRetries counts every retry of the transport as a call of its own, as
+// each attempt is billed by the provider.
+func (p *QuotaProvider) guardRetries(ctx context.Context) context.Context {
+	return roundtrip.WithAttemptGuard(ctx, p.acquire)
+}
+
+// acquire counts the call in every tracked window. A refused call is taken
+// back from the counters, failing counters let the call through since the
+// quota must not stop serving weather on its own.
+func (p *QuotaProvider) acquire(ctx context.Context) error {
+	log := p.logger.WithContext(ctx)
+
+	var counted []string
+	for _, window := range p.windows(time.Now().UTC()) {
+		key := p.counterKey(window)
+
+		count, err := p.counter.Increment(ctx, key, window.expiration)
+		if err != nil {
+			log.Warnf("Count %s quota of provider %s: %s", window.name, p.name, err.Error())
+			continue
+		}
+
+		if int(count) > window.limit-window.limit*p.limits.ReservePercent/100 {
+			p.metrics.RecordQuotaRemaining(p.name, window.name, window.limit-int(count)+1)
+			p.release(ctx, append(counted, key))
+			log.Warnf("Provider %s is near its %s quota (%d of %d used), skipping", p.name, window.name, count-1, window.limit)
+			return infraerrors.ErrQuotaExhausted
+		}
+
+		p.metrics.RecordQuotaRemaining(p.name, window.name, window.limit-int(count))
+		counted = append(counted, key)
+	}
+
+	return nil
+}
+
+func (p *QuotaProvider) release(ctx context.Context, keys []string) {
+	for _, key := range keys {
+		if err := p.counter.Decrement(ctx, key); err != nil {
+			p.logger.WithContext(ctx).Warnf("Release quota of provider %s: %s", p.name, err.Error())
+		}
+	}
+}
+
+func (p *QuotaProvider) windows(now time.Time) []quotaWindow {
+	var windows []quotaWindow
+	if p.limits.PerMinute > 0 {
+		windows = append(windows, quotaWindow{
+			name:       QuotaMinuteWindow,
+			limit:      p.limits.PerMinute,
+			period:     now.Format("200601021504"),
+			expiration: 2 * time.Minute,
+		})
+	}
+	if p.limits.PerMonth > 0 {
+		windows = append(windows, quotaWindow{
+			name:       QuotaMonthWindow,
+			limit:      p.limits.PerMonth,
+			period:     now.Format("200601"),
+			expiration: 32 * 24 * time.Hour,
+		})
+	}
+
+	return windows
+}
+
+func (p *QuotaProvider) counterKey(window quotaWindow) string {
+	return fmt.Sprintf("quota:%s:%s:%s:%s", p.name, p.keyID, window.name, window.period)
+}
@@ -0,0 +1,95 @@
+package providers
+
+import (
+	"context"
+	"sync/atomic"
+	"weather-service/internal/domain/models"
+)
+
+const (
+	WeatherOperation    = "weather"
+	ForecastOperation   = "forecast"
+	AlertsOperation     = "alerts"
+	AirQualityOperation = "air_quality"
+
+	unknownServedBy = "unknown"
+)
+
+type (
+	ServedByRecorder interface {
+		RecordServedBy(provider, operation string)
+	}
+
+	servedByKey struct{}
+
+	servedBy struct {
+		name atomic.Pointer[string]
+	}
+
+	// ServedByLink sits in front of the chain and records which link answered
+	// each request.
+	ServedByLink struct {
+		head    WeatherChainLink
+		metrics ServedByRecorder
+	}
+)
+
+func NewServedByLink(head WeatherChainLink, metrics ServedByRecorder) *ServedByLink {
+	return &ServedByLink{
+		head:    head,
+		metrics: metrics,
+	}
+}
+
+func (l *ServedByLink) SetNext(section WeatherChainLink) {
+	l.head.SetNext(section)
+}
+
+func (l *ServedByLink) GetWeatherByCity(ctx context.Context, location models.Location, options models.WeatherOptions) (*models.Weather, error) {
+	return serve(ctx, l, WeatherOperation, func(ctx context.Context) (*models.Weather, error) {
+		return l.head.GetWeatherByCity(ctx, location, options)
+	})
+}
+
+func (l *ServedByLink) GetForecastByCity(ctx context.Context, location models.Location, days int) (*models.Forecast, error) {
+	return serve(ctx, l, ForecastOperation, func(ctx context.Context) (*models.Forecast, error) {
+		return l.head.GetForecastByCity(ctx, location, days)
+	})
+}
+
+func (l *ServedByLink) GetAlertsByCity(ctx context.Context, location models.Location) (*models.Alerts, error) {
+	return serve(ctx, l, AlertsOperation, func(ctx context.Context) (*models.Alerts, error) {
+		return l.head.GetAlertsByCity(ctx, location)
+	})
+}
+
+func (l *ServedByLink) GetAirQualityByCity(ctx context.Context, location models.Location) (*models.AirQuality, error) {
+	return serve(ctx, l, AirQualityOperation, func(ctx context.Context) (*models.AirQuality, error) {
+		return l.head.GetAirQualityByCity(ctx, location)
+	})
+}
+
+func serve[T any](ctx context.Context, l *ServedByLink, operation string, fetch func(context.Context) (*T, error)) (*T, error) {
+	answer := &servedBy{}
+
+	value, err := fetch(context.WithValue(ctx, servedByKey{}, answer))
+	if err != nil {
+		return nil, err
+	}
+
+	name := unknownServedBy
+	if answered := answer.name.Load(); answered != nil {
+		name = *answered
+	}
+	l.metrics.RecordServedBy(name, operation)
+
+	return value, nil
+}
+
+// markServedBy remembers the first link that answered the request, later
+// answers come from background revalidation or the losing side of a hedge.
+func markServedBy(ctx context.Context, name string) {
+	if answer, ok := ctx.Value(servedByKey{}).(*servedBy); ok {
+		answer.name.CompareAndSwap(nil, &name)
+	}
+}
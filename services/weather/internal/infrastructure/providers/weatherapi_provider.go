@@ -2,6 +2,7 @@ package providers
 
 import (
 	"context"
+	"time"
 	"weather-service/internal/domain/models"
 	"weather-service/internal/infrastructure/clients/weatherapi"
 
@@ -23,26 +24,130 @@ func NewWeatherAPIProvider(client *weatherapi.WeatherAPIClient, logger logger.Lo
 	}
 }
 
-func (p *WeatherAPIProvider) GetWeatherByCity(ctx context.Context, city string) (*models.Weather, error) {
+func (p *WeatherAPIProvider) GetWeatherByCity(ctx context.Context, location models.Location, options models.WeatherOptions) (*models.Weather, error) {
 
 	log := p.logger.WithContext(ctx)
 
-	log.Debugf("Requesting weather data from WeatherAPI for city: %s", city)
+	log.Debugf("Requesting weather data from WeatherAPI for city: %s", location.Name)
 
-	weatherResponse, err := p.client.GetWeather(ctx, city)
-	log.Debugf("Processing WeatherAPI response for city: %s", city)
+	weatherResponse, err := p.client.GetWeather(ctx, location, options.Lang)
+	log.Debugf("Processing WeatherAPI response for city: %s", location.Name)
 
 	if err != nil {
 		return nil, err
 	}
 
 	result := models.Weather{
-		Temperature: weatherResponse.Current.TempC,
-		Humidity:    weatherResponse.Current.Humidity,
-		Description: weatherResponse.Current.Condition.Text,
+		Temperature:   options.Units.FromCelsius(weatherResponse.Current.TempC),
+		Humidity:      weatherResponse.Current.Humidity,
+		Description:   weatherResponse.Current.Condition.Text,
+		WindSpeed:     options.Units.FromKph(weatherResponse.Current.WindKph),
+		WindDirection: weatherResponse.Current.WindDegree,
+		Pressure:      weatherResponse.Current.PressureMb,
+		CloudCover:    weatherResponse.Current.Cloud,
+		Visibility:    weatherResponse.Current.VisKm,
+		FeelsLike:     options.Units.FromCelsius(weatherResponse.Current.FeelsLikeC),
 	}
 
-	log.Infof("WeatherAPI data processed successfully for city: %s", city)
+	log.Infof("WeatherAPI data processed successfully for city: %s", location.Name)
+
+	return &result, nil
+}
+
+func (p *WeatherAPIProvider) GetForecastByCity(ctx context.Context, location models.Location, days int) (*models.Forecast, error) {
+
+	log := p.logger.WithContext(ctx)
+
+	log.Debugf("Requesting forecast data from WeatherAPI for city: %s", location.Name)
+
+	forecastResponse, err := p.client.GetForecast(ctx, location, days)
+	log.Debugf("Processing WeatherAPI forecast response for city: %s", location.Name)
+
+	if err != nil {
+		return nil, err
+	}
+
+	result := models.Forecast{
+		Days: make([]models.DailyForecast, 0, len(forecastResponse.Forecast.ForecastDay)),
+	}
+
+	for _, forecastDay := range forecastResponse.Forecast.ForecastDay {
+		result.Days = append(result.Days, models.DailyForecast{
+			Date:                forecastDay.Date,
+			MinTemperature:      forecastDay.Day.MinTempC,
+			MaxTemperature:      forecastDay.Day.MaxTempC,
+			PrecipitationChance: max(forecastDay.Day.DailyChanceOfRain, forecastDay.Day.DailyChanceOfSnow),
+			Condition:           forecastDay.Day.Condition.Text,
+		})
+	}
+
+	log.Infof("WeatherAPI forecast processed successfully for city: %s", location.Name)
+
+	return &result, nil
+}
+
+func (p *WeatherAPIProvider) GetAlertsByCity(ctx context.Context, location models.Location) (*models.Alerts, error) {
+
+	log := p.logger.WithContext(ctx)
+
+	log.Debugf("Requesting alerts from WeatherAPI for city: %s", location.Name)
+
+	alertsResponse, err := p.client.GetAlerts(ctx, location)
+	log.Debugf("Processing WeatherAPI alerts response for city: %s", location.Name)
+
+	if err != nil {
+		return nil, err
+	}
+
+	result := models.Alerts{
+		Items: make([]models.Alert, 0, len(alertsResponse.Alerts.Alert)),
+	}
+
+	for _, alert := range alertsResponse.Alerts.Alert {
+		start := parseAlertTime(alert.Effective)
+		result.Items = append(result.Items, models.Alert{
+			ID:          models.AlertID(alert.Event, start),
+			Event:       alert.Event,
+			Severity:    alert.Severity,
+			Headline:    alert.Headline,
+			Description: alert.Description,
+			Start:       start,
+			End:         parseAlertTime(alert.Expires),
+		})
+	}
+
+	log.Infof("WeatherAPI alerts processed successfully for city: %s", location.Name)
+
+	return &result, nil
+}
+
+// parseAlertTime leaves the time zero when WeatherAPI omits it or sends it in an unexpected format.
+func parseAlertTime(value string) time.Time {
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}
+	}
+
+	return parsed
+}
+
+func (p *WeatherAPIProvider) GetAirQualityByCity(ctx context.Context, location models.Location) (*models.AirQuality, error) {
+
+	log := p.logger.WithContext(ctx)
+
+	log.Debugf("Requesting air quality from WeatherAPI for city: %s", location.Name)
+
+	airQualityResponse, err := p.client.GetAirQuality(ctx, location)
+	log.Debugf("Processing WeatherAPI air quality response for city: %s", location.Name)
+
+	if err != nil {
+		return nil, err
+	}
+
+	air := airQualityResponse.Current.AirQuality
+	result := models.NewAirQuality(air.PM25, air.PM10, air.O3, air.NO2)
+
+	log.Infof("WeatherAPI air quality processed successfully for city: %s", location.Name)
 
 	return &result, nil
 }
@@ -0,0 +1,69 @@
+package roundtrip
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"time"
+	"weather-forecast/pkg/logger"
+)
+
+type (
+	// LoggingRoundTripper logs requests with secrets redacted. Response bodies
+	// are logged only for a sample of responses and truncated to maxBodyBytes.
+	LoggingRoundTripper struct {
+		transport    http.RoundTripper
+		bodySampler  logger.Sampler
+		maxBodyBytes int
+		logger       logger.Logger
+	}
+)
+
+func NewLogging(transport http.RoundTripper, bodySampler logger.Sampler, maxBodyBytes int, logger logger.Logger) *LoggingRoundTripper {
+	return &LoggingRoundTripper{
+		transport:    transport,
+		bodySampler:  bodySampler,
+		maxBodyBytes: maxBodyBytes,
+		logger:       logger,
+	}
+}
+
+func (rt *LoggingRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	log := rt.logger.WithContext(req.Context())
+	safeURL := RedactURL(req.URL)
+
+	start := time.Now()
+	resp, err := rt.transport.RoundTrip(req)
+	if err != nil {
+		log.Warnf("%s %s failed after %s: %v", req.Method, safeURL, time.Since(start), RedactError(err))
+		return nil, err
+	}
+
+	log.Debugf("%s %s - %d in %s", req.Method, safeURL, resp.StatusCode, time.Since(start))
+
+	if rt.maxBodyBytes <= 0 || !rt.bodySampler.ShouldLog() {
+		return resp, nil
+	}
+
+	bodyBytes, err := io.ReadAll(resp.Body)
+	if closeErr := resp.Body.Close(); closeErr != nil {
+		log.Warnf("Failed to close response body from %s: %v", req.URL.Host, closeErr)
+	}
+	if err != nil {
+		log.Warnf("Failed to read response body from %s: %v", req.URL.Host, err)
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(bodyBytes))
+
+	log.Debugf("%s - Response: %s", req.URL.Host, truncate(bodyBytes, rt.maxBodyBytes))
+
+	return resp, nil
+}
+
+func truncate(body []byte, limit int) string {
+	if len(body) <= limit {
+		return string(body)
+	}
+
+	return string(body[:limit]) + "...(truncated)"
+}
@@ -0,0 +1,59 @@
+package roundtrip
+
+import (
+	"errors"
+	"net/url"
+	"strings"
+)
+
+const redacted = "REDACTED"
+
+var secretParams = []string{"key", "appid", "api_key", "apikey", "token", "access_token"}
+
+// RedactURL hides API keys and tokens passed in the query string.
+func RedactURL(u *url.URL) string {
+	if u == nil {
+		return ""
+	}
+
+	query := u.Query()
+	changed := false
+	for name := range query {
+		if isSecretParam(name) {
+			query.Set(name, redacted)
+			changed = true
+		}
+	}
+	if !changed {
+		return u.String()
+	}
+
+	safe := *u
+	safe.RawQuery = query.Encode()
+	return safe.String()
+}
+
+// RedactError hides secrets of the request URL that http.Client puts in its errors.
+func RedactError(err error) error {
+	var urlErr *url.Error
+	if !errors.As(err, &urlErr) {
+		return err
+	}
+
+	parsed, parseErr := url.Parse(urlErr.URL)
+	if parseErr != nil {
+		return err
+	}
+
+	return &url.Error{Op: urlErr.Op, URL: RedactURL(parsed), Err: urlErr.Err}
+}
+
+func isSecretParam(name string) bool {
+	name = strings.ToLower(name)
+	for _, secret := range secretParams {
+		if name == secret {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,146 @@
+package roundtrip
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"os"
+	"time"
+	"weather-forecast/pkg/logger"
+)
+
+type (
+	RetrySettings struct {
+		MaxRetries int
+		// AttemptTimeout bounds every single attempt, zero leaves it to the caller.
+		AttemptTimeout time.Duration
+		BaseDelay      time.Duration
+		MaxDelay       time.Duration
+	}
+
+	// RetryRoundTripper repeats idempotent requests that timed out or got a 5xx
+	// response, waiting a random delay below an exponentially growing cap
+	// between attempts.
+	RetryRoundTripper struct {
+		transport http.RoundTripper
+		settings  RetrySettings
+		logger    logger.Logger
+	}
+
+	cancelOnCloseBody struct {
+		io.ReadCloser
+		cancel context.CancelFunc
+	}
+
+	// AttemptGuard is asked before every retry, an error stops retrying and
+	// the last response is returned.
+	AttemptGuard func(ctx context.Context) error
+
+	attemptGuardKey struct{}
+)
+
+// WithAttemptGuard sets the guard of retries of requests made with the
+// context, e.g. to count every attempt against a quota.
+func WithAttemptGuard(ctx context.Context, guard AttemptGuard) context.Context {
+	return context.WithValue(ctx, attemptGuardKey{}, guard)
+}
+
+func NewRetry(transport http.RoundTripper, settings RetrySettings, logger logger.Logger) *RetryRoundTripper {
+	return &RetryRoundTripper{
+		transport: transport,
+		settings:  settings,
+		logger:    logger,
+	}
+}
+
+func (rt *RetryRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	for attempt := 0; ; attempt++ {
+		resp, err := rt.attempt(req)
+
+		if attempt >= rt.settings.MaxRetries || !isIdempotent(req) || ctx.Err() != nil || !shouldRetry(resp, err) {
+			return resp, err
+		}
+
+		if guard, ok := ctx.Value(attemptGuardKey{}).(AttemptGuard); ok {
+			if guardErr := guard(ctx); guardErr != nil {
+				rt.logger.WithContext(ctx).Debugf("Not retrying %s %s: %v", req.Method, req.URL.Host, guardErr)
+				return resp, err
+			}
+		}
+
+		if resp != nil {
+			_, _ = io.Copy(io.Discard, resp.Body)
+			_ = resp.Body.Close()
+		}
+
+		delay := rt.backoff(attempt)
+		rt.logger.WithContext(ctx).Debugf("Retrying %s %s in %s, attempt %d of %d", req.Method, req.URL.Host, delay, attempt+1, rt.settings.MaxRetries)
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+func (rt *RetryRoundTripper) attempt(req *http.Request) (*http.Response, error) {
+	if rt.settings.AttemptTimeout <= 0 {
+		return rt.transport.RoundTrip(req)
+	}
+
+	ctx, cancel := context.WithTimeout(req.Context(), rt.settings.AttemptTimeout)
+	resp, err := rt.transport.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	resp.Body = &cancelOnCloseBody{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+func (rt *RetryRoundTripper) backoff(attempt int) time.Duration {
+	limit := rt.settings.BaseDelay << attempt
+	if limit <= 0 || limit > rt.settings.MaxDelay {
+		limit = rt.settings.MaxDelay
+	}
+	if limit <= 0 {
+		return 0
+	}
+
+	return rand.N(limit + 1)
+}
+
+func (b *cancelOnCloseBody) Close() error {
+	defer b.cancel()
+	return b.ReadCloser.Close()
+}
+
+func isIdempotent(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+	default:
+		return false
+	}
+}
+
+func shouldRetry(resp *http.Response, err error) bool {
+	if err != nil {
+		return errors.Is(err, context.DeadlineExceeded) || os.IsTimeout(err)
+	}
+
+	switch resp.StatusCode {
+	case http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,44 @@
+package roundtrip
+
+import (
+	"net/http"
+	"time"
+	"weather-forecast/pkg/logger"
+)
+
+type (
+	PoolSettings struct {
+		MaxIdleConnsPerHost int
+		MaxConnsPerHost     int
+		IdleConnTimeout     time.Duration
+	}
+
+	LogSettings struct {
+		MaxBodyBytes int
+		// BodySampleRate logs the body of every BodySampleRate-th response.
+		BodySampleRate int
+	}
+)
+
+// NewTransport returns the connection pool shared by the provider clients,
+// the limits apply to every provider host separately.
+func NewTransport(settings PoolSettings) *http.Transport {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.MaxIdleConnsPerHost = settings.MaxIdleConnsPerHost
+	transport.MaxConnsPerHost = settings.MaxConnsPerHost
+	transport.IdleConnTimeout = settings.IdleConnTimeout
+
+	return transport
+}
+
+// NewStack composes the transport of a provider client. Retries wrap the
+// logging so that every attempt is logged.
+func NewStack(transport http.RoundTripper, retry RetrySettings, logSettings LogSettings, log logger.Logger) http.RoundTripper {
+	var bodySampler logger.Sampler = &logger.NoSampler{}
+	if logSettings.BodySampleRate > 1 {
+		bodySampler = logger.NewRateSampler(logSettings.BodySampleRate)
+	}
+
+	logging := NewLogging(transport, bodySampler, logSettings.MaxBodyBytes, log)
+	return NewRetry(logging, retry, log)
+}
@@ -0,0 +1,45 @@
+package server
+
+import (
+	"context"
+	"crypto/subtle"
+	"strings"
+	"weather-forecast/pkg/proto/weather"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	AuthorizationHeader = "authorization"
+	BearerPrefix        = "Bearer "
+)
+
+var adminMethodPrefix = "/" + weather.WeatherAdminService_ServiceDesc.ServiceName + "/"
+
+// AdminAuthInterceptor lets calls to the admin service through only when they
+// carry the admin token as a bearer token, other services are not checked.
+func AdminAuthInterceptor(token string) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (resp interface{}, err error) {
+		if !strings.HasPrefix(info.FullMethod, adminMethodPrefix) {
+			return handler(ctx, req)
+		}
+
+		md, _ := metadata.FromIncomingContext(ctx)
+		for _, value := range md.Get(AuthorizationHeader) {
+			provided, ok := strings.CutPrefix(value, BearerPrefix)
+			if ok && token != "" && subtle.ConstantTimeCompare([]byte(provided), []byte(token)) == 1 {
+				return handler(ctx, req)
+			}
+		}
+
+		return nil, status.Error(codes.Unauthenticated, "admin token is missing or invalid")
+	}
+}
@@ -0,0 +1,126 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"time"
+	"weather-forecast/pkg/logger"
+	"weather-forecast/pkg/proto/weather"
+	domainerrors "weather-service/internal/domain/errors"
+	"weather-service/internal/domain/models"
+	infraerrors "weather-service/internal/infrastructure/errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type (
+	CacheAdminService interface {
+		ListCache(ctx context.Context, city string) ([]models.CacheEntry, error)
+		InvalidateCache(ctx context.Context, city string, all bool) (int, error)
+		RefreshCache(ctx context.Context, city string) ([]models.CacheEntry, error)
+	}
+
+	AdminHandler struct {
+		weather.UnimplementedWeatherAdminServiceServer
+		cacheAdminService CacheAdminService
+		logger            logger.Logger
+	}
+)
+
+func NewAdminHandler(cacheAdminService CacheAdminService, logger logger.Logger) *AdminHandler {
+	return &AdminHandler{
+		cacheAdminService: cacheAdminService,
+		logger:            logger,
+	}
+}
+
+func (h *AdminHandler) ListCache(ctx context.Context, req *weather.ListCacheRequest) (*weather.ListCacheResponse, error) {
+	log := h.logger.WithContext(ctx)
+
+	log.Infof("GRPC ListCache called: city=%s", req.City)
+	entries, err := h.cacheAdminService.ListCache(ctx, req.City)
+	if err != nil {
+		log.Warnf("ListCache error: %s", err.Error())
+		return nil, h.handleAdminError(err)
+	}
+
+	log.Infof("Cache listed successfully: entries=%d", len(entries))
+
+	return &weather.ListCacheResponse{Entries: mapCacheEntriesToProto(entries)}, nil
+}
+
+func (h *AdminHandler) InvalidateCache(ctx context.Context, req *weather.InvalidateCacheRequest) (*weather.InvalidateCacheResponse, error) {
+	log := h.logger.WithContext(ctx)
+
+	log.Infof("GRPC InvalidateCache called: city=%s, all=%t", req.City, req.All)
+	removed, err := h.cacheAdminService.InvalidateCache(ctx, req.City, req.All)
+	if err != nil {
+		log.Warnf("InvalidateCache error: %s", err.Error())
+		return nil, h.handleAdminError(err)
+	}
+
+	log.Infof("Cache invalidated successfully: removed=%d", removed)
+
+	return &weather.InvalidateCacheResponse{Removed: int32(removed)}, nil
+}
+
+func (h *AdminHandler) RefreshCache(ctx context.Context, req *weather.RefreshCacheRequest) (*weather.RefreshCacheResponse, error) {
+	log := h.logger.WithContext(ctx)
+
+	log.Infof("GRPC RefreshCache called: city=%s", req.City)
+	entries, err := h.cacheAdminService.RefreshCache(ctx, req.City)
+	if err != nil {
+		log.Warnf("RefreshCache error: %s", err.Error())
+		return nil, h.handleAdminError(err)
+	}
+
+	log.Infof("Cache refreshed successfully: city=%s, entries=%d", req.City, len(entries))
+
+	return &weather.RefreshCacheResponse{Entries: mapCacheEntriesToProto(entries)}, nil
+}
+
+func mapCacheEntriesToProto(entries []models.CacheEntry) []*weather.CacheEntry {
+	now := time.Now()
+
+	protoEntries := make([]*weather.CacheEntry, 0, len(entries))
+	for _, entry := range entries {
+		protoEntries = append(protoEntries, &weather.CacheEntry{
+			Key:        entry.Key,
+			Kind:       string(entry.Kind),
+			LocationId: entry.LocationID,
+			Source:     entry.Source,
+			CachedAt:   unixOrZero(entry.CachedAt),
+			AgeSeconds: int64(entry.Age(now).Seconds()),
+			Stale:      !entry.Fresh(now),
+		})
+	}
+
+	return protoEntries
+}
+
+func (h *AdminHandler) handleAdminError(err error) error {
+
+	switch {
+	case errors.Is(err, domainerrors.ErrInvalidCity):
+		return status.Error(codes.InvalidArgument, err.Error())
+
+	case errors.Is(err, domainerrors.ErrInvalidCacheScope):
+		return status.Error(codes.InvalidArgument, err.Error())
+
+	case errors.Is(err, infraerrors.ErrCityNotFound):
+		return status.Error(codes.NotFound, err.Error())
+
+	case errors.Is(err, infraerrors.ErrCache):
+		return status.Error(codes.Unavailable, err.Error())
+
+	case errors.Is(err, infraerrors.ErrProviderUnavailable):
+		return status.Error(codes.Unavailable, err.Error())
+
+	case errors.Is(err, infraerrors.ErrQuotaExhausted):
+		return status.Error(codes.Unavailable, err.Error())
+
+	default:
+		return status.Error(codes.Internal, "failed to administer cache")
+	}
+}
@@ -3,65 +3,467 @@ package handlers
 import (
 	"context"
 	"errors"
+	"fmt"
+	"time"
 	"weather-forecast/pkg/logger"
 	"weather-forecast/pkg/proto/weather"
+	domainerrors "weather-service/internal/domain/errors"
 	"weather-service/internal/domain/models"
 	infraerrors "weather-service/internal/infrastructure/errors"
 
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
 type (
 	WeatherService interface {
-		GetWeatherByCity(ctx context.Context, city string) (*models.Weather, error)
+		GetWeatherByCity(ctx context.Context, city string, options models.WeatherOptions) (*models.Weather, error)
+		GetWeatherByCoordinates(ctx context.Context, coordinates models.Coordinates, options models.WeatherOptions) (*models.Weather, error)
+		GetForecastByCity(ctx context.Context, city string, days int) (*models.Forecast, error)
+		ResolveCity(ctx context.Context, city string) (*models.Location, error)
+		GetWeatherBatch(ctx context.Context, cities []string) ([]models.CityWeather, error)
+		GetAlertsByCity(ctx context.Context, city string) (*models.Alerts, error)
+		GetAirQualityByCity(ctx context.Context, city string) (*models.AirQuality, error)
+		PrefetchWeather(ctx context.Context, cities []string, airQualityCities []string, freshUntil time.Time) (*models.Prefetch, error)
+	}
+
+	WeatherWatcher interface {
+		Watch(ctx context.Context, cities []string) (<-chan models.WeatherUpdate, error)
+	}
+
+	HistoryService interface {
+		GetHistory(ctx context.Context, city string, query models.HistoryQuery) (*models.History, error)
+	}
+
+	CitySearchService interface {
+		SearchCities(ctx context.Context, prefix string, limit int) ([]models.Location, error)
 	}
 
 	WeatherHandler struct {
 		weather.UnimplementedWeatherServiceServer
-		weatherService WeatherService
-		logger         logger.Logger
+		weatherService    WeatherService
+		weatherWatcher    WeatherWatcher
+		historyService    HistoryService
+		citySearchService CitySearchService
+		logger            logger.Logger
 	}
 )
 
-func NewWeatherHandler(weatherService WeatherService, logger logger.Logger) *WeatherHandler {
+func NewWeatherHandler(weatherService WeatherService, weatherWatcher WeatherWatcher, historyService HistoryService, citySearchService CitySearchService, logger logger.Logger) *WeatherHandler {
 	return &WeatherHandler{
-		weatherService: weatherService,
-		logger:         logger,
+		weatherService:    weatherService,
+		weatherWatcher:    weatherWatcher,
+		historyService:    historyService,
+		citySearchService: citySearchService,
+		logger:            logger,
 	}
 }
 
 func (h *WeatherHandler) GetWeather(ctx context.Context, req *weather.GetWeatherRequest) (*weather.GetWeatherResponse, error) {
 	log := h.logger.WithContext(ctx)
 
-	log.Infof("GRPC GetWeather called: city=%s", req.City)
-	weatherRes, err := h.weatherService.GetWeatherByCity(ctx, req.City)
+	options := models.WeatherOptions{
+		Units: models.Units(req.Units),
+		Lang:  req.Lang,
+	}
+
+	var weatherRes *models.Weather
+	var err error
+	location := req.GetCity()
+	if coordinates := req.GetCoordinates(); coordinates != nil {
+		location = fmt.Sprintf("%f,%f", coordinates.Latitude, coordinates.Longitude)
+		log.Infof("GRPC GetWeather called: coordinates=%s, units=%s, lang=%s", location, req.Units, req.Lang)
+		weatherRes, err = h.weatherService.GetWeatherByCoordinates(ctx, models.Coordinates{
+			Latitude:  coordinates.Latitude,
+			Longitude: coordinates.Longitude,
+		}, options)
+	} else {
+		log.Infof("GRPC GetWeather called: city=%s, units=%s, lang=%s", location, req.Units, req.Lang)
+		weatherRes, err = h.weatherService.GetWeatherByCity(ctx, location, options)
+	}
 	if err != nil {
 		log.Warnf("GetWeather error: %s", err.Error())
 		grpcErr := h.handleGetWeatherError(err)
 		return nil, grpcErr
 	}
 
-	protoWeather := &weather.GetWeatherResponse{
-		Temperature: weatherRes.Temperature,
-		Humidity:    int32(weatherRes.Humidity),
-		Description: weatherRes.Description,
+	log.Infof("Weather received successfully: location=%s", location)
+
+	return mapWeatherToProto(weatherRes), nil
+}
+
+func (h *WeatherHandler) GetWeatherBatch(ctx context.Context, req *weather.GetWeatherBatchRequest) (*weather.GetWeatherBatchResponse, error) {
+	log := h.logger.WithContext(ctx)
+
+	log.Infof("GRPC GetWeatherBatch called: cities=%d", len(req.Cities))
+	batch, err := h.weatherService.GetWeatherBatch(ctx, req.Cities)
+	if err != nil {
+		log.Warnf("GetWeatherBatch error: %s", err.Error())
+		grpcErr := h.handleGetWeatherError(err)
+		return nil, grpcErr
+	}
+
+	resp := &weather.GetWeatherBatchResponse{
+		Results: make([]*weather.CityWeatherResult, 0, len(batch)),
+	}
+
+	failed := 0
+	for _, cityWeather := range batch {
+		result := &weather.CityWeatherResult{City: cityWeather.City}
+
+		if cityWeather.Err != nil {
+			failed++
+			st := status.Convert(h.handleGetWeatherError(cityWeather.Err))
+			result.Result = &weather.CityWeatherResult_Error{
+				Error: &weather.CityWeatherError{
+					Code:    int32(st.Code()),
+					Message: st.Message(),
+				},
+			}
+		} else {
+			result.Result = &weather.CityWeatherResult_Weather{
+				Weather: mapWeatherToProto(cityWeather.Weather),
+			}
+		}
+
+		resp.Results = append(resp.Results, result)
+	}
+
+	log.Infof("Weather batch processed: cities=%d, failed=%d", len(batch), failed)
+
+	return resp, nil
+}
+
+func (h *WeatherHandler) PrefetchWeather(ctx context.Context, req *weather.PrefetchWeatherRequest) (*weather.PrefetchWeatherResponse, error) {
+	log := h.logger.WithContext(ctx)
+
+	log.Infof("GRPC PrefetchWeather called: cities=%d, air_quality_cities=%d, fresh_until=%d", len(req.Cities), len(req.AirQualityCities), req.FreshUntil)
+	prefetch, err := h.weatherService.PrefetchWeather(ctx, req.Cities, req.AirQualityCities, timeOrZero(req.FreshUntil))
+	if err != nil {
+		log.Warnf("PrefetchWeather error: %s", err.Error())
+		grpcErr := h.handleGetWeatherError(err)
+		return nil, grpcErr
+	}
+
+	log.Infof("Weather prefetched: warmed=%d, failed=%d", prefetch.Warmed, len(prefetch.Failed))
+
+	return &weather.PrefetchWeatherResponse{
+		Warmed: int32(prefetch.Warmed),
+		Failed: prefetch.Failed,
+	}, nil
+}
+
+func (h *WeatherHandler) WatchWeather(req *weather.WatchWeatherRequest, stream grpc.ServerStreamingServer[weather.WeatherUpdate]) error {
+	ctx := stream.Context()
+	log := h.logger.WithContext(ctx)
+
+	log.Infof("GRPC WatchWeather called: cities=%d", len(req.Cities))
+	updates, err := h.weatherWatcher.Watch(ctx, req.Cities)
+	if err != nil {
+		log.Warnf("WatchWeather error: %s", err.Error())
+		grpcErr := h.handleGetWeatherError(err)
+		return grpcErr
+	}
+
+	for update := range updates {
+		protoUpdate := &weather.WeatherUpdate{
+			City:       update.Location.Name,
+			LocationId: update.Location.ID,
+			Weather:    mapWeatherToProto(&update.Weather),
+		}
+
+		if err := stream.Send(protoUpdate); err != nil {
+			log.Warnf("Failed to send weather update for %s: %v", update.Location.ID, err)
+			return err
+		}
+	}
+
+	log.Infof("WatchWeather stream closed")
+
+	return nil
+}
+
+func mapWeatherToProto(weatherRes *models.Weather) *weather.GetWeatherResponse {
+	return &weather.GetWeatherResponse{
+		Temperature:   weatherRes.Temperature,
+		Humidity:      int32(weatherRes.Humidity),
+		Description:   weatherRes.Description,
+		WindSpeed:     weatherRes.WindSpeed,
+		WindDirection: int32(weatherRes.WindDirection),
+		Pressure:      weatherRes.Pressure,
+		CloudCover:    int32(weatherRes.CloudCover),
+		Visibility:    weatherRes.Visibility,
+		FeelsLike:     weatherRes.FeelsLike,
+		HeatIndex:     weatherRes.HeatIndex,
+		WindChill:     weatherRes.WindChill,
+		DewPoint:      weatherRes.DewPoint,
+		Stale:         weatherRes.Stale,
+		Disputed:      weatherRes.Disputed,
+	}
+}
+
+func (h *WeatherHandler) GetForecast(ctx context.Context, req *weather.GetForecastRequest) (*weather.GetForecastResponse, error) {
+	log := h.logger.WithContext(ctx)
+
+	log.Infof("GRPC GetForecast called: city=%s, days=%d", req.City, req.Days)
+	forecast, err := h.weatherService.GetForecastByCity(ctx, req.City, int(req.Days))
+	if err != nil {
+		log.Warnf("GetForecast error: %s", err.Error())
+		grpcErr := h.handleGetWeatherError(err)
+		return nil, grpcErr
+	}
+
+	protoForecast := &weather.GetForecastResponse{
+		Days:  make([]*weather.DailyForecast, 0, len(forecast.Days)),
+		Stale: forecast.Stale,
+	}
+
+	for _, day := range forecast.Days {
+		protoForecast.Days = append(protoForecast.Days, &weather.DailyForecast{
+			Date:                day.Date,
+			MinTemperature:      day.MinTemperature,
+			MaxTemperature:      day.MaxTemperature,
+			PrecipitationChance: int32(day.PrecipitationChance),
+			Condition:           day.Condition,
+		})
+	}
+
+	log.Infof("Forecast received successfully: city=%s", req.City)
+
+	return protoForecast, nil
+}
+
+func (h *WeatherHandler) GetAlerts(ctx context.Context, req *weather.GetAlertsRequest) (*weather.GetAlertsResponse, error) {
+	log := h.logger.WithContext(ctx)
+
+	log.Infof("GRPC GetAlerts called: city=%s", req.City)
+	alerts, err := h.weatherService.GetAlertsByCity(ctx, req.City)
+	if err != nil {
+		log.Warnf("GetAlerts error: %s", err.Error())
+		grpcErr := h.handleGetWeatherError(err)
+		return nil, grpcErr
+	}
+
+	protoAlerts := &weather.GetAlertsResponse{
+		Alerts: make([]*weather.Alert, 0, len(alerts.Items)),
+		Stale:  alerts.Stale,
+	}
+
+	for _, alert := range alerts.Items {
+		protoAlerts.Alerts = append(protoAlerts.Alerts, &weather.Alert{
+			Id:          alert.ID,
+			Event:       alert.Event,
+			Severity:    alert.Severity,
+			Headline:    alert.Headline,
+			Description: alert.Description,
+			StartsAt:    unixOrZero(alert.Start),
+			EndsAt:      unixOrZero(alert.End),
+		})
+	}
+
+	log.Infof("Alerts received successfully: city=%s, alerts=%d", req.City, len(alerts.Items))
+
+	return protoAlerts, nil
+}
+
+func (h *WeatherHandler) GetAirQuality(ctx context.Context, req *weather.GetAirQualityRequest) (*weather.GetAirQualityResponse, error) {
+	log := h.logger.WithContext(ctx)
+
+	log.Infof("GRPC GetAirQuality called: city=%s", req.City)
+	airQuality, err := h.weatherService.GetAirQualityByCity(ctx, req.City)
+	if err != nil {
+		log.Warnf("GetAirQuality error: %s", err.Error())
+		grpcErr := h.handleGetWeatherError(err)
+		return nil, grpcErr
+	}
+
+	log.Infof("Air quality received successfully: city=%s", req.City)
+
+	return &weather.GetAirQualityResponse{
+		Aqi:      int32(airQuality.AQI),
+		Category: airQuality.Category,
+		Pm2_5:    airQuality.PM25,
+		Pm10:     airQuality.PM10,
+		O3:       airQuality.O3,
+		No2:      airQuality.NO2,
+		Stale:    airQuality.Stale,
+	}, nil
+}
+
+func (h *WeatherHandler) GetHistory(ctx context.Context, req *weather.GetHistoryRequest) (*weather.GetHistoryResponse, error) {
+	log := h.logger.WithContext(ctx)
+
+	log.Infof("GRPC GetHistory called: city=%s, from=%d, to=%d, aggregation=%s", req.City, req.From, req.To, req.Aggregation)
+	query := models.HistoryQuery{
+		From:        timeOrZero(req.From),
+		To:          timeOrZero(req.To),
+		Aggregation: models.Aggregation(req.Aggregation),
+		Units:       models.Units(req.Units),
+	}
+	history, err := h.historyService.GetHistory(ctx, req.City, query)
+	if err != nil {
+		log.Warnf("GetHistory error: %s", err.Error())
+		grpcErr := h.handleGetWeatherError(err)
+		return nil, grpcErr
 	}
 
-	log.Infof("Weather received successfully: city=%s", req.City)
+	protoHistory := &weather.GetHistoryResponse{
+		LocationId: history.Location.ID,
+		Points:     make([]*weather.HistoryPoint, 0, len(history.Points)),
+	}
+
+	for _, point := range history.Points {
+		protoHistory.Points = append(protoHistory.Points, &weather.HistoryPoint{
+			Timestamp:      point.Time.Unix(),
+			Provider:       point.Provider,
+			Temperature:    point.Temperature,
+			MinTemperature: point.MinTemperature,
+			MaxTemperature: point.MaxTemperature,
+			Humidity:       int32(point.Humidity),
+			Description:    point.Description,
+			WindSpeed:      point.WindSpeed,
+			Pressure:       point.Pressure,
+			Samples:        int32(point.Samples),
+		})
+	}
+
+	log.Infof("History received successfully: city=%s, points=%d", req.City, len(history.Points))
+
+	return protoHistory, nil
+}
+
+// timeOrZero reads an unset Unix time as the zero time.
+func timeOrZero(unix int64) time.Time {
+	if unix == 0 {
+		return time.Time{}
+	}
 
-	return protoWeather, nil
+	return time.Unix(unix, 0)
+}
+
+// unixOrZero keeps an unknown time as zero instead of the Unix time of year 1.
+func unixOrZero(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+
+	return t.Unix()
+}
+
+func (h *WeatherHandler) ResolveCity(ctx context.Context, req *weather.ResolveCityRequest) (*weather.ResolveCityResponse, error) {
+	log := h.logger.WithContext(ctx)
+
+	log.Infof("GRPC ResolveCity called: city=%s", req.City)
+	location, err := h.weatherService.ResolveCity(ctx, req.City)
+	if err != nil {
+		log.Warnf("ResolveCity error: %s", err.Error())
+		grpcErr := h.handleGetWeatherError(err)
+		return nil, grpcErr
+	}
+
+	log.Infof("City resolved successfully: city=%s, id=%s", req.City, location.ID)
+
+	return &weather.ResolveCityResponse{Location: mapLocationToProto(location)}, nil
+}
+
+func (h *WeatherHandler) SearchCities(ctx context.Context, req *weather.SearchCitiesRequest) (*weather.SearchCitiesResponse, error) {
+	log := h.logger.WithContext(ctx)
+
+	log.Infof("GRPC SearchCities called: prefix=%s, limit=%d", req.Prefix, req.Limit)
+	cities, err := h.citySearchService.SearchCities(ctx, req.Prefix, int(req.Limit))
+	if err != nil {
+		log.Warnf("SearchCities error: %s", err.Error())
+		grpcErr := h.handleGetWeatherError(err)
+		return nil, grpcErr
+	}
+
+	resp := &weather.SearchCitiesResponse{
+		Locations: make([]*weather.Location, 0, len(cities)),
+	}
+	for i := range cities {
+		resp.Locations = append(resp.Locations, mapLocationToProto(&cities[i]))
+	}
+
+	log.Infof("Cities found successfully: prefix=%s, cities=%d", req.Prefix, len(cities))
+
+	return resp, nil
+}
+
+func mapLocationToProto(location *models.Location) *weather.Location {
+	protoLocation := &weather.Location{
+		Id:      location.ID,
+		Name:    location.Name,
+		Country: location.Country,
+	}
+
+	if location.Coordinates != nil {
+		protoLocation.Coordinates = &weather.Coordinates{
+			Latitude:  location.Coordinates.Latitude,
+			Longitude: location.Coordinates.Longitude,
+		}
+	}
+
+	return protoLocation
 }
 
 func (h *WeatherHandler) handleGetWeatherError(err error) error {
 
 	switch {
+	case errors.Is(err, domainerrors.ErrInvalidForecastDays):
+		return status.Error(codes.InvalidArgument, err.Error())
+
+	case errors.Is(err, domainerrors.ErrInvalidCity):
+		return status.Error(codes.InvalidArgument, err.Error())
+
+	case errors.Is(err, domainerrors.ErrInvalidCoordinates):
+		return status.Error(codes.InvalidArgument, err.Error())
+
+	case errors.Is(err, domainerrors.ErrEmptyBatch):
+		return status.Error(codes.InvalidArgument, err.Error())
+
+	case errors.Is(err, domainerrors.ErrBatchTooLarge):
+		return status.Error(codes.InvalidArgument, err.Error())
+
+	case errors.Is(err, domainerrors.ErrTooManyWatched):
+		return status.Error(codes.InvalidArgument, err.Error())
+
+	case errors.Is(err, domainerrors.ErrInvalidUnits):
+		return status.Error(codes.InvalidArgument, err.Error())
+
+	case errors.Is(err, domainerrors.ErrInvalidLang):
+		return status.Error(codes.InvalidArgument, err.Error())
+
+	case errors.Is(err, domainerrors.ErrInvalidHistoryRange):
+		return status.Error(codes.InvalidArgument, err.Error())
+
+	case errors.Is(err, domainerrors.ErrInvalidAggregation):
+		return status.Error(codes.InvalidArgument, err.Error())
+
+	case errors.Is(err, domainerrors.ErrInvalidSearchPrefix):
+		return status.Error(codes.InvalidArgument, err.Error())
+
+	case errors.Is(err, domainerrors.ErrInvalidSearchLimit):
+		return status.Error(codes.InvalidArgument, err.Error())
+
 	case errors.Is(err, infraerrors.ErrCityNotFound):
 		return status.Error(codes.NotFound, err.Error())
 
 	case errors.Is(err, infraerrors.ErrGetWeather):
 		return status.Error(codes.Internal, err.Error())
 
+	case errors.Is(err, infraerrors.ErrProviderUnavailable):
+		return status.Error(codes.Unavailable, err.Error())
+
+	case errors.Is(err, infraerrors.ErrQuotaExhausted):
+		return status.Error(codes.Unavailable, err.Error())
+
+	case errors.Is(err, infraerrors.ErrAlertsUnsupported):
+		return status.Error(codes.Unimplemented, err.Error())
+
+	case errors.Is(err, infraerrors.ErrAirQualityUnsupported):
+		return status.Error(codes.Unimplemented, err.Error())
+
 	case errors.Is(err, infraerrors.ErrInternal):
 		return status.Error(codes.Internal, err.Error())
 
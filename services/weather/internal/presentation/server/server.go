@@ -18,14 +18,26 @@ type (
 	}
 )
 
-func New(weatherHandler weather.WeatherServiceServer, logger logger.Logger) *Server {
+// New registers the admin service only when adminToken is set, without it the
+// admin methods answer Unimplemented.
+func New(weatherHandler weather.WeatherServiceServer, adminHandler weather.WeatherAdminServiceServer, adminToken string, logger logger.Logger) *Server {
 	grpcServer := grpc.NewServer(
-		grpc.UnaryInterceptor(grpcpkg.CorrelationIDServerInterceptor(logger)),
+		grpc.ChainUnaryInterceptor(
+			grpcpkg.CorrelationIDServerInterceptor(logger),
+			AdminAuthInterceptor(adminToken),
+		),
+		grpc.StreamInterceptor(grpcpkg.CorrelationIDStreamServerInterceptor(logger)),
 	)
 	reflection.Register(grpcServer)
 
 	weather.RegisterWeatherServiceServer(grpcServer, weatherHandler)
 
+	if adminToken != "" {
+		weather.RegisterWeatherAdminServiceServer(grpcServer, adminHandler)
+	} else {
+		logger.Warnf("ADMIN_TOKEN is not set, cache administration is disabled")
+	}
+
 	return &Server{
 		grpcServer: grpcServer,
 		logger:     logger,
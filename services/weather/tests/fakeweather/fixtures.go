@@ -0,0 +1,68 @@
+package fakeweather
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode"
+)
+
+const defaultFixture = "_default"
+
+var ErrFixtureNotFound = errors.New("fixture not found")
+
+// Fixtures stores provider responses as <dir>/<provider>/<endpoint>/<location>.json.
+// A _default.json file in the endpoint directory is served for any location
+// that has no fixture of its own.
+type Fixtures struct {
+	dir string
+}
+
+func NewFixtures(dir string) *Fixtures {
+	return &Fixtures{dir: dir}
+}
+
+func (f *Fixtures) Load(provider, endpoint, location string) ([]byte, error) {
+	for _, name := range []string{fixtureName(location), defaultFixture} {
+		body, err := os.ReadFile(f.path(provider, endpoint, name))
+		if err == nil {
+			return body, nil
+		}
+		if !errors.Is(err, os.ErrNotExist) {
+			return nil, err
+		}
+	}
+
+	return nil, ErrFixtureNotFound
+}
+
+func (f *Fixtures) Save(provider, endpoint, location string, body []byte) error {
+	path := f.path(provider, endpoint, fixtureName(location))
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	var indented bytes.Buffer
+	if err := json.Indent(&indented, body, "", "  "); err != nil {
+		return err
+	}
+	indented.WriteByte('\n')
+
+	return os.WriteFile(path, indented.Bytes(), 0o644)
+}
+
+func (f *Fixtures) path(provider, endpoint, name string) string {
+	return filepath.Join(f.dir, provider, endpoint, name+".json")
+}
+
+func fixtureName(location string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) || r == '.' || r == ',' || r == '-' {
+			return r
+		}
+		return '_'
+	}, strings.ToLower(strings.TrimSpace(location)))
+}
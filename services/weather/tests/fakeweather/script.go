@@ -0,0 +1,82 @@
+package fakeweather
+
+import (
+	"strings"
+	"sync"
+)
+
+const (
+	FailureNotFound    = "not_found"
+	FailureServerError = "server_error"
+	FailureSlow        = "slow"
+)
+
+type (
+	// Failure scripts a misbehaving response. Empty Provider, Endpoint and
+	// Location match any request, Times limits how many requests are affected
+	// (0 - every request). A slow failure delays the normal response by DelayMs.
+	Failure struct {
+		Provider string `json:"provider"`
+		Endpoint string `json:"endpoint"`
+		Location string `json:"location"`
+		Kind     string `json:"kind"`
+		DelayMs  int    `json:"delay_ms"`
+		Times    int    `json:"times"`
+	}
+
+	scriptedFailure struct {
+		failure   Failure
+		remaining int
+	}
+
+	Script struct {
+		failures []*scriptedFailure
+		mu       *sync.Mutex
+	}
+)
+
+func NewScript() *Script {
+	return &Script{mu: &sync.Mutex{}}
+}
+
+func (s *Script) Add(failures ...Failure) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, failure := range failures {
+		s.failures = append(s.failures, &scriptedFailure{failure: failure, remaining: failure.Times})
+	}
+}
+
+func (s *Script) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failures = nil
+}
+
+func (s *Script) match(provider, endpoint, location string) (Failure, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i, scripted := range s.failures {
+		failure := scripted.failure
+		if !matches(failure.Provider, provider) || !matches(failure.Endpoint, endpoint) || !matches(failure.Location, location) {
+			continue
+		}
+
+		if failure.Times > 0 {
+			scripted.remaining--
+			if scripted.remaining == 0 {
+				s.failures = append(s.failures[:i], s.failures[i+1:]...)
+			}
+		}
+
+		return failure, true
+	}
+
+	return Failure{}, false
+}
+
+func matches(pattern, value string) bool {
+	return pattern == "" || strings.EqualFold(strings.TrimSpace(pattern), strings.TrimSpace(value))
+}
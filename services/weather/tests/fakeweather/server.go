@@ -0,0 +1,266 @@
+package fakeweather
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+	"weather-forecast/pkg/logger"
+	"weather-service/internal/config"
+)
+
+const (
+	WeatherAPI  = "weatherapi"
+	OpenWeather = "openweather"
+
+	WeatherAPIUpstream  = "https://api.weatherapi.com"
+	OpenWeatherUpstream = "https://api.openweathermap.org"
+)
+
+type (
+	endpoint struct {
+		provider string
+		name     string
+		path     string
+	}
+
+	// Upstream is the real provider the fake forwards to while recording.
+	Upstream struct {
+		BaseURL string
+		Key     string
+	}
+
+	// Server imitates the WeatherAPI and OpenWeather HTTP contracts under the
+	// /weatherapi and /openweather path prefixes.
+	Server struct {
+		fixtures  *Fixtures
+		script    *Script
+		upstreams map[string]Upstream
+		calls     map[string]int
+		client    *http.Client
+		mux       *http.ServeMux
+		mu        *sync.Mutex
+		logger    logger.Logger
+	}
+)
+
+var endpoints = []endpoint{
+	{provider: WeatherAPI, name: "current", path: "/v1/current.json"},
+	{provider: WeatherAPI, name: "forecast", path: "/v1/forecast.json"},
+	{provider: WeatherAPI, name: "alerts", path: "/v1/alerts.json"},
+	{provider: OpenWeather, name: "weather", path: "/data/2.5/weather"},
+	{provider: OpenWeather, name: "forecast", path: "/data/2.5/forecast"},
+	{provider: OpenWeather, name: "air_pollution", path: "/data/2.5/air_pollution"},
+	{provider: OpenWeather, name: "onecall", path: "/data/3.0/onecall"},
+}
+
+func NewServer(fixtures *Fixtures, script *Script, logger logger.Logger) *Server {
+	server := &Server{
+		fixtures:  fixtures,
+		script:    script,
+		upstreams: make(map[string]Upstream),
+		calls:     make(map[string]int),
+		client:    &http.Client{Timeout: 10 * time.Second},
+		mux:       http.NewServeMux(),
+		mu:        &sync.Mutex{},
+		logger:    logger,
+	}
+
+	for _, e := range endpoints {
+		server.mux.HandleFunc("GET /"+e.provider+e.path, server.handle(e))
+	}
+	server.mux.HandleFunc("PUT /_fake/failures", server.replaceFailures)
+	server.mux.HandleFunc("DELETE /_fake/failures", server.resetFailures)
+
+	return server
+}
+
+// Configure points every WeatherAPI and OpenWeather URL of cfg at the fake
+// served from baseURL.
+func Configure(cfg *config.Config, baseURL string) {
+	cfg.WeatherAPIURL = baseURL + "/" + WeatherAPI + "/v1/current.json"
+	cfg.WeatherAPIForecastURL = baseURL + "/" + WeatherAPI + "/v1/forecast.json"
+	cfg.WeatherAPIAlertsURL = baseURL + "/" + WeatherAPI + "/v1/alerts.json"
+	cfg.OpenWeatherURL = baseURL + "/" + OpenWeather + "/data/2.5/weather"
+	cfg.OpenWeatherForecastURL = baseURL + "/" + OpenWeather + "/data/2.5/forecast"
+	cfg.OpenWeatherAirQualityURL = baseURL + "/" + OpenWeather + "/data/2.5/air_pollution"
+	cfg.OpenWeatherAlertsURL = baseURL + "/" + OpenWeather + "/data/3.0/onecall"
+}
+
+// Record forwards requests for the provider to upstream and saves every
+// successful response as a fixture for its location.
+func (s *Server) Record(provider string, upstream Upstream) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.upstreams[provider] = upstream
+}
+
+func (s *Server) Calls(provider, endpoint string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.calls[provider+"/"+endpoint]
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mux.ServeHTTP(w, r)
+}
+
+func (s *Server) handle(e endpoint) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		location := requestLocation(e.provider, query)
+		name := e.name
+		if e.provider == WeatherAPI && query.Get("aqi") == "yes" {
+			name += "_aqi"
+		}
+
+		s.mu.Lock()
+		s.calls[e.provider+"/"+e.name]++
+		upstream, recording := s.upstreams[e.provider]
+		s.mu.Unlock()
+
+		if failure, ok := s.script.match(e.provider, e.name, location); ok {
+			s.logger.Infof("Scripted %s failure for %s/%s: %s", failure.Kind, e.provider, e.name, location)
+			switch failure.Kind {
+			case FailureNotFound:
+				writeNotFound(w, e.provider)
+				return
+			case FailureServerError:
+				writeServerError(w, e.provider)
+				return
+			case FailureSlow:
+				select {
+				case <-time.After(time.Duration(failure.DelayMs) * time.Millisecond):
+				case <-r.Context().Done():
+					return
+				}
+			}
+		}
+
+		if recording {
+			s.record(w, r, e, name, location, upstream)
+			return
+		}
+
+		body, err := s.fixtures.Load(e.provider, name, location)
+		if err != nil {
+			if !errors.Is(err, ErrFixtureNotFound) {
+				s.logger.Warnf("Load fixture %s/%s/%s: %s", e.provider, name, location, err.Error())
+				writeServerError(w, e.provider)
+				return
+			}
+			writeNotFound(w, e.provider)
+			return
+		}
+
+		writeJSON(w, http.StatusOK, body)
+	}
+}
+
+func (s *Server) record(w http.ResponseWriter, r *http.Request, e endpoint, name, location string, upstream Upstream) {
+	upstreamURL, err := url.Parse(upstream.BaseURL + e.path)
+	if err != nil {
+		s.logger.Warnf("Parse upstream url: %s", err.Error())
+		writeServerError(w, e.provider)
+		return
+	}
+	query := r.URL.Query()
+	query.Set(keyParam(e.provider), upstream.Key)
+	upstreamURL.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(r.Context(), http.MethodGet, upstreamURL.String(), nil)
+	if err != nil {
+		s.logger.Warnf("Create upstream request: %s", err.Error())
+		writeServerError(w, e.provider)
+		return
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		s.logger.Warnf("Call upstream %s: %s", e.provider, err.Error())
+		writeServerError(w, e.provider)
+		return
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			s.logger.Warnf("Failed to close response body: %s", err.Error())
+		}
+	}()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		s.logger.Warnf("Read upstream response: %s", err.Error())
+		writeServerError(w, e.provider)
+		return
+	}
+
+	if resp.StatusCode == http.StatusOK {
+		if err := s.fixtures.Save(e.provider, name, location, body); err != nil {
+			s.logger.Warnf("Save fixture %s/%s/%s: %s", e.provider, name, location, err.Error())
+		} else {
+			s.logger.Infof("Recorded fixture %s/%s/%s", e.provider, name, location)
+		}
+	}
+
+	writeJSON(w, resp.StatusCode, body)
+}
+
+func (s *Server) replaceFailures(w http.ResponseWriter, r *http.Request) {
+	var failures []Failure
+	if err := json.NewDecoder(r.Body).Decode(&failures); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	s.script.Reset()
+	s.script.Add(failures...)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) resetFailures(w http.ResponseWriter, r *http.Request) {
+	s.script.Reset()
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func requestLocation(provider string, query url.Values) string {
+	if provider == OpenWeather && query.Get("lat") != "" && query.Get("lon") != "" {
+		return query.Get("lat") + "," + query.Get("lon")
+	}
+
+	return query.Get("q")
+}
+
+func keyParam(provider string) string {
+	if provider == OpenWeather {
+		return "appid"
+	}
+
+	return "key"
+}
+
+func writeNotFound(w http.ResponseWriter, provider string) {
+	if provider == OpenWeather {
+		writeJSON(w, http.StatusNotFound, []byte(`{"cod":"404","message":"city not found"}`))
+		return
+	}
+
+	writeJSON(w, http.StatusBadRequest, []byte(`{"error":{"code":1006,"message":"No matching location found."}}`))
+}
+
+func writeServerError(w http.ResponseWriter, provider string) {
+	if provider == OpenWeather {
+		writeJSON(w, http.StatusInternalServerError, []byte(`{"cod":"500","message":"Internal error"}`))
+		return
+	}
+
+	writeJSON(w, http.StatusInternalServerError, []byte(`{"error":{"code":9999,"message":"Internal application error."}}`))
+}
+
+func writeJSON(w http.ResponseWriter, status int, body []byte) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_, _ = w.Write(body)
+}
@@ -0,0 +1,174 @@
+package integration
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+	"weather-forecast/pkg/proto/weather"
+	"weather-service/internal/infrastructure/clients/openmeteo"
+	"weather-service/internal/infrastructure/clients/openweather"
+	"weather-service/internal/infrastructure/clients/weatherapi"
+	"weather-service/tests/integration/testutils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func testWeatherAPIAirQualityResponse(pm25, pm10 float64) weatherapi.WeatherAirQualitySuccessResponse {
+	return weatherapi.WeatherAirQualitySuccessResponse{
+		Current: weatherapi.WeatherAirQualityCurrentResponse{
+			AirQuality: weatherapi.WeatherAirQualityResponse{
+				PM25: pm25,
+				PM10: pm10,
+				O3:   60.1,
+				NO2:  12.34,
+			},
+		},
+	}
+}
+
+func weatherAPIAirQualityQuery(t *testing.T, city string) url.Values {
+	t.Helper()
+
+	query := weatherAPIQuery(t, city)
+	query.Set("aqi", "yes")
+
+	return query
+}
+
+func TestGetAirQuality_WeatherAPI(t *testing.T) {
+	city := "Kyiv"
+
+	weatherAPIServerMock := newMockServer(t, testWeatherAPIAirQualityResponse(20, 30), http.StatusOK, weatherAPIAirQualityQuery(t, city).Encode(), true)
+
+	cfg := newChainConfig(weatherAPIServerMock.URL, "http://localhost", "weatherapi")
+	weatherHandler := setupWeatherHandlerFromConfig(t, cfg, testutils.NewInMemoryCache(), testutils.NewInMemoryMetrics())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := weatherHandler.GetAirQuality(ctx, &weather.GetAirQualityRequest{City: city})
+	require.NoError(t, err)
+
+	assert.Equal(t, int32(71), resp.Aqi)
+	assert.Equal(t, "Moderate", resp.Category)
+	assert.Equal(t, float64(20), resp.Pm2_5)
+	assert.Equal(t, float64(30), resp.Pm10)
+	assert.Equal(t, 60.1, resp.O3)
+	assert.Equal(t, 12.3, resp.No2)
+	assert.False(t, resp.Stale)
+}
+
+func TestGetAirQuality_Index(t *testing.T) {
+	testCases := []struct {
+		name             string
+		pm25             float64
+		pm10             float64
+		expectedAQI      int32
+		expectedCategory string
+	}{
+		{name: "Good", pm25: 5, pm10: 10, expectedAQI: 28, expectedCategory: "Good"},
+		{name: "PM10 Dominant", pm25: 5, pm10: 200, expectedAQI: 123, expectedCategory: "Unhealthy for Sensitive Groups"},
+		{name: "Unhealthy", pm25: 100, pm10: 10, expectedAQI: 182, expectedCategory: "Unhealthy"},
+		{name: "Beyond Scale", pm25: 600, pm10: 10, expectedAQI: 500, expectedCategory: "Hazardous"},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			weatherAPIServerMock := newMockServer(t, testWeatherAPIAirQualityResponse(testCase.pm25, testCase.pm10), http.StatusOK, "", true)
+
+			cfg := newChainConfig(weatherAPIServerMock.URL, "http://localhost", "weatherapi")
+			weatherHandler := setupWeatherHandlerFromConfig(t, cfg, testutils.NewInMemoryCache(), testutils.NewInMemoryMetrics())
+
+			resp, err := weatherHandler.GetAirQuality(context.Background(), &weather.GetAirQualityRequest{City: "Kyiv"})
+			require.NoError(t, err)
+
+			assert.Equal(t, testCase.expectedAQI, resp.Aqi)
+			assert.Equal(t, testCase.expectedCategory, resp.Category)
+		})
+	}
+}
+
+func TestGetAirQuality_FallbackToOpenWeather(t *testing.T) {
+	city := "Kyiv"
+
+	weatherAPIServerMock := newMockServer(t, weatherAPIInternalErrorResponse, http.StatusBadRequest, "", true)
+
+	query := openWeatherQuery(t, city)
+	query.Del("units")
+	openWeatherServerMock := newMockServer(t, openweather.OpenWeatherAirQualityResponse{
+		List: []openweather.OpenWeatherAirQualityItemResponse{
+			{Components: openweather.OpenWeatherAirComponentsResponse{PM25: 20, PM10: 30, O3: 60.1, NO2: 12.34}},
+		},
+	}, http.StatusOK, query.Encode(), true)
+
+	cfg := newChainConfig(weatherAPIServerMock.URL, openWeatherServerMock.URL, "weatherapi", "openweather")
+	weatherHandler := setupWeatherHandlerFromConfig(t, cfg, testutils.NewInMemoryCache(), testutils.NewInMemoryMetrics())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := weatherHandler.GetAirQuality(ctx, &weather.GetAirQualityRequest{City: city})
+	require.NoError(t, err)
+
+	assert.Equal(t, int32(71), resp.Aqi)
+	assert.Equal(t, 12.3, resp.No2)
+}
+
+func TestGetAirQuality_OpenMeteo(t *testing.T) {
+	city := "Kyiv"
+	location := resolveTestCity(t, city)
+
+	query := url.Values{}
+	query.Set("current", "pm2_5,pm10,ozone,nitrogen_dioxide")
+	query.Set("latitude", formatCoordinate(location.Coordinates.Latitude))
+	query.Set("longitude", formatCoordinate(location.Coordinates.Longitude))
+	openMeteoServerMock := newMockServer(t, openmeteo.OpenMeteoAirQualityResponse{
+		Current: openmeteo.OpenMeteoAirQualityCurrentResponse{PM25: 20, PM10: 30, O3: 60.1, NO2: 12.34},
+	}, http.StatusOK, query.Encode(), true)
+
+	cfg := newOpenMeteoChainConfig("http://localhost", "openmeteo")
+	cfg.OpenMeteoAirQualityURL = openMeteoServerMock.URL
+	weatherHandler := setupWeatherHandlerFromConfig(t, cfg, testutils.NewInMemoryCache(), testutils.NewInMemoryMetrics())
+
+	resp, err := weatherHandler.GetAirQuality(context.Background(), &weather.GetAirQualityRequest{City: city})
+	require.NoError(t, err)
+
+	assert.Equal(t, int32(71), resp.Aqi)
+	assert.Equal(t, 60.1, resp.O3)
+}
+
+func TestGetAirQuality_Cached(t *testing.T) {
+	weatherAPIServerMock := newDelayedMockServer(t, testWeatherAPIAirQualityResponse(20, 30), http.StatusOK, 0)
+
+	cfg := newChainConfig(weatherAPIServerMock.URL, "http://localhost", "cache", "weatherapi")
+	cfg.AirQualityCacheTTL = 60
+	weatherHandler := setupWeatherHandlerFromConfig(t, cfg, testutils.NewInMemoryCache(), testutils.NewInMemoryMetrics())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	for range 2 {
+		resp, err := weatherHandler.GetAirQuality(ctx, &weather.GetAirQualityRequest{City: "Kyiv"})
+		require.NoError(t, err)
+		assert.Equal(t, int32(71), resp.Aqi)
+	}
+
+	assert.Equal(t, int32(1), weatherAPIServerMock.calls.Load())
+}
+
+func TestGetAirQuality_UnknownCityWithoutCoordinates(t *testing.T) {
+	weatherAPIServerMock := newMockServer(t, weatherAPIInternalErrorResponse, http.StatusBadRequest, "", true)
+
+	cfg := newChainConfig(weatherAPIServerMock.URL, "http://localhost", "weatherapi", "openweather")
+	weatherHandler := setupWeatherHandlerFromConfig(t, cfg, testutils.NewInMemoryCache(), testutils.NewInMemoryMetrics())
+
+	resp, err := weatherHandler.GetAirQuality(context.Background(), &weather.GetAirQualityRequest{City: "Springfield"})
+	require.Error(t, err)
+	assert.Nil(t, resp)
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+}
@@ -0,0 +1,125 @@
+package integration
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+	"weather-forecast/pkg/proto/weather"
+	"weather-service/internal/infrastructure/clients/openweather"
+	"weather-service/internal/infrastructure/clients/weatherapi"
+	"weather-service/tests/integration/testutils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var testAlertStart = time.Date(2025, time.July, 1, 12, 0, 0, 0, time.UTC)
+
+func testWeatherAPIAlertsResponse() weatherapi.WeatherAlertsResponse {
+	return weatherapi.WeatherAlertsResponse{
+		Alerts: weatherapi.WeatherAlertListResponse{
+			Alert: []weatherapi.WeatherAlertResponse{
+				{
+					Headline:    "Storm warning for Kyiv",
+					Severity:    "Severe",
+					Event:       "Storm",
+					Effective:   testAlertStart.Format(time.RFC3339),
+					Expires:     testAlertStart.Add(6 * time.Hour).Format(time.RFC3339),
+					Description: "Wind gusts up to 90 km/h",
+				},
+			},
+		},
+	}
+}
+
+func TestGetAlerts_WeatherAPI(t *testing.T) {
+	city := "Kyiv"
+
+	weatherAPIServerMock := newMockServer(t, testWeatherAPIAlertsResponse(), http.StatusOK, weatherAPIQuery(t, city).Encode(), true)
+
+	cfg := newChainConfig(weatherAPIServerMock.URL, "http://localhost", "weatherapi")
+	weatherHandler := setupWeatherHandlerFromConfig(t, cfg, testutils.NewInMemoryCache(), testutils.NewInMemoryMetrics())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := weatherHandler.GetAlerts(ctx, &weather.GetAlertsRequest{City: city})
+	require.NoError(t, err)
+	require.Len(t, resp.Alerts, 1)
+
+	alert := resp.Alerts[0]
+	assert.Equal(t, "storm:"+strconv.FormatInt(testAlertStart.Unix(), 10), alert.Id)
+	assert.Equal(t, "Storm", alert.Event)
+	assert.Equal(t, "Severe", alert.Severity)
+	assert.Equal(t, "Storm warning for Kyiv", alert.Headline)
+	assert.Equal(t, "Wind gusts up to 90 km/h", alert.Description)
+	assert.Equal(t, testAlertStart.Unix(), alert.StartsAt)
+	assert.Equal(t, testAlertStart.Add(6*time.Hour).Unix(), alert.EndsAt)
+}
+
+func TestGetAlerts_FallbackToOpenWeather(t *testing.T) {
+	city := "Kyiv"
+
+	weatherAPIServerMock := newMockServer(t, weatherAPIInternalErrorResponse, http.StatusBadRequest, "", true)
+
+	query := openWeatherQuery(t, city)
+	query.Del("units")
+	query.Set("exclude", "current,minutely,hourly,daily")
+	openWeatherServerMock := newMockServer(t, openweather.OpenWeatherAlertsResponse{
+		Alerts: []openweather.OpenWeatherAlertResponse{
+			{
+				SenderName:  "Ukrainian Hydrometeorological Center",
+				Event:       "Storm",
+				Start:       testAlertStart.Unix(),
+				End:         testAlertStart.Add(6 * time.Hour).Unix(),
+				Description: "Wind gusts up to 90 km/h",
+			},
+		},
+	}, http.StatusOK, query.Encode(), true)
+
+	cfg := newChainConfig(weatherAPIServerMock.URL, openWeatherServerMock.URL, "weatherapi", "openweather")
+	weatherHandler := setupWeatherHandlerFromConfig(t, cfg, testutils.NewInMemoryCache(), testutils.NewInMemoryMetrics())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := weatherHandler.GetAlerts(ctx, &weather.GetAlertsRequest{City: city})
+	require.NoError(t, err)
+	require.Len(t, resp.Alerts, 1)
+
+	assert.Equal(t, "storm:"+strconv.FormatInt(testAlertStart.Unix(), 10), resp.Alerts[0].Id)
+	assert.Equal(t, "Storm issued by Ukrainian Hydrometeorological Center", resp.Alerts[0].Headline)
+}
+
+func TestGetAlerts_Cached(t *testing.T) {
+	weatherAPIServerMock := newDelayedMockServer(t, testWeatherAPIAlertsResponse(), http.StatusOK, 0)
+
+	cfg := newChainConfig(weatherAPIServerMock.URL, "http://localhost", "cache", "weatherapi")
+	cfg.AlertsCacheTTL = 60
+	weatherHandler := setupWeatherHandlerFromConfig(t, cfg, testutils.NewInMemoryCache(), testutils.NewInMemoryMetrics())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	for range 2 {
+		resp, err := weatherHandler.GetAlerts(ctx, &weather.GetAlertsRequest{City: "Kyiv"})
+		require.NoError(t, err)
+		require.Len(t, resp.Alerts, 1)
+	}
+
+	assert.Equal(t, int32(1), weatherAPIServerMock.calls.Load())
+}
+
+func TestGetAlerts_Unsupported(t *testing.T) {
+	cfg := newOpenMeteoChainConfig("http://localhost", "openmeteo")
+	weatherHandler := setupWeatherHandlerFromConfig(t, cfg, testutils.NewInMemoryCache(), testutils.NewInMemoryMetrics())
+
+	resp, err := weatherHandler.GetAlerts(context.Background(), &weather.GetAlertsRequest{City: "Kyiv"})
+	require.Error(t, err)
+	assert.Nil(t, resp)
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+}
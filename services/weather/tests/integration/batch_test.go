@@ -0,0 +1,150 @@
+package integration
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+	"weather-forecast/pkg/proto/weather"
+	"weather-service/internal/domain/usecases"
+	"weather-service/internal/infrastructure/clients/openweather"
+	"weather-service/internal/infrastructure/clients/weatherapi"
+	"weather-service/tests/integration/testutils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const unknownBatchCity = "Atlantis"
+
+type BatchMockServer struct {
+	*httptest.Server
+	inFlight    atomic.Int32
+	maxInFlight atomic.Int32
+}
+
+func newBatchWeatherAPIMock(t *testing.T, delay time.Duration) *BatchMockServer {
+	t.Helper()
+
+	mock := &BatchMockServer{}
+	mock.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current := mock.inFlight.Add(1)
+		defer mock.inFlight.Add(-1)
+		for {
+			maxInFlight := mock.maxInFlight.Load()
+			if current <= maxInFlight || mock.maxInFlight.CompareAndSwap(maxInFlight, current) {
+				break
+			}
+		}
+
+		time.Sleep(delay)
+
+		if r.URL.Query().Get("q") == unknownBatchCity {
+			w.WriteHeader(http.StatusBadRequest)
+			require.NoError(t, json.NewEncoder(w).Encode(weatherapi.WeatherErrorResponse{
+				Error: weatherapi.WeatherErrorDetails{Code: 1006, Message: "No matching location found."},
+			}))
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+		require.NoError(t, json.NewEncoder(w).Encode(testWeatherAPISuccessResponse()))
+	}))
+	t.Cleanup(mock.Close)
+
+	return mock
+}
+
+func newNotFoundOpenWeatherMock(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		require.NoError(t, json.NewEncoder(w).Encode(openweather.OpenWeatherErrorResponse{Cod: "404", Message: "city not found"}))
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestGetWeatherBatch_PerCityResults(t *testing.T) {
+	weatherAPIServerMock := newBatchWeatherAPIMock(t, 0)
+	openWeatherServerMock := newNotFoundOpenWeatherMock(t)
+
+	cfg := newChainConfig(weatherAPIServerMock.URL, openWeatherServerMock.URL, "weatherapi", "openweather")
+	weatherHandler := setupWeatherHandlerFromConfig(t, cfg, testutils.NewInMemoryCache(), testutils.NewInMemoryMetrics())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := weatherHandler.GetWeatherBatch(ctx, &weather.GetWeatherBatchRequest{
+		Cities: []string{"Kyiv", unknownBatchCity, " "},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Results, 3)
+
+	assert.Equal(t, "Kyiv", resp.Results[0].City)
+	require.NotNil(t, resp.Results[0].GetWeather())
+	assertWeatherResponse(t, resp.Results[0].GetWeather(), testWeather)
+
+	assert.Equal(t, unknownBatchCity, resp.Results[1].City)
+	require.NotNil(t, resp.Results[1].GetError())
+	assert.Equal(t, int32(codes.NotFound), resp.Results[1].GetError().Code)
+
+	require.NotNil(t, resp.Results[2].GetError())
+	assert.Equal(t, int32(codes.InvalidArgument), resp.Results[2].GetError().Code)
+}
+
+func TestGetWeatherBatch_BoundedConcurrency(t *testing.T) {
+	weatherAPIServerMock := newBatchWeatherAPIMock(t, 50*time.Millisecond)
+
+	cfg := newChainConfig(weatherAPIServerMock.URL, "http://localhost", "weatherapi")
+	weatherHandler := setupWeatherHandlerFromConfig(t, cfg, testutils.NewInMemoryCache(), testutils.NewInMemoryMetrics())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	cities := make([]string, 3*usecases.BatchWorkers)
+	for i := range cities {
+		cities[i] = fmt.Sprintf("Test City %d", i)
+	}
+
+	resp, err := weatherHandler.GetWeatherBatch(ctx, &weather.GetWeatherBatchRequest{Cities: cities})
+	require.NoError(t, err)
+	require.Len(t, resp.Results, len(cities))
+
+	for i, result := range resp.Results {
+		assert.Equal(t, cities[i], result.City)
+		assert.NotNil(t, result.GetWeather())
+	}
+
+	assert.LessOrEqual(t, weatherAPIServerMock.maxInFlight.Load(), int32(usecases.BatchWorkers))
+	assert.Greater(t, weatherAPIServerMock.maxInFlight.Load(), int32(1))
+}
+
+func TestGetWeatherBatch_InvalidBatch(t *testing.T) {
+	testCases := []struct {
+		name   string
+		cities []string
+	}{
+		{name: "Empty Batch", cities: nil},
+		{name: "Too Large Batch", cities: make([]string, usecases.MaxBatchSize+1)},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			weatherHandler := setupWeatherHandler("http://localhost", "http://localhost")
+
+			resp, err := weatherHandler.GetWeatherBatch(context.Background(), &weather.GetWeatherBatchRequest{Cities: testCase.cities})
+			require.Error(t, err)
+			assert.Nil(t, resp)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+	}
+}
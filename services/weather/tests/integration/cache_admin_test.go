@@ -0,0 +1,221 @@
+package integration
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+	"weather-forecast/pkg/proto/weather"
+	"weather-service/internal/config"
+	"weather-service/internal/domain/models"
+	"weather-service/internal/domain/usecases"
+	"weather-service/internal/infrastructure/locations"
+	"weather-service/internal/infrastructure/providers"
+	"weather-service/internal/presentation/server"
+	"weather-service/internal/presentation/server/handlers"
+	"weather-service/tests/fakeweather"
+	"weather-service/tests/integration/testutils"
+
+	stub_logger "weather-forecast/pkg/stubs/logger"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const testAdminToken = "testAdminToken"
+
+func setupCacheAdminHandlers(t *testing.T, cfg *config.Config) (*handlers.WeatherHandler, *handlers.AdminHandler) {
+	t.Helper()
+	stubLogger := stub_logger.New()
+
+	cacher := testutils.NewInMemoryCache()
+	weatherChain, err := providers.NewChainBuilder(cfg, cacher, testutils.NewInMemoryCache(), newHistoryStore(), testutils.NewInMemoryMetrics(), http.DefaultTransport, stubLogger).Build()
+	require.NoError(t, err)
+
+	cityResolver, err := locations.NewResolver(stubLogger)
+	require.NoError(t, err)
+
+	weatherService := usecases.NewWeatherService(weatherChain, cityResolver, stubLogger)
+	cacheAdminService := usecases.NewCacheAdminService(providers.NewCacheInspector(cacher, stubLogger), weatherChain, cityResolver, stubLogger)
+
+	return newWeatherHandler(weatherService), handlers.NewAdminHandler(cacheAdminService, stubLogger)
+}
+
+func newCacheAdminConfig(t *testing.T) (*fakeweather.Server, *fakeweather.Script, *config.Config) {
+	t.Helper()
+
+	fake, script, cfg := newFakeWeather(t, bundledFixtures, "cache", "weatherapi")
+	cfg.WeatherAPICacheTTL = 600
+	cfg.AirQualityCacheTTL = 600
+
+	return fake, script, cfg
+}
+
+func TestCacheAdmin_ListCache(t *testing.T) {
+	_, _, cfg := newCacheAdminConfig(t)
+	weatherHandler, adminHandler := setupCacheAdminHandlers(t, cfg)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := weatherHandler.GetWeather(ctx, &weather.GetWeatherRequest{Location: &weather.GetWeatherRequest_City{City: "Kyiv"}})
+	require.NoError(t, err)
+	_, err = weatherHandler.GetAirQuality(ctx, &weather.GetAirQualityRequest{City: "Kyiv"})
+	require.NoError(t, err)
+	_, err = weatherHandler.GetWeather(ctx, &weather.GetWeatherRequest{Location: &weather.GetWeatherRequest_City{City: "Lviv"}, Units: string(models.UnitsImperial)})
+	require.NoError(t, err)
+
+	resp, err := adminHandler.ListCache(ctx, &weather.ListCacheRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.Entries, 3)
+
+	assert.Equal(t, "air_quality:kyiv-ua", resp.Entries[0].Key)
+	assert.Equal(t, string(models.CacheKindAirQuality), resp.Entries[0].Kind)
+	assert.Equal(t, "weather:kyiv-ua:metric:en", resp.Entries[1].Key)
+	assert.Equal(t, "weather:lviv-ua:imperial:en", resp.Entries[2].Key)
+	assert.Equal(t, "lviv-ua", resp.Entries[2].LocationId)
+
+	for _, entry := range resp.Entries {
+		assert.Equal(t, providers.WeatherAPIProviderName, entry.Source)
+		assert.InDelta(t, time.Now().Unix(), entry.CachedAt, 5)
+		assert.GreaterOrEqual(t, entry.AgeSeconds, int64(0))
+		assert.False(t, entry.Stale)
+	}
+
+	resp, err = adminHandler.ListCache(ctx, &weather.ListCacheRequest{City: "kyiv"})
+	require.NoError(t, err)
+	require.Len(t, resp.Entries, 2)
+	for _, entry := range resp.Entries {
+		assert.Equal(t, "kyiv-ua", entry.LocationId)
+	}
+}
+
+func TestCacheAdmin_InvalidateCache(t *testing.T) {
+	fake, _, cfg := newCacheAdminConfig(t)
+	weatherHandler, adminHandler := setupCacheAdminHandlers(t, cfg)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	for _, city := range []string{"Kyiv", "Lviv", "Odesa"} {
+		_, err := weatherHandler.GetWeather(ctx, &weather.GetWeatherRequest{Location: &weather.GetWeatherRequest_City{City: city}})
+		require.NoError(t, err)
+	}
+	require.Equal(t, 3, fake.Calls(fakeweather.WeatherAPI, "current"))
+
+	resp, err := adminHandler.InvalidateCache(ctx, &weather.InvalidateCacheRequest{City: "Kyiv"})
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), resp.Removed)
+
+	_, err = weatherHandler.GetWeather(ctx, &weather.GetWeatherRequest{Location: &weather.GetWeatherRequest_City{City: "Kyiv"}})
+	require.NoError(t, err)
+	_, err = weatherHandler.GetWeather(ctx, &weather.GetWeatherRequest{Location: &weather.GetWeatherRequest_City{City: "Lviv"}})
+	require.NoError(t, err)
+	assert.Equal(t, 4, fake.Calls(fakeweather.WeatherAPI, "current"), "only the invalidated city is fetched again")
+
+	resp, err = adminHandler.InvalidateCache(ctx, &weather.InvalidateCacheRequest{All: true})
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), resp.Removed)
+
+	list, err := adminHandler.ListCache(ctx, &weather.ListCacheRequest{})
+	require.NoError(t, err)
+	assert.Empty(t, list.Entries)
+}
+
+func TestCacheAdmin_InvalidateCacheScope(t *testing.T) {
+	_, _, cfg := newCacheAdminConfig(t)
+	_, adminHandler := setupCacheAdminHandlers(t, cfg)
+
+	tests := []struct {
+		name string
+		req  *weather.InvalidateCacheRequest
+	}{
+		{name: "neither city nor all", req: &weather.InvalidateCacheRequest{City: " "}},
+		{name: "both city and all", req: &weather.InvalidateCacheRequest{City: "Kyiv", All: true}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := adminHandler.InvalidateCache(context.Background(), tt.req)
+			require.Error(t, err)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+	}
+}
+
+func TestCacheAdmin_RefreshCache(t *testing.T) {
+	fake, script, cfg := newCacheAdminConfig(t)
+	weatherHandler, adminHandler := setupCacheAdminHandlers(t, cfg)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := weatherHandler.GetWeather(ctx, &weather.GetWeatherRequest{Location: &weather.GetWeatherRequest_City{City: "Kyiv"}, Units: string(models.UnitsImperial)})
+	require.NoError(t, err)
+	require.Equal(t, 1, fake.Calls(fakeweather.WeatherAPI, "current"))
+
+	resp, err := adminHandler.RefreshCache(ctx, &weather.RefreshCacheRequest{City: "Kyiv"})
+	require.NoError(t, err)
+	require.Len(t, resp.Entries, 1)
+	assert.Equal(t, "weather:kyiv-ua:imperial:en", resp.Entries[0].Key, "the cached options are refreshed")
+	assert.Equal(t, 2, fake.Calls(fakeweather.WeatherAPI, "current"))
+
+	resp, err = adminHandler.RefreshCache(ctx, &weather.RefreshCacheRequest{City: "Lviv"})
+	require.NoError(t, err)
+	require.Len(t, resp.Entries, 1)
+	assert.Equal(t, "weather:lviv-ua:metric:en", resp.Entries[0].Key, "current weather is fetched when nothing is cached")
+
+	script.Add(fakeweather.Failure{Provider: fakeweather.WeatherAPI, Kind: fakeweather.FailureServerError, Times: 1})
+	_, err = adminHandler.RefreshCache(ctx, &weather.RefreshCacheRequest{City: "Kyiv"})
+	require.Error(t, err)
+
+	list, err := adminHandler.ListCache(ctx, &weather.ListCacheRequest{City: "Kyiv"})
+	require.NoError(t, err)
+	assert.Len(t, list.Entries, 1, "a failed refresh keeps the cached entry")
+
+	_, err = adminHandler.RefreshCache(ctx, &weather.RefreshCacheRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestCacheAdmin_AuthInterceptor(t *testing.T) {
+	adminMethod := "/" + weather.WeatherAdminService_ServiceDesc.ServiceName + "/ListCache"
+	weatherMethod := "/" + weather.WeatherService_ServiceDesc.ServiceName + "/GetWeather"
+
+	tests := []struct {
+		name          string
+		token         string
+		method        string
+		authorization string
+		expectedCode  codes.Code
+	}{
+		{name: "valid token", token: testAdminToken, method: adminMethod, authorization: "Bearer " + testAdminToken, expectedCode: codes.OK},
+		{name: "missing token", token: testAdminToken, method: adminMethod, expectedCode: codes.Unauthenticated},
+		{name: "wrong token", token: testAdminToken, method: adminMethod, authorization: "Bearer wrong", expectedCode: codes.Unauthenticated},
+		{name: "token without scheme", token: testAdminToken, method: adminMethod, authorization: testAdminToken, expectedCode: codes.Unauthenticated},
+		{name: "admin disabled", token: "", method: adminMethod, authorization: "Bearer ", expectedCode: codes.Unauthenticated},
+		{name: "other services are open", token: testAdminToken, method: weatherMethod, expectedCode: codes.OK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.authorization != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(server.AuthorizationHeader, tt.authorization))
+			}
+
+			called := false
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				called = true
+				return nil, nil
+			}
+
+			_, err := server.AdminAuthInterceptor(tt.token)(ctx, nil, &grpc.UnaryServerInfo{FullMethod: tt.method}, handler)
+			assert.Equal(t, tt.expectedCode, status.Code(err))
+			assert.Equal(t, tt.expectedCode == codes.OK, called)
+		})
+	}
+}
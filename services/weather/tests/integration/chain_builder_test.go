@@ -0,0 +1,138 @@
+package integration
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+	"weather-forecast/pkg/proto/weather"
+	stub_logger "weather-forecast/pkg/stubs/logger"
+	"weather-service/internal/infrastructure/clients/weatherapi"
+	"weather-service/internal/infrastructure/providers"
+	"weather-service/tests/integration/testutils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func testWeatherAPISuccessResponse() weatherapi.WeatherSuccessResponse {
+	return weatherapi.WeatherSuccessResponse{
+		Current: weatherapi.WeatherCurrentResponse{
+			TempC:      testWeather.Temperature,
+			Condition:  weatherapi.WeatherConditionResponse{Text: testWeather.Description},
+			Humidity:   testWeather.Humidity,
+			WindKph:    testWeather.WindSpeed,
+			WindDegree: testWeather.WindDirection,
+			PressureMb: testWeather.Pressure,
+			Cloud:      testWeather.CloudCover,
+			VisKm:      testWeather.Visibility,
+			FeelsLikeC: testWeather.FeelsLike,
+		},
+	}
+}
+
+func TestChainBuilder_ProviderOrder(t *testing.T) {
+	city := "Kyiv"
+
+	weatherAPIServerMock := newMockServer(t, nil, 0, "", false)
+	openWeatherServerMock := setupOpenWeatherMock(t, testOpenWeatherSuccessResponse(), http.StatusOK, city, true)
+
+	cfg := newChainConfig(weatherAPIServerMock.URL, openWeatherServerMock.URL, "openweather", "weatherapi")
+	weatherHandler := setupWeatherHandlerFromConfig(t, cfg, testutils.NewInMemoryCache(), testutils.NewInMemoryMetrics())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := weatherHandler.GetWeather(ctx, &weather.GetWeatherRequest{Location: &weather.GetWeatherRequest_City{City: city}})
+	require.NoError(t, err)
+	assertWeatherResponse(t, resp, testWeather)
+}
+
+func TestChainBuilder_DisabledProvider(t *testing.T) {
+	city := "Kyiv"
+
+	weatherAPIServerMock := setupWeatherAPIMock(t, weatherAPIInternalErrorResponse, http.StatusBadRequest, city)
+	openWeatherServerMock := newMockServer(t, nil, 0, "", false)
+
+	cfg := newChainConfig(weatherAPIServerMock.URL, openWeatherServerMock.URL, "weatherapi")
+	weatherHandler := setupWeatherHandlerFromConfig(t, cfg, testutils.NewInMemoryCache(), testutils.NewInMemoryMetrics())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := weatherHandler.GetWeather(ctx, &weather.GetWeatherRequest{Location: &weather.GetWeatherRequest_City{City: city}})
+	require.Error(t, err)
+	assert.Nil(t, resp)
+	assert.Equal(t, codes.Internal, status.Code(err))
+}
+
+func TestChainBuilder_CacheTTL(t *testing.T) {
+	testCases := []struct {
+		name                    string
+		cacheTTL                int
+		expectedWeatherAPICalls int
+		expectedCacheSize       int
+	}{
+		{
+			name:                    "Cached Provider",
+			cacheTTL:                60,
+			expectedWeatherAPICalls: 1,
+			expectedCacheSize:       1,
+		},
+		{
+			name:                    "Caching Disabled",
+			cacheTTL:                0,
+			expectedWeatherAPICalls: 2,
+			expectedCacheSize:       0,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			city := "Kyiv"
+			cache := testutils.NewInMemoryCache()
+
+			weatherAPIServerMock := setupWeatherAPIMock(t, testWeatherAPISuccessResponse(), http.StatusOK, city)
+			openWeatherServerMock := newMockServer(t, nil, 0, "", false)
+
+			cfg := newChainConfig(weatherAPIServerMock.URL, openWeatherServerMock.URL, "cache", "weatherapi", "openweather")
+			cfg.WeatherAPICacheTTL = testCase.cacheTTL
+			weatherHandler := setupWeatherHandlerFromConfig(t, cfg, cache, testutils.NewInMemoryMetrics())
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			for range 2 {
+				resp, err := weatherHandler.GetWeather(ctx, &weather.GetWeatherRequest{Location: &weather.GetWeatherRequest_City{City: city}})
+				require.NoError(t, err)
+				assertWeatherResponse(t, resp, testWeather)
+			}
+
+			assert.Equal(t, testCase.expectedWeatherAPICalls, weatherAPIServerMock.CallCount())
+			assert.Equal(t, testCase.expectedCacheSize, cache.Size())
+		})
+	}
+}
+
+func TestChainBuilder_InvalidChain(t *testing.T) {
+	testCases := []struct {
+		name  string
+		chain []string
+	}{
+		{name: "Unknown Provider", chain: []string{"cache", "accuweather"}},
+		{name: "Duplicated Provider", chain: []string{"weatherapi", "openweather", "weatherapi"}},
+		{name: "Empty Chain", chain: nil},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			cfg := newChainConfig("http://localhost", "http://localhost", testCase.chain...)
+
+			chain, err := providers.NewChainBuilder(cfg, testutils.NewInMemoryCache(), testutils.NewInMemoryCache(), newHistoryStore(), testutils.NewInMemoryMetrics(), http.DefaultTransport, stub_logger.New()).Build()
+			require.Error(t, err)
+			assert.Nil(t, chain)
+		})
+	}
+}
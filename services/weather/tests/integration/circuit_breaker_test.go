@@ -0,0 +1,278 @@
+package integration
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+	"weather-forecast/pkg/proto/weather"
+	"weather-service/internal/infrastructure/clients/openweather"
+	"weather-service/internal/infrastructure/clients/weatherapi"
+	"weather-service/internal/infrastructure/providers"
+	"weather-service/tests/integration/testutils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	testCircuitBreakerSettings = providers.CircuitBreakerSettings{
+		FailureThreshold: 2,
+		SuccessThreshold: 1,
+		CoolDown:         200 * time.Millisecond,
+	}
+
+	weatherAPIInternalErrorResponse = weatherapi.WeatherErrorResponse{
+		Error: weatherapi.WeatherErrorDetails{
+			Code:    9999,
+			Message: "Internal application error.",
+		},
+	}
+)
+
+func testOpenWeatherSuccessResponse() openweather.OpenWeatherSuccessResponse {
+	return openweather.OpenWeatherSuccessResponse{
+		Weather: []openweather.OpenWeatherDescriptionResponse{
+			{Description: testWeather.Description},
+		},
+		Main: openweather.OpenWeatherMainResponse{
+			Temperature: testWeather.Temperature,
+			Humidity:    testWeather.Humidity,
+			FeelsLike:   testWeather.FeelsLike,
+			Pressure:    testWeather.Pressure,
+		},
+		Wind: openweather.OpenWeatherWindResponse{
+			Speed:  4,
+			Degree: testWeather.WindDirection,
+		},
+		Clouds: openweather.OpenWeatherCloudsResponse{
+			All: testWeather.CloudCover,
+		},
+		Visibility: 10000,
+	}
+}
+
+func TestCircuitBreaker_SkipsOpenProvider(t *testing.T) {
+	city := "Kyiv"
+	metrics := testutils.NewInMemoryMetrics()
+
+	weatherAPIServerMock := setupWeatherAPIMock(t, weatherAPIInternalErrorResponse, http.StatusBadRequest, city)
+	openWeatherServerMock := setupOpenWeatherMock(t, testOpenWeatherSuccessResponse(), http.StatusOK, city, true)
+	weatherHandler := setupWeatherHandlerWithCircuitBreaker(metrics, testCircuitBreakerSettings, weatherAPIServerMock.URL, openWeatherServerMock.URL)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	for range 4 {
+		resp, err := weatherHandler.GetWeather(ctx, &weather.GetWeatherRequest{Location: &weather.GetWeatherRequest_City{City: city}})
+		require.NoError(t, err)
+		assertWeatherResponse(t, resp, testWeather)
+	}
+
+	assert.Equal(t, testCircuitBreakerSettings.FailureThreshold, weatherAPIServerMock.CallCount())
+	assert.Equal(t, 4, openWeatherServerMock.CallCount())
+	assert.Equal(t, providers.CircuitOpen, metrics.CircuitState("weatherapi"))
+	assert.Equal(t, providers.CircuitClosed, metrics.CircuitState("openweather"))
+
+	time.Sleep(testCircuitBreakerSettings.CoolDown)
+
+	_, err := weatherHandler.GetWeather(ctx, &weather.GetWeatherRequest{Location: &weather.GetWeatherRequest_City{City: city}})
+	require.NoError(t, err)
+
+	assert.Equal(t, testCircuitBreakerSettings.FailureThreshold+1, weatherAPIServerMock.CallCount())
+	assert.Equal(t, providers.CircuitOpen, metrics.CircuitState("weatherapi"))
+}
+
+func TestCircuitBreaker_ClosesAfterSuccessfulTrial(t *testing.T) {
+	city := "Kyiv"
+	metrics := testutils.NewInMemoryMetrics()
+
+	var weatherAPIHealthy atomic.Bool
+	var weatherAPICalls atomic.Int32
+	weatherAPIServerMock := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		weatherAPICalls.Add(1)
+
+		if !weatherAPIHealthy.Load() {
+			w.WriteHeader(http.StatusBadRequest)
+			require.NoError(t, json.NewEncoder(w).Encode(weatherAPIInternalErrorResponse))
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+		require.NoError(t, json.NewEncoder(w).Encode(weatherapi.WeatherSuccessResponse{
+			Current: weatherapi.WeatherCurrentResponse{
+				TempC:      testWeather.Temperature,
+				Condition:  weatherapi.WeatherConditionResponse{Text: testWeather.Description},
+				Humidity:   testWeather.Humidity,
+				WindKph:    testWeather.WindSpeed,
+				WindDegree: testWeather.WindDirection,
+				PressureMb: testWeather.Pressure,
+				Cloud:      testWeather.CloudCover,
+				VisKm:      testWeather.Visibility,
+				FeelsLikeC: testWeather.FeelsLike,
+			},
+		}))
+	}))
+	t.Cleanup(weatherAPIServerMock.Close)
+
+	openWeatherServerMock := setupOpenWeatherMock(t, testOpenWeatherSuccessResponse(), http.StatusOK, city, true)
+	weatherHandler := setupWeatherHandlerWithCircuitBreaker(metrics, testCircuitBreakerSettings, weatherAPIServerMock.URL, openWeatherServerMock.URL)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	for range testCircuitBreakerSettings.FailureThreshold {
+		_, err := weatherHandler.GetWeather(ctx, &weather.GetWeatherRequest{Location: &weather.GetWeatherRequest_City{City: city}})
+		require.NoError(t, err)
+	}
+	require.Equal(t, providers.CircuitOpen, metrics.CircuitState("weatherapi"))
+
+	weatherAPIHealthy.Store(true)
+	time.Sleep(testCircuitBreakerSettings.CoolDown)
+
+	for range 2 {
+		resp, err := weatherHandler.GetWeather(ctx, &weather.GetWeatherRequest{Location: &weather.GetWeatherRequest_City{City: city}})
+		require.NoError(t, err)
+		assertWeatherResponse(t, resp, testWeather)
+	}
+
+	assert.Equal(t, int32(testCircuitBreakerSettings.FailureThreshold+2), weatherAPICalls.Load())
+	assert.Equal(t, testCircuitBreakerSettings.FailureThreshold, openWeatherServerMock.CallCount())
+	assert.Equal(t, providers.CircuitClosed, metrics.CircuitState("weatherapi"))
+}
+
+func TestCircuitBreaker_AllProvidersOpen(t *testing.T) {
+	city := "Kyiv"
+	metrics := testutils.NewInMemoryMetrics()
+	settings := providers.CircuitBreakerSettings{
+		FailureThreshold: 1,
+		SuccessThreshold: 1,
+		CoolDown:         time.Minute,
+	}
+
+	openWeatherErrorResponse := openweather.OpenWeatherErrorResponse{
+		Cod:     "500",
+		Message: "internal server error",
+	}
+
+	weatherAPIServerMock := setupWeatherAPIMock(t, weatherAPIInternalErrorResponse, http.StatusBadRequest, city)
+	openWeatherServerMock := setupOpenWeatherMock(t, openWeatherErrorResponse, http.StatusInternalServerError, city, true)
+	weatherHandler := setupWeatherHandlerWithCircuitBreaker(metrics, settings, weatherAPIServerMock.URL, openWeatherServerMock.URL)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := weatherHandler.GetWeather(ctx, &weather.GetWeatherRequest{Location: &weather.GetWeatherRequest_City{City: city}})
+	assert.Equal(t, codes.Internal, status.Code(err))
+
+	resp, err := weatherHandler.GetWeather(ctx, &weather.GetWeatherRequest{Location: &weather.GetWeatherRequest_City{City: city}})
+	require.Error(t, err)
+	assert.Nil(t, resp)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+
+	assert.Equal(t, 1, weatherAPIServerMock.CallCount())
+	assert.Equal(t, 1, openWeatherServerMock.CallCount())
+}
+
+func TestCircuitBreaker_CityNotFoundKeepsCircuitClosed(t *testing.T) {
+	const weatherAPINotFoundErrorCode = 1006
+	const openWeatherNotFoundErrorCode = "404"
+
+	city := "Odeca"
+	metrics := testutils.NewInMemoryMetrics()
+	settings := providers.CircuitBreakerSettings{
+		FailureThreshold: 1,
+		SuccessThreshold: 1,
+		CoolDown:         time.Minute,
+	}
+
+	weatherAPINotFoundResponse := weatherapi.WeatherErrorResponse{
+		Error: weatherapi.WeatherErrorDetails{
+			Code:    weatherAPINotFoundErrorCode,
+			Message: "No matching location found.",
+		},
+	}
+	openWeatherNotFoundResponse := openweather.OpenWeatherErrorResponse{
+		Cod:     openWeatherNotFoundErrorCode,
+		Message: "city not found",
+	}
+
+	weatherAPIServerMock := setupWeatherAPIMock(t, weatherAPINotFoundResponse, http.StatusBadRequest, city)
+	openWeatherServerMock := setupOpenWeatherMock(t, openWeatherNotFoundResponse, http.StatusNotFound, city, true)
+	weatherHandler := setupWeatherHandlerWithCircuitBreaker(metrics, settings, weatherAPIServerMock.URL, openWeatherServerMock.URL)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	for range 2 {
+		_, err := weatherHandler.GetWeather(ctx, &weather.GetWeatherRequest{Location: &weather.GetWeatherRequest_City{City: city}})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	}
+
+	assert.Equal(t, 2, weatherAPIServerMock.CallCount())
+	assert.Equal(t, 2, openWeatherServerMock.CallCount())
+	assert.Equal(t, providers.CircuitClosed, metrics.CircuitState("weatherapi"))
+	assert.Equal(t, providers.CircuitClosed, metrics.CircuitState("openweather"))
+}
+
+func TestCircuitBreaker_CancelledTrialIsNeutral(t *testing.T) {
+	city := "Kyiv"
+	metrics := testutils.NewInMemoryMetrics()
+
+	var weatherAPIMode atomic.Int32 // 0 failing, 1 slow, 2 healthy
+	var weatherAPICalls atomic.Int32
+	weatherAPIServerMock := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		weatherAPICalls.Add(1)
+
+		switch weatherAPIMode.Load() {
+		case 0:
+			w.WriteHeader(http.StatusBadRequest)
+			require.NoError(t, json.NewEncoder(w).Encode(weatherAPIInternalErrorResponse))
+			return
+		case 1:
+			select {
+			case <-r.Context().Done():
+				return
+			case <-time.After(time.Second):
+			}
+		}
+
+		w.WriteHeader(http.StatusOK)
+		require.NoError(t, json.NewEncoder(w).Encode(testWeatherAPISuccessResponse()))
+	}))
+	t.Cleanup(weatherAPIServerMock.Close)
+
+	openWeatherServerMock := setupOpenWeatherMock(t, testOpenWeatherSuccessResponse(), http.StatusOK, city, true)
+	weatherHandler := setupWeatherHandlerWithCircuitBreaker(metrics, testCircuitBreakerSettings, weatherAPIServerMock.URL, openWeatherServerMock.URL)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	for range testCircuitBreakerSettings.FailureThreshold {
+		_, err := weatherHandler.GetWeather(ctx, &weather.GetWeatherRequest{Location: &weather.GetWeatherRequest_City{City: city}})
+		require.NoError(t, err)
+	}
+	require.Equal(t, providers.CircuitOpen, metrics.CircuitState("weatherapi"))
+
+	weatherAPIMode.Store(1)
+	time.Sleep(testCircuitBreakerSettings.CoolDown)
+
+	trialCtx, cancelTrial := context.WithCancel(ctx)
+	time.AfterFunc(50*time.Millisecond, cancelTrial)
+	_, err := weatherHandler.GetWeather(trialCtx, &weather.GetWeatherRequest{Location: &weather.GetWeatherRequest_City{City: city}})
+	require.Error(t, err)
+	assert.Equal(t, providers.CircuitHalfOpen, metrics.CircuitState("weatherapi"), "a cancelled trial does not close the circuit")
+
+	weatherAPIMode.Store(2)
+	resp, err := weatherHandler.GetWeather(ctx, &weather.GetWeatherRequest{Location: &weather.GetWeatherRequest_City{City: city}})
+	require.NoError(t, err)
+	assertWeatherResponse(t, resp, testWeather)
+
+	assert.Equal(t, int32(testCircuitBreakerSettings.FailureThreshold+2), weatherAPICalls.Load(), "the cancelled trial gives its slot up")
+	assert.Equal(t, providers.CircuitClosed, metrics.CircuitState("weatherapi"))
+}
@@ -0,0 +1,152 @@
+package integration
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+	"weather-forecast/pkg/proto/weather"
+	stub_logger "weather-forecast/pkg/stubs/logger"
+	"weather-service/internal/infrastructure/cache"
+	"weather-service/internal/infrastructure/clients/weatherapi"
+	"weather-service/tests/integration/testutils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestResolveCity(t *testing.T) {
+	testCases := []struct {
+		name                string
+		city                string
+		expectedID          string
+		expectedName        string
+		expectedCountry     string
+		expectedCoordinates *weather.Coordinates
+	}{
+		{
+			name:                "Canonical Name",
+			city:                "Kyiv",
+			expectedID:          "kyiv-ua",
+			expectedName:        "Kyiv",
+			expectedCountry:     "UA",
+			expectedCoordinates: &weather.Coordinates{Latitude: 50.4501, Longitude: 30.5234},
+		},
+		{
+			name:                "Legacy Spelling With Spaces",
+			city:                "  KIEV ",
+			expectedID:          "kyiv-ua",
+			expectedName:        "Kyiv",
+			expectedCountry:     "UA",
+			expectedCoordinates: &weather.Coordinates{Latitude: 50.4501, Longitude: 30.5234},
+		},
+		{
+			name:                "Cyrillic Name",
+			city:                "Київ",
+			expectedID:          "kyiv-ua",
+			expectedName:        "Kyiv",
+			expectedCountry:     "UA",
+			expectedCoordinates: &weather.Coordinates{Latitude: 50.4501, Longitude: 30.5234},
+		},
+		{
+			name:                "Hyphen Variant",
+			city:                "ivano frankivsk",
+			expectedID:          "ivano-frankivsk-ua",
+			expectedName:        "Ivano-Frankivsk",
+			expectedCountry:     "UA",
+			expectedCoordinates: &weather.Coordinates{Latitude: 48.9226, Longitude: 24.7111},
+		},
+		{
+			name:         "Unknown City",
+			city:         " Bila   Tserkva ",
+			expectedID:   "bila-tserkva",
+			expectedName: "Bila Tserkva",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			weatherAPIServerMock := newMockServer(t, nil, 0, "", false)
+			openWeatherServerMock := newMockServer(t, nil, 0, "", false)
+			weatherHandler := setupWeatherHandler(weatherAPIServerMock.URL, openWeatherServerMock.URL)
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			resp, err := weatherHandler.ResolveCity(ctx, &weather.ResolveCityRequest{City: testCase.city})
+			require.NoError(t, err)
+
+			assert.Equal(t, testCase.expectedID, resp.Location.Id)
+			assert.Equal(t, testCase.expectedName, resp.Location.Name)
+			assert.Equal(t, testCase.expectedCountry, resp.Location.Country)
+			if testCase.expectedCoordinates == nil {
+				assert.Nil(t, resp.Location.Coordinates)
+			} else {
+				require.NotNil(t, resp.Location.Coordinates)
+				assert.Equal(t, testCase.expectedCoordinates.Latitude, resp.Location.Coordinates.Latitude)
+				assert.Equal(t, testCase.expectedCoordinates.Longitude, resp.Location.Coordinates.Longitude)
+			}
+		})
+	}
+}
+
+func TestResolveCity_Empty(t *testing.T) {
+	weatherAPIServerMock := newMockServer(t, nil, 0, "", false)
+	openWeatherServerMock := newMockServer(t, nil, 0, "", false)
+	weatherHandler := setupWeatherHandler(weatherAPIServerMock.URL, openWeatherServerMock.URL)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := weatherHandler.GetWeather(ctx, &weather.GetWeatherRequest{Location: &weather.GetWeatherRequest_City{City: "   "}})
+	require.Error(t, err)
+	assert.Nil(t, resp)
+
+	grpcStatus, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, grpcStatus.Code())
+}
+
+func TestGetWeather_RedisCacheFlow_CitySpellings(t *testing.T) {
+	testRedis := testutils.SetupTestRedis(t)
+	redisCache, err := cache.NewRedis(testRedis.ConnectionString(), stub_logger.New())
+	require.NoError(t, err)
+	metrics := testutils.NewInMemoryMetrics()
+
+	weatherAPISuccessResponse := weatherapi.WeatherSuccessResponse{
+		Current: weatherapi.WeatherCurrentResponse{
+			TempC: testWeather.Temperature,
+			Condition: weatherapi.WeatherConditionResponse{
+				Text: testWeather.Description,
+			},
+			Humidity:   testWeather.Humidity,
+			WindKph:    testWeather.WindSpeed,
+			WindDegree: testWeather.WindDirection,
+			PressureMb: testWeather.Pressure,
+			Cloud:      testWeather.CloudCover,
+			VisKm:      testWeather.Visibility,
+			FeelsLikeC: testWeather.FeelsLike,
+		},
+	}
+
+	weatherAPIServerMock := setupWeatherAPIMock(t, weatherAPISuccessResponse, http.StatusOK, "Kyiv")
+	openWeatherAPIServerMock := setupOpenWeatherMock(t, nil, 0, "", false)
+	weatherHandler := setupWeatherHandlerWithCache(redisCache, metrics, weatherAPIServerMock.URL, openWeatherAPIServerMock.URL)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	for _, city := range []string{"Kyiv", "kiev ", "Київ"} {
+		resp, err := weatherHandler.GetWeather(ctx, &weather.GetWeatherRequest{Location: &weather.GetWeatherRequest_City{City: city}})
+		require.NoError(t, err)
+		assertWeatherResponse(t, resp, testWeather)
+	}
+
+	hits, misses, errors := metrics.Stats()
+	assert.Equal(t, 2, hits)
+	assert.Equal(t, 1, misses)
+	assert.Equal(t, 0, errors)
+	assert.Equal(t, 1, testRedis.Size())
+}
@@ -0,0 +1,185 @@
+package integration
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+	"weather-forecast/pkg/proto/weather"
+	"weather-service/internal/domain/usecases"
+	"weather-service/internal/infrastructure/clients/openmeteo"
+	"weather-service/internal/infrastructure/locations"
+	"weather-service/internal/infrastructure/providers"
+	"weather-service/internal/presentation/server/handlers"
+	"weather-service/tests/integration/testutils"
+
+	stub_logger "weather-forecast/pkg/stubs/logger"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const testCitySearchCacheTTL = time.Minute
+
+func setupCitySearchHandler(t *testing.T, geocodingURL string) *handlers.WeatherHandler {
+	t.Helper()
+	stubLogger := stub_logger.New()
+
+	cfg := newChainConfig("http://localhost", "http://localhost", "weatherapi")
+	weatherChain, err := providers.NewChainBuilder(cfg, testutils.NewInMemoryCache(), testutils.NewInMemoryCache(), newHistoryStore(), testutils.NewInMemoryMetrics(), http.DefaultTransport, stubLogger).Build()
+	require.NoError(t, err)
+
+	cityResolver, err := locations.NewResolver(stubLogger)
+	require.NoError(t, err)
+	weatherService := usecases.NewWeatherService(weatherChain, cityResolver, stubLogger)
+
+	var cityGeocoder usecases.CityIndex
+	if geocodingURL != "" {
+		cfg.OpenMeteoGeocodingURL = geocodingURL
+		client := openmeteo.NewClient(cfg, &http.Client{}, stubLogger)
+		cityGeocoder = locations.NewGeocoder(client, testutils.NewInMemoryCache(), testCitySearchCacheTTL, stubLogger)
+	}
+
+	return newWeatherHandlerWithHistory(weatherService, newHistoryStore(), cityGeocoder)
+}
+
+func cityNames(resp *weather.SearchCitiesResponse) []string {
+	names := make([]string, 0, len(resp.Locations))
+	for _, location := range resp.Locations {
+		names = append(names, location.Name)
+	}
+	return names
+}
+
+func TestSearchCities_BundledDataset(t *testing.T) {
+	weatherHandler := setupCitySearchHandler(t, "")
+
+	tests := []struct {
+		name     string
+		req      *weather.SearchCitiesRequest
+		expected []string
+	}{
+		{
+			name:     "prefix of several names",
+			req:      &weather.SearchCitiesRequest{Prefix: "kh"},
+			expected: []string{"Kharkiv", "Kherson", "Khmelnytskyi"},
+		},
+		{
+			name:     "limit",
+			req:      &weather.SearchCitiesRequest{Prefix: "Kh", Limit: 2},
+			expected: []string{"Kharkiv", "Kherson"},
+		},
+		{
+			name:     "alias",
+			req:      &weather.SearchCitiesRequest{Prefix: "Харк"},
+			expected: []string{"Kharkiv"},
+		},
+		{
+			name:     "several aliases",
+			req:      &weather.SearchCitiesRequest{Prefix: "Ki"},
+			expected: []string{"Kropyvnytskyi", "Kyiv"},
+		},
+		{
+			name:     "names before aliases",
+			req:      &weather.SearchCitiesRequest{Prefix: "Ro"},
+			expected: []string{"Rome", "Rivne"},
+		},
+		{
+			name:     "no match",
+			req:      &weather.SearchCitiesRequest{Prefix: "Atlantis"},
+			expected: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := weatherHandler.SearchCities(context.Background(), tt.req)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, cityNames(resp))
+		})
+	}
+
+	resp, err := weatherHandler.SearchCities(context.Background(), &weather.SearchCitiesRequest{Prefix: "Kyiv"})
+	require.NoError(t, err)
+	require.Len(t, resp.Locations, 1)
+	assert.Equal(t, "kyiv-ua", resp.Locations[0].Id)
+	assert.Equal(t, "UA", resp.Locations[0].Country)
+	assert.NotNil(t, resp.Locations[0].Coordinates)
+}
+
+func TestSearchCities_GeocodingFallback(t *testing.T) {
+	geocoding := openmeteo.OpenMeteoGeocodingResponse{
+		Results: []openmeteo.OpenMeteoGeocodingResult{
+			{Name: "London", Country: "United Kingdom", CountryCode: "GB", Latitude: 51.5085, Longitude: -0.1257},
+			{Name: "London", Country: "Canada", CountryCode: "CA", Latitude: 42.9834, Longitude: -81.233},
+			{Name: "Londonderry", Country: "United Kingdom", CountryCode: "GB", Latitude: 54.9977, Longitude: -7.3087},
+		},
+	}
+	openMeteoMock := newOpenMeteoMockServer(t, http.StatusOK, testOpenMeteoWeatherResponse(), geocoding)
+	weatherHandler := setupCitySearchHandler(t, openMeteoMock.URL+openMeteoGeocodingPath)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := weatherHandler.SearchCities(ctx, &weather.SearchCitiesRequest{Prefix: "Lon"})
+	require.NoError(t, err)
+	require.Len(t, resp.Locations, 3)
+
+	assert.Equal(t, "london-gb", resp.Locations[0].Id, "bundled cities come first")
+	assert.Equal(t, "London", resp.Locations[1].Name)
+	assert.Equal(t, "CA", resp.Locations[1].Country)
+	assert.Equal(t, "london-ca-42.98,-81.23", resp.Locations[1].Id, "cities of the same name get their own IDs")
+	assert.Equal(t, "Londonderry", resp.Locations[2].Name)
+	require.NotNil(t, resp.Locations[2].Coordinates)
+	assert.Equal(t, 54.9977, resp.Locations[2].Coordinates.Latitude)
+
+	resp, err = weatherHandler.SearchCities(ctx, &weather.SearchCitiesRequest{Prefix: "lon", Limit: 2})
+	require.NoError(t, err)
+	assert.Len(t, resp.Locations, 2)
+	assert.Equal(t, int32(1), openMeteoMock.geocodingCalls.Load(), "geocoded prefixes are cached")
+
+	_, err = weatherHandler.SearchCities(ctx, &weather.SearchCitiesRequest{Prefix: "L"})
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), openMeteoMock.geocodingCalls.Load(), "single letters are not geocoded")
+
+	resp, err = weatherHandler.SearchCities(ctx, &weather.SearchCitiesRequest{Prefix: "Kyiv"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Kyiv"}, cityNames(resp)[:1])
+}
+
+func TestSearchCities_GeocodingFailureKeepsBundledCities(t *testing.T) {
+	geocodingMock := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	t.Cleanup(geocodingMock.Close)
+
+	weatherHandler := setupCitySearchHandler(t, geocodingMock.URL)
+
+	resp, err := weatherHandler.SearchCities(context.Background(), &weather.SearchCitiesRequest{Prefix: "Lon"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"London"}, cityNames(resp))
+}
+
+func TestSearchCities_InvalidRequest(t *testing.T) {
+	weatherHandler := setupCitySearchHandler(t, "")
+
+	tests := []struct {
+		name string
+		req  *weather.SearchCitiesRequest
+	}{
+		{name: "empty prefix", req: &weather.SearchCitiesRequest{Prefix: "  "}},
+		{name: "negative limit", req: &weather.SearchCitiesRequest{Prefix: "Kyiv", Limit: -1}},
+		{name: "limit too large", req: &weather.SearchCitiesRequest{Prefix: "Kyiv", Limit: usecases.MaxCitySearchLimit + 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := weatherHandler.SearchCities(context.Background(), tt.req)
+			require.Error(t, err)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+	}
+}
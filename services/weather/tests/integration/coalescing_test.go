@@ -0,0 +1,156 @@
+package integration
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+	"weather-forecast/pkg/proto/weather"
+	stub_logger "weather-forecast/pkg/stubs/logger"
+	"weather-service/internal/domain/usecases"
+	"weather-service/internal/infrastructure/locations"
+	"weather-service/internal/infrastructure/providers"
+	"weather-service/internal/presentation/server/handlers"
+	"weather-service/tests/integration/testutils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupCoalescingWeatherHandler(t *testing.T, metrics *testutils.InMemoryMetrics, weatherAPIURLMock string) *handlers.WeatherHandler {
+	t.Helper()
+	return setupCoalescingWeatherHandlerWithTimeout(t, metrics, weatherAPIURLMock, 0)
+}
+
+// setupCoalescingWeatherHandlerWithTimeout bounds the shared calls by timeout,
+// or by the timeout of the chain when it is zero.
+func setupCoalescingWeatherHandlerWithTimeout(t *testing.T, metrics *testutils.InMemoryMetrics, weatherAPIURLMock string, timeout time.Duration) *handlers.WeatherHandler {
+	t.Helper()
+	stubLogger := stub_logger.New()
+
+	cfg := newChainConfig(weatherAPIURLMock, "http://localhost", "weatherapi")
+	chainBuilder := providers.NewChainBuilder(cfg, testutils.NewInMemoryCache(), testutils.NewInMemoryCache(), newHistoryStore(), metrics, http.DefaultTransport, stubLogger)
+	weatherChain, err := chainBuilder.Build()
+	require.NoError(t, err)
+
+	if timeout == 0 {
+		timeout = chainBuilder.CallTimeout()
+	}
+
+	cityResolver, err := locations.NewResolver(stubLogger)
+	require.NoError(t, err)
+
+	coalescingProvider := providers.NewCoalescingProvider(weatherChain, timeout, metrics, stubLogger)
+	weatherService := usecases.NewWeatherService(coalescingProvider, cityResolver, stubLogger)
+	return newWeatherHandler(weatherService)
+}
+
+func TestCoalescing_SameCity(t *testing.T) {
+	const concurrentRequests = 10
+
+	metrics := testutils.NewInMemoryMetrics()
+	weatherAPIServerMock := newDelayedMockServer(t, testWeatherAPISuccessResponse(), http.StatusOK, 200*time.Millisecond)
+	weatherHandler := setupCoalescingWeatherHandler(t, metrics, weatherAPIServerMock.URL)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	responses := make([]*weather.GetWeatherResponse, concurrentRequests)
+	errs := make([]error, concurrentRequests)
+	for i := range concurrentRequests {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			responses[i], errs[i] = weatherHandler.GetWeather(ctx, &weather.GetWeatherRequest{Location: &weather.GetWeatherRequest_City{City: "Kyiv"}})
+		}()
+	}
+	wg.Wait()
+
+	for i := range concurrentRequests {
+		require.NoError(t, errs[i])
+		assertWeatherResponse(t, responses[i], testWeather)
+	}
+
+	assert.Equal(t, int32(1), weatherAPIServerMock.calls.Load())
+	assert.Equal(t, concurrentRequests-1, metrics.Coalesced())
+}
+
+func TestCoalescing_DifferentCities(t *testing.T) {
+	metrics := testutils.NewInMemoryMetrics()
+	weatherAPIServerMock := newDelayedMockServer(t, testWeatherAPISuccessResponse(), http.StatusOK, 100*time.Millisecond)
+	weatherHandler := setupCoalescingWeatherHandler(t, metrics, weatherAPIServerMock.URL)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	for _, city := range []string{"Kyiv", "Lviv"} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := weatherHandler.GetWeather(ctx, &weather.GetWeatherRequest{Location: &weather.GetWeatherRequest_City{City: city}})
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(2), weatherAPIServerMock.calls.Load())
+	assert.Equal(t, 0, metrics.Coalesced())
+}
+
+func TestCoalescing_CancelledCallerDoesNotFailOthers(t *testing.T) {
+	metrics := testutils.NewInMemoryMetrics()
+	weatherAPIServerMock := newDelayedMockServer(t, testWeatherAPISuccessResponse(), http.StatusOK, 200*time.Millisecond)
+	weatherHandler := setupCoalescingWeatherHandler(t, metrics, weatherAPIServerMock.URL)
+
+	impatientCtx, cancelImpatient := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancelImpatient()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		_, err := weatherHandler.GetWeather(impatientCtx, &weather.GetWeatherRequest{Location: &weather.GetWeatherRequest_City{City: "Kyiv"}})
+		assert.Error(t, err)
+	}()
+
+	time.Sleep(20 * time.Millisecond)
+
+	resp, err := weatherHandler.GetWeather(ctx, &weather.GetWeatherRequest{Location: &weather.GetWeatherRequest_City{City: "Kyiv"}})
+	require.NoError(t, err)
+	assertWeatherResponse(t, resp, testWeather)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), weatherAPIServerMock.calls.Load())
+	assert.Equal(t, int32(0), weatherAPIServerMock.cancelled.Load())
+}
+
+func TestCoalescing_SharedCallTimesOut(t *testing.T) {
+	metrics := testutils.NewInMemoryMetrics()
+	weatherAPIServerMock := newDelayedMockServer(t, testWeatherAPISuccessResponse(), http.StatusOK, time.Second)
+	weatherHandler := setupCoalescingWeatherHandlerWithTimeout(t, metrics, weatherAPIServerMock.URL, 100*time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	start := time.Now()
+
+	var wg sync.WaitGroup
+	for range 3 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := weatherHandler.GetWeather(ctx, &weather.GetWeatherRequest{Location: &weather.GetWeatherRequest_City{City: "Kyiv"}})
+			assert.Error(t, err)
+		}()
+	}
+	wg.Wait()
+
+	assert.Less(t, time.Since(start), 800*time.Millisecond, "followers are not held by a hung upstream call")
+	assert.Equal(t, int32(1), weatherAPIServerMock.calls.Load())
+}
@@ -0,0 +1,237 @@
+package integration
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+	"weather-forecast/pkg/proto/weather"
+	stub_logger "weather-forecast/pkg/stubs/logger"
+	"weather-service/internal/config"
+	"weather-service/internal/domain/models"
+	"weather-service/internal/infrastructure/clients/openmeteo"
+	"weather-service/internal/infrastructure/clients/openweather"
+	"weather-service/internal/infrastructure/providers"
+	"weather-service/tests/integration/testutils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testConsensusThreshold = 5
+
+type consensusReading struct {
+	temperature float64
+	humidity    int
+	description string
+}
+
+type consensusMocks struct {
+	weatherAPI  *MockServer
+	openWeather *MockServer
+	openMeteo   *OpenMeteoMockServer
+}
+
+func newConsensusMocks(t *testing.T, weatherAPI, openWeather consensusReading, openMeteo *consensusReading) *consensusMocks {
+	t.Helper()
+
+	weatherAPIResponse := testWeatherAPISuccessResponse()
+	weatherAPIResponse.Current.TempC = weatherAPI.temperature
+	weatherAPIResponse.Current.Humidity = weatherAPI.humidity
+	weatherAPIResponse.Current.Condition.Text = weatherAPI.description
+
+	openWeatherResponse := testOpenWeatherSuccessResponse()
+	openWeatherResponse.Main.Temperature = openWeather.temperature
+	openWeatherResponse.Main.Humidity = openWeather.humidity
+	openWeatherResponse.Weather[0].Description = openWeather.description
+
+	mocks := &consensusMocks{
+		weatherAPI:  newMockServer(t, weatherAPIResponse, http.StatusOK, "", true),
+		openWeather: newMockServer(t, openWeatherResponse, http.StatusOK, "", true),
+	}
+
+	if openMeteo != nil {
+		openMeteoResponse := testOpenMeteoWeatherResponse()
+		openMeteoResponse.Current.Temperature = openMeteo.temperature
+		openMeteoResponse.Current.RelativeHumidity = openMeteo.humidity
+		mocks.openMeteo = newOpenMeteoMockServer(t, http.StatusOK, openMeteoResponse, openmeteo.OpenMeteoGeocodingResponse{})
+	}
+
+	return mocks
+}
+
+func (m *consensusMocks) config(chain ...string) *config.Config {
+	cfg := newChainConfig(m.weatherAPI.URL, m.openWeather.URL, chain...)
+	cfg.ProviderStrategy = config.ConsensusStrategy
+	cfg.ConsensusTemperatureThreshold = testConsensusThreshold
+	if m.openMeteo != nil {
+		cfg.OpenMeteoURL = m.openMeteo.URL + openMeteoForecastPath
+		cfg.OpenMeteoGeocodingURL = m.openMeteo.URL + openMeteoGeocodingPath
+	}
+	return cfg
+}
+
+func TestConsensus_MergesProviders(t *testing.T) {
+	metrics := testutils.NewInMemoryMetrics()
+	mocks := newConsensusMocks(t,
+		consensusReading{temperature: 24, humidity: 70, description: "Light rain"},
+		consensusReading{temperature: 22.5, humidity: 64, description: "partly cloudy"},
+		&consensusReading{temperature: 21, humidity: 60},
+	)
+
+	cfg := mocks.config("weatherapi", "openweather", "openmeteo")
+	weatherHandler := setupWeatherHandlerFromConfig(t, cfg, testutils.NewInMemoryCache(), metrics)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := weatherHandler.GetWeather(ctx, &weather.GetWeatherRequest{Location: &weather.GetWeatherRequest_City{City: "Kyiv"}})
+	require.NoError(t, err)
+
+	assert.Equal(t, 22.5, resp.Temperature)
+	assert.Equal(t, int32(64), resp.Humidity)
+	assert.Equal(t, "partly cloudy", resp.Description, "description of the majority, as the first of it worded it")
+	assert.False(t, resp.Disputed)
+
+	expected := models.Weather{Temperature: 22.5, Humidity: 64}
+	expected.CalculateIndices(models.UnitsMetric)
+	assert.Equal(t, expected.DewPoint, resp.DewPoint, "indices follow the merged temperature and humidity")
+	assert.Equal(t, expected.HeatIndex, resp.HeatIndex)
+
+	assert.Equal(t, 1, mocks.weatherAPI.CallCount())
+	assert.Equal(t, 1, mocks.openWeather.CallCount())
+	assert.Equal(t, int32(1), mocks.openMeteo.forecastCalls.Load())
+
+	spreads, disputed := metrics.ConsensusStats()
+	assert.Equal(t, []float64{3}, spreads)
+	assert.Equal(t, 0, disputed)
+	assert.Equal(t, 1, metrics.ServedBy(providers.ConsensusProviderName, providers.WeatherOperation))
+}
+
+func TestConsensus_FlagsDisagreement(t *testing.T) {
+	metrics := testutils.NewInMemoryMetrics()
+	mocks := newConsensusMocks(t,
+		consensusReading{temperature: 22.5, humidity: 64, description: "Partly cloudy"},
+		consensusReading{temperature: 30.5, humidity: 40, description: "clear sky"},
+		nil,
+	)
+
+	cfg := mocks.config("weatherapi", "openweather")
+	weatherHandler := setupWeatherHandlerFromConfig(t, cfg, testutils.NewInMemoryCache(), metrics)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := weatherHandler.GetWeather(ctx, &weather.GetWeatherRequest{Location: &weather.GetWeatherRequest_City{City: "Kyiv"}})
+	require.NoError(t, err)
+
+	assert.Equal(t, 26.5, resp.Temperature)
+	assert.Equal(t, int32(52), resp.Humidity)
+	assert.Equal(t, "Partly cloudy", resp.Description, "a tie goes to the first provider in chain")
+	assert.True(t, resp.Disputed)
+
+	spreads, disputed := metrics.ConsensusStats()
+	assert.Equal(t, []float64{8}, spreads)
+	assert.Equal(t, 1, disputed)
+}
+
+func TestConsensus_DisagreementInImperialUnits(t *testing.T) {
+	metrics := testutils.NewInMemoryMetrics()
+	mocks := newConsensusMocks(t,
+		consensusReading{temperature: 20, humidity: 64, description: "Partly cloudy"},
+		consensusReading{temperature: 68, humidity: 64, description: "partly cloudy"},
+		nil,
+	)
+
+	cfg := mocks.config("weatherapi", "openweather")
+	weatherHandler := setupWeatherHandlerFromConfig(t, cfg, testutils.NewInMemoryCache(), metrics)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	// WeatherAPI reports in °C and is converted, OpenWeather is asked for °F.
+	resp, err := weatherHandler.GetWeather(ctx, &weather.GetWeatherRequest{Location: &weather.GetWeatherRequest_City{City: "Kyiv"}, Units: "imperial"})
+	require.NoError(t, err)
+
+	assert.Equal(t, 68.0, resp.Temperature)
+	assert.False(t, resp.Disputed)
+
+	spreads, _ := metrics.ConsensusStats()
+	require.Len(t, spreads, 1)
+	assert.InDelta(t, 0, spreads[0], 0.01)
+}
+
+func TestConsensus_SkipsOpenCircuit(t *testing.T) {
+	metrics := testutils.NewInMemoryMetrics()
+
+	openWeatherErrorResponse := openweather.OpenWeatherErrorResponse{
+		Cod:     "500",
+		Message: "internal server error",
+	}
+
+	weatherAPIServerMock := newMockServer(t, testWeatherAPISuccessResponse(), http.StatusOK, "", true)
+	openWeatherServerMock := newMockServer(t, openWeatherErrorResponse, http.StatusInternalServerError, "", true)
+
+	cfg := newChainConfig(weatherAPIServerMock.URL, openWeatherServerMock.URL, "weatherapi", "openweather")
+	cfg.ProviderStrategy = config.ConsensusStrategy
+	cfg.ConsensusTemperatureThreshold = testConsensusThreshold
+	cfg.CircuitBreakerFailureThreshold = 1
+	weatherHandler := setupWeatherHandlerFromConfig(t, cfg, testutils.NewInMemoryCache(), metrics)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	for range 2 {
+		resp, err := weatherHandler.GetWeather(ctx, &weather.GetWeatherRequest{Location: &weather.GetWeatherRequest_City{City: "Kyiv"}})
+		require.NoError(t, err)
+		assertWeatherResponse(t, resp, testWeather)
+		assert.False(t, resp.Disputed)
+	}
+
+	assert.Equal(t, 2, weatherAPIServerMock.CallCount())
+	assert.Equal(t, 1, openWeatherServerMock.CallCount(), "the open circuit keeps the provider out of consensus")
+	assert.Equal(t, providers.CircuitOpen, metrics.CircuitState(providers.OpenWeatherProviderName))
+
+	spreads, _ := metrics.ConsensusStats()
+	assert.Empty(t, spreads, "a single answer is not compared")
+}
+
+func TestConsensus_CachesMergedWeather(t *testing.T) {
+	metrics := testutils.NewInMemoryMetrics()
+	mocks := newConsensusMocks(t,
+		consensusReading{temperature: 24, humidity: 70, description: "Partly cloudy"},
+		consensusReading{temperature: 22.5, humidity: 64, description: "partly cloudy"},
+		&consensusReading{temperature: 21, humidity: 60},
+	)
+
+	cfg := mocks.config("cache", "weatherapi", "openweather", "openmeteo")
+	cfg.WeatherAPICacheTTL = 600
+	cfg.OpenWeatherCacheTTL = 300
+	cfg.OpenMeteoCacheTTL = 600
+	weatherHandler := setupWeatherHandlerFromConfig(t, cfg, testutils.NewInMemoryCache(), metrics)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	for range 2 {
+		resp, err := weatherHandler.GetWeather(ctx, &weather.GetWeatherRequest{Location: &weather.GetWeatherRequest_City{City: "Kyiv"}})
+		require.NoError(t, err)
+		assert.Equal(t, 22.5, resp.Temperature, "the merged weather is cached over the answers of the providers")
+		assert.Equal(t, int32(64), resp.Humidity)
+	}
+
+	assert.Equal(t, 1, mocks.weatherAPI.CallCount())
+	assert.Equal(t, 1, mocks.openWeather.CallCount())
+	assert.Equal(t, 1, metrics.ServedBy(providers.ConsensusProviderName, providers.WeatherOperation))
+	assert.Equal(t, 1, metrics.ServedBy(providers.CacheProviderName, providers.WeatherOperation))
+}
+
+func TestConsensus_RequiresTwoProviders(t *testing.T) {
+	cfg := newChainConfig("http://localhost", "http://localhost", "cache", "weatherapi")
+	cfg.ProviderStrategy = config.ConsensusStrategy
+	cfg.ConsensusTemperatureThreshold = testConsensusThreshold
+
+	chain, err := providers.NewChainBuilder(cfg, testutils.NewInMemoryCache(), testutils.NewInMemoryCache(), newHistoryStore(), testutils.NewInMemoryMetrics(), http.DefaultTransport, stub_logger.New()).Build()
+	require.Error(t, err)
+	assert.Nil(t, chain)
+}
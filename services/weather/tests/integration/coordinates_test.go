@@ -0,0 +1,124 @@
+package integration
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+	"weather-forecast/pkg/proto/weather"
+	"weather-service/tests/integration/testutils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func coordinatesRequest(latitude, longitude float64) *weather.GetWeatherRequest {
+	return &weather.GetWeatherRequest{Location: &weather.GetWeatherRequest_Coordinates{
+		Coordinates: &weather.Coordinates{Latitude: latitude, Longitude: longitude},
+	}}
+}
+
+func TestGetWeather_CoordinatesSnappedToGrid(t *testing.T) {
+	query := url.Values{}
+	query.Set("key", testAPIKey)
+	query.Set("q", "50.45,30.52")
+	query.Set("lang", "en")
+	weatherAPIServerMock := newMockServer(t, testWeatherAPISuccessResponse(), http.StatusOK, query.Encode(), true)
+	openWeatherServerMock := newMockServer(t, nil, 0, "", false)
+
+	cacher := testutils.NewInMemoryCache()
+	weatherHandler := setupWeatherHandlerWithCache(cacher, testutils.NewInMemoryMetrics(), weatherAPIServerMock.URL, openWeatherServerMock.URL)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := weatherHandler.GetWeather(ctx, coordinatesRequest(50.4501, 30.5234))
+	require.NoError(t, err)
+	assertWeatherResponse(t, resp, testWeather)
+
+	resp, err = weatherHandler.GetWeather(ctx, coordinatesRequest(50.4549, 30.5249))
+	require.NoError(t, err)
+	assertWeatherResponse(t, resp, testWeather)
+
+	assert.Equal(t, 1, weatherAPIServerMock.CallCount(), "points of one grid cell share the cached weather")
+
+	keys, err := cacher.Keys(ctx, "weather:*")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"weather:50.45,30.52:metric:en"}, keys)
+}
+
+func TestGetWeather_CoordinatesPassedToOpenWeather(t *testing.T) {
+	weatherAPIQuery := url.Values{}
+	weatherAPIQuery.Set("key", testAPIKey)
+	weatherAPIQuery.Set("q", "-33.87,151.21")
+	weatherAPIQuery.Set("lang", "en")
+	weatherAPIServerMock := newMockServer(t, weatherAPIInternalErrorResponse, http.StatusBadRequest, weatherAPIQuery.Encode(), true)
+
+	query := url.Values{}
+	query.Set("appid", testAPIKey)
+	query.Set("units", "metric")
+	query.Set("lat", "-33.87")
+	query.Set("lon", "151.21")
+	query.Set("lang", "en")
+	openWeatherServerMock := newMockServer(t, testOpenWeatherSuccessResponse(), http.StatusOK, query.Encode(), true)
+
+	weatherHandler := setupWeatherHandler(weatherAPIServerMock.URL, openWeatherServerMock.URL)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := weatherHandler.GetWeather(ctx, coordinatesRequest(-33.8688, 151.2093))
+	require.NoError(t, err)
+	assertWeatherResponse(t, resp, testWeather)
+}
+
+func TestGetWeather_CoordinatesNearZero(t *testing.T) {
+	query := url.Values{}
+	query.Set("key", testAPIKey)
+	query.Set("q", "0,0")
+	query.Set("lang", "en")
+	weatherAPIServerMock := newMockServer(t, testWeatherAPISuccessResponse(), http.StatusOK, query.Encode(), true)
+	openWeatherServerMock := newMockServer(t, nil, 0, "", false)
+
+	cacher := testutils.NewInMemoryCache()
+	weatherHandler := setupWeatherHandlerWithCache(cacher, testutils.NewInMemoryMetrics(), weatherAPIServerMock.URL, openWeatherServerMock.URL)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := weatherHandler.GetWeather(ctx, coordinatesRequest(-0.001, 0.004))
+	require.NoError(t, err)
+
+	keys, err := cacher.Keys(ctx, "weather:*")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"weather:0.00,0.00:metric:en"}, keys)
+}
+
+func TestGetWeather_InvalidCoordinates(t *testing.T) {
+	weatherAPIServerMock := newMockServer(t, nil, 0, "", false)
+	openWeatherServerMock := newMockServer(t, nil, 0, "", false)
+
+	weatherHandler := setupWeatherHandler(weatherAPIServerMock.URL, openWeatherServerMock.URL)
+
+	tests := []struct {
+		name      string
+		latitude  float64
+		longitude float64
+	}{
+		{name: "latitude above range", latitude: 90.1, longitude: 30},
+		{name: "latitude below range", latitude: -91, longitude: 30},
+		{name: "longitude above range", latitude: 50, longitude: 180.5},
+		{name: "longitude below range", latitude: 50, longitude: -200},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := weatherHandler.GetWeather(context.Background(), coordinatesRequest(tt.latitude, tt.longitude))
+			require.Error(t, err)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+	}
+}
@@ -0,0 +1,179 @@
+package integration
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+	"weather-forecast/pkg/proto/weather"
+	stub_logger "weather-forecast/pkg/stubs/logger"
+	"weather-service/internal/config"
+	"weather-service/tests/fakeweather"
+	"weather-service/tests/integration/testutils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const bundledFixtures = "../fakeweather/fixtures"
+
+func newFakeWeather(t *testing.T, fixturesDir string, chain ...string) (*fakeweather.Server, *fakeweather.Script, *config.Config) {
+	t.Helper()
+
+	script := fakeweather.NewScript()
+	fake := fakeweather.NewServer(fakeweather.NewFixtures(fixturesDir), script, stub_logger.New())
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+
+	cfg := newChainConfig("", "", chain...)
+	fakeweather.Configure(cfg, server.URL)
+
+	return fake, script, cfg
+}
+
+func weatherAPILocation(t *testing.T, city string) string {
+	t.Helper()
+	return weatherAPIQuery(t, city).Get("q")
+}
+
+func TestFakeWeather_ServesBundledFixtures(t *testing.T) {
+	fake, _, cfg := newFakeWeather(t, bundledFixtures, "weatherapi")
+	weatherHandler := setupWeatherHandlerFromConfig(t, cfg, testutils.NewInMemoryCache(), testutils.NewInMemoryMetrics())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := weatherHandler.GetWeather(ctx, &weather.GetWeatherRequest{Location: &weather.GetWeatherRequest_City{City: "Kyiv"}})
+	require.NoError(t, err)
+	assert.Equal(t, 18.4, resp.Temperature)
+	assert.Equal(t, "Partly cloudy", resp.Description)
+
+	forecast, err := weatherHandler.GetForecast(ctx, &weather.GetForecastRequest{City: "Kyiv", Days: 3})
+	require.NoError(t, err)
+	assert.Len(t, forecast.Days, 3)
+
+	assert.Equal(t, 1, fake.Calls(fakeweather.WeatherAPI, "current"))
+	assert.Equal(t, 1, fake.Calls(fakeweather.WeatherAPI, "forecast"))
+}
+
+func TestFakeWeather_ServesCityFixture(t *testing.T) {
+	fixturesDir := t.TempDir()
+	fixtures := fakeweather.NewFixtures(fixturesDir)
+	body, err := json.Marshal(testWeatherAPISuccessResponse())
+	require.NoError(t, err)
+	require.NoError(t, fixtures.Save(fakeweather.WeatherAPI, "current", weatherAPILocation(t, "Kyiv"), body))
+
+	_, _, cfg := newFakeWeather(t, fixturesDir, "weatherapi")
+	weatherHandler := setupWeatherHandlerFromConfig(t, cfg, testutils.NewInMemoryCache(), testutils.NewInMemoryMetrics())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := weatherHandler.GetWeather(ctx, &weather.GetWeatherRequest{Location: &weather.GetWeatherRequest_City{City: "Kyiv"}})
+	require.NoError(t, err)
+	assertWeatherResponse(t, resp, testWeather)
+
+	_, err = weatherHandler.GetWeather(ctx, &weather.GetWeatherRequest{Location: &weather.GetWeatherRequest_City{City: "Lviv"}})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestFakeWeather_ScriptedFailures(t *testing.T) {
+	tests := []struct {
+		name         string
+		failures     []fakeweather.Failure
+		expectedCode codes.Code
+		expectedTemp float64
+	}{
+		{
+			name:         "server error falls back to OpenWeather",
+			failures:     []fakeweather.Failure{{Provider: fakeweather.WeatherAPI, Kind: fakeweather.FailureServerError}},
+			expectedCode: codes.OK,
+			expectedTemp: 18.1,
+		},
+		{
+			name: "city not found everywhere",
+			failures: []fakeweather.Failure{
+				{Provider: fakeweather.WeatherAPI, Kind: fakeweather.FailureNotFound},
+				{Provider: fakeweather.OpenWeather, Kind: fakeweather.FailureNotFound},
+			},
+			expectedCode: codes.NotFound,
+		},
+		{
+			name:         "failure limited to one request",
+			failures:     []fakeweather.Failure{{Kind: fakeweather.FailureServerError, Times: 1}},
+			expectedCode: codes.OK,
+			expectedTemp: 18.1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, script, cfg := newFakeWeather(t, bundledFixtures, "weatherapi", "openweather")
+			script.Add(tt.failures...)
+			weatherHandler := setupWeatherHandlerFromConfig(t, cfg, testutils.NewInMemoryCache(), testutils.NewInMemoryMetrics())
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			resp, err := weatherHandler.GetWeather(ctx, &weather.GetWeatherRequest{Location: &weather.GetWeatherRequest_City{City: "Kyiv"}})
+			require.Equal(t, tt.expectedCode, status.Code(err))
+			if tt.expectedCode == codes.OK {
+				assert.Equal(t, tt.expectedTemp, resp.Temperature)
+			}
+		})
+	}
+}
+
+func TestFakeWeather_SlowProviderIsHedged(t *testing.T) {
+	_, script, cfg := newFakeWeather(t, bundledFixtures, "weatherapi", "openweather")
+	cfg.ProviderStrategy = config.HedgedStrategy
+	cfg.HedgeDelayMs = testHedgeDelayMs
+	script.Add(fakeweather.Failure{Provider: fakeweather.WeatherAPI, Kind: fakeweather.FailureSlow, DelayMs: 500})
+
+	metrics := testutils.NewInMemoryMetrics()
+	weatherHandler := setupWeatherHandlerFromConfig(t, cfg, testutils.NewInMemoryCache(), metrics)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := weatherHandler.GetWeather(ctx, &weather.GetWeatherRequest{Location: &weather.GetWeatherRequest_City{City: "Kyiv"}})
+	require.NoError(t, err)
+	assert.Equal(t, 18.1, resp.Temperature)
+
+	_, wins := metrics.HedgeStats()
+	assert.Equal(t, 1, wins["openweather"])
+}
+
+func TestFakeWeather_RecordsFixtures(t *testing.T) {
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/current.json", r.URL.Path)
+		assert.Equal(t, "realKey", r.URL.Query().Get("key"))
+		w.WriteHeader(http.StatusOK)
+		require.NoError(t, json.NewEncoder(w).Encode(testWeatherAPISuccessResponse()))
+	}))
+	t.Cleanup(upstream.Close)
+
+	fixturesDir := t.TempDir()
+	recorder, _, cfg := newFakeWeather(t, fixturesDir, "weatherapi")
+	recorder.Record(fakeweather.WeatherAPI, fakeweather.Upstream{BaseURL: upstream.URL, Key: "realKey"})
+	weatherHandler := setupWeatherHandlerFromConfig(t, cfg, testutils.NewInMemoryCache(), testutils.NewInMemoryMetrics())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := weatherHandler.GetWeather(ctx, &weather.GetWeatherRequest{Location: &weather.GetWeatherRequest_City{City: "Kyiv"}})
+	require.NoError(t, err)
+	assertWeatherResponse(t, resp, testWeather)
+
+	_, _, replayCfg := newFakeWeather(t, fixturesDir, "weatherapi")
+	upstream.Close()
+	replayHandler := setupWeatherHandlerFromConfig(t, replayCfg, testutils.NewInMemoryCache(), testutils.NewInMemoryMetrics())
+
+	resp, err = replayHandler.GetWeather(ctx, &weather.GetWeatherRequest{Location: &weather.GetWeatherRequest_City{City: "Kyiv"}})
+	require.NoError(t, err)
+	assertWeatherResponse(t, resp, testWeather)
+}
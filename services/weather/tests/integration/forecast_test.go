@@ -0,0 +1,156 @@
+package integration
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+	"weather-forecast/pkg/proto/weather"
+	"weather-service/internal/infrastructure/clients/openweather"
+	"weather-service/internal/infrastructure/clients/weatherapi"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	testForecast = []*weather.DailyForecast{
+		{
+			Date:                "2025-07-01",
+			MinTemperature:      16.2,
+			MaxTemperature:      27.4,
+			PrecipitationChance: 40,
+			Condition:           "Patchy rain possible",
+		},
+		{
+			Date:                "2025-07-02",
+			MinTemperature:      14.8,
+			MaxTemperature:      24.1,
+			PrecipitationChance: 0,
+			Condition:           "Sunny",
+		},
+	}
+)
+
+func TestGetForecast_Success(t *testing.T) {
+	city := "Kyiv"
+	days := 2
+
+	weatherAPIForecastResponse := weatherapi.WeatherForecastResponse{
+		Forecast: weatherapi.WeatherForecastDaysResponse{
+			ForecastDay: []weatherapi.WeatherForecastDayResponse{
+				{
+					Date: testForecast[0].Date,
+					Day: weatherapi.WeatherForecastDayDetailsResponse{
+						MinTempC:          testForecast[0].MinTemperature,
+						MaxTempC:          testForecast[0].MaxTemperature,
+						DailyChanceOfRain: int(testForecast[0].PrecipitationChance),
+						Condition:         weatherapi.WeatherConditionResponse{Text: testForecast[0].Condition},
+					},
+				},
+				{
+					Date: testForecast[1].Date,
+					Day: weatherapi.WeatherForecastDayDetailsResponse{
+						MinTempC:  testForecast[1].MinTemperature,
+						MaxTempC:  testForecast[1].MaxTemperature,
+						Condition: weatherapi.WeatherConditionResponse{Text: testForecast[1].Condition},
+					},
+				},
+			},
+		},
+	}
+
+	weatherAPIServerMock := setupWeatherAPIForecastMock(t, weatherAPIForecastResponse, http.StatusOK, city, days)
+	openWeatherServerMock := setupOpenWeatherForecastMock(t, nil, 0, "", 0, false)
+
+	weatherHandler := setupWeatherHandler(weatherAPIServerMock.URL, openWeatherServerMock.URL)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := weatherHandler.GetForecast(ctx, &weather.GetForecastRequest{City: city, Days: int32(days)})
+	require.NoError(t, err)
+
+	assertForecastResponse(t, resp, testForecast)
+}
+
+func TestGetForecast_WeatherAPI_Failed(t *testing.T) {
+	city := "Kyiv"
+	days := 2
+
+	firstDay := time.Date(2025, time.July, 1, 0, 0, 0, 0, time.UTC)
+	secondDay := firstDay.AddDate(0, 0, 1)
+
+	openWeatherForecastResponse := openweather.OpenWeatherForecastResponse{
+		List: []openweather.OpenWeatherForecastItemResponse{
+			{
+				Timestamp:                firstDay.Add(6 * time.Hour).Unix(),
+				Main:                     openweather.OpenWeatherForecastMainResponse{TempMin: 16.2, TempMax: 18},
+				Weather:                  []openweather.OpenWeatherDescriptionResponse{{Description: "light rain"}},
+				PrecipitationProbability: 0.4,
+			},
+			{
+				Timestamp:                firstDay.Add(12 * time.Hour).Unix(),
+				Main:                     openweather.OpenWeatherForecastMainResponse{TempMin: 25, TempMax: 27.4},
+				Weather:                  []openweather.OpenWeatherDescriptionResponse{{Description: "Patchy rain possible"}},
+				PrecipitationProbability: 0.2,
+			},
+			{
+				Timestamp:                firstDay.Add(18 * time.Hour).Unix(),
+				Main:                     openweather.OpenWeatherForecastMainResponse{TempMin: 21, TempMax: 22},
+				Weather:                  []openweather.OpenWeatherDescriptionResponse{{Description: "Patchy rain possible"}},
+				PrecipitationProbability: 0.1,
+			},
+			{
+				Timestamp: secondDay.Add(9 * time.Hour).Unix(),
+				Main:      openweather.OpenWeatherForecastMainResponse{TempMin: 14.8, TempMax: 20},
+				Weather:   []openweather.OpenWeatherDescriptionResponse{{Description: "Sunny"}},
+			},
+			{
+				Timestamp: secondDay.Add(15 * time.Hour).Unix(),
+				Main:      openweather.OpenWeatherForecastMainResponse{TempMin: 22, TempMax: 24.1},
+				Weather:   []openweather.OpenWeatherDescriptionResponse{{Description: "Sunny"}},
+			},
+		},
+	}
+
+	weatherAPIErrorResponse := weatherapi.WeatherErrorResponse{
+		Error: weatherapi.WeatherErrorDetails{
+			Code:    9999,
+			Message: "Internal application error.",
+		},
+	}
+
+	weatherAPIServerMock := setupWeatherAPIForecastMock(t, weatherAPIErrorResponse, http.StatusBadRequest, city, days)
+	openWeatherServerMock := setupOpenWeatherForecastMock(t, openWeatherForecastResponse, http.StatusOK, city, days, true)
+
+	weatherHandler := setupWeatherHandler(weatherAPIServerMock.URL, openWeatherServerMock.URL)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := weatherHandler.GetForecast(ctx, &weather.GetForecastRequest{City: city, Days: int32(days)})
+	require.NoError(t, err)
+
+	assertForecastResponse(t, resp, testForecast)
+}
+
+func TestGetForecast_InvalidDays(t *testing.T) {
+	weatherAPIServerMock := newMockServer(t, nil, 0, "", false)
+	openWeatherServerMock := newMockServer(t, nil, 0, "", false)
+
+	weatherHandler := setupWeatherHandler(weatherAPIServerMock.URL, openWeatherServerMock.URL)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := weatherHandler.GetForecast(ctx, &weather.GetForecastRequest{City: "Kyiv", Days: 0})
+	require.Error(t, err)
+	assert.Nil(t, resp)
+
+	grpcStatus, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, grpcStatus.Code())
+}
@@ -0,0 +1,138 @@
+package integration
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+	"weather-forecast/pkg/proto/weather"
+	stub_logger "weather-forecast/pkg/stubs/logger"
+	"weather-service/internal/config"
+	"weather-service/internal/infrastructure/providers"
+	"weather-service/tests/integration/testutils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testHedgeDelayMs = 50
+
+type DelayedMockServer struct {
+	*httptest.Server
+	calls     atomic.Int32
+	cancelled atomic.Int32
+}
+
+func newDelayedMockServer(t *testing.T, responseBody interface{}, statusCode int, delay time.Duration) *DelayedMockServer {
+	t.Helper()
+
+	mock := &DelayedMockServer{}
+	mock.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mock.calls.Add(1)
+
+		select {
+		case <-time.After(delay):
+		case <-r.Context().Done():
+			mock.cancelled.Add(1)
+			return
+		}
+
+		w.WriteHeader(statusCode)
+		require.NoError(t, json.NewEncoder(w).Encode(responseBody))
+	}))
+	t.Cleanup(mock.Close)
+
+	return mock
+}
+
+func newHedgedChainConfig(weatherAPIURLMock, openWeatherURLMock string) *config.Config {
+	cfg := newChainConfig(weatherAPIURLMock, openWeatherURLMock, "weatherapi", "openweather")
+	cfg.ProviderStrategy = config.HedgedStrategy
+	cfg.HedgeDelayMs = testHedgeDelayMs
+	return cfg
+}
+
+func TestHedged_SlowPrimary(t *testing.T) {
+	metrics := testutils.NewInMemoryMetrics()
+
+	weatherAPIServerMock := newDelayedMockServer(t, testWeatherAPISuccessResponse(), http.StatusOK, 2*time.Second)
+	openWeatherServerMock := newDelayedMockServer(t, testOpenWeatherSuccessResponse(), http.StatusOK, 0)
+
+	cfg := newHedgedChainConfig(weatherAPIServerMock.URL, openWeatherServerMock.URL)
+	weatherHandler := setupWeatherHandlerFromConfig(t, cfg, testutils.NewInMemoryCache(), metrics)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	start := time.Now()
+	resp, err := weatherHandler.GetWeather(ctx, &weather.GetWeatherRequest{Location: &weather.GetWeatherRequest_City{City: "Kyiv"}})
+	require.NoError(t, err)
+	assertWeatherResponse(t, resp, testWeather)
+	assert.Less(t, time.Since(start), time.Second)
+
+	hedgeFired, hedgeWins := metrics.HedgeStats()
+	assert.Equal(t, 1, hedgeFired)
+	assert.Equal(t, map[string]int{providers.OpenWeatherProviderName: 1}, hedgeWins)
+
+	assert.Eventually(t, func() bool {
+		return weatherAPIServerMock.cancelled.Load() == 1
+	}, time.Second, 10*time.Millisecond)
+	assert.Equal(t, providers.CircuitClosed, metrics.CircuitState(providers.WeatherAPIProviderName))
+}
+
+func TestHedged_FastPrimary(t *testing.T) {
+	metrics := testutils.NewInMemoryMetrics()
+
+	weatherAPIServerMock := newDelayedMockServer(t, testWeatherAPISuccessResponse(), http.StatusOK, 0)
+	openWeatherServerMock := newDelayedMockServer(t, testOpenWeatherSuccessResponse(), http.StatusOK, 0)
+
+	cfg := newHedgedChainConfig(weatherAPIServerMock.URL, openWeatherServerMock.URL)
+	weatherHandler := setupWeatherHandlerFromConfig(t, cfg, testutils.NewInMemoryCache(), metrics)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := weatherHandler.GetWeather(ctx, &weather.GetWeatherRequest{Location: &weather.GetWeatherRequest_City{City: "Kyiv"}})
+	require.NoError(t, err)
+	assertWeatherResponse(t, resp, testWeather)
+
+	hedgeFired, hedgeWins := metrics.HedgeStats()
+	assert.Equal(t, 0, hedgeFired)
+	assert.Equal(t, map[string]int{providers.WeatherAPIProviderName: 1}, hedgeWins)
+	assert.Equal(t, int32(0), openWeatherServerMock.calls.Load())
+}
+
+func TestHedged_PrimaryFailsBeforeDelay(t *testing.T) {
+	metrics := testutils.NewInMemoryMetrics()
+
+	weatherAPIServerMock := newDelayedMockServer(t, weatherAPIInternalErrorResponse, http.StatusBadRequest, 0)
+	openWeatherServerMock := newDelayedMockServer(t, testOpenWeatherSuccessResponse(), http.StatusOK, 0)
+
+	cfg := newHedgedChainConfig(weatherAPIServerMock.URL, openWeatherServerMock.URL)
+	weatherHandler := setupWeatherHandlerFromConfig(t, cfg, testutils.NewInMemoryCache(), metrics)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := weatherHandler.GetWeather(ctx, &weather.GetWeatherRequest{Location: &weather.GetWeatherRequest_City{City: "Kyiv"}})
+	require.NoError(t, err)
+	assertWeatherResponse(t, resp, testWeather)
+
+	hedgeFired, hedgeWins := metrics.HedgeStats()
+	assert.Equal(t, 1, hedgeFired, "the secondary launched early is a hedge too")
+	assert.Equal(t, map[string]int{providers.OpenWeatherProviderName: 1}, hedgeWins)
+	assert.Equal(t, int32(1), openWeatherServerMock.calls.Load())
+}
+
+func TestHedged_RequiresTwoProviders(t *testing.T) {
+	cfg := newChainConfig("http://localhost", "http://localhost", "cache", "weatherapi")
+	cfg.ProviderStrategy = config.HedgedStrategy
+	cfg.HedgeDelayMs = testHedgeDelayMs
+
+	chain, err := providers.NewChainBuilder(cfg, testutils.NewInMemoryCache(), testutils.NewInMemoryCache(), newHistoryStore(), testutils.NewInMemoryMetrics(), http.DefaultTransport, stub_logger.New()).Build()
+	require.Error(t, err)
+	assert.Nil(t, chain)
+}
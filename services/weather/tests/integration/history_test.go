@@ -0,0 +1,212 @@
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+	"weather-forecast/pkg/proto/weather"
+	"weather-service/internal/domain/models"
+	"weather-service/internal/infrastructure/providers"
+	"weather-service/tests/fakeweather"
+	"weather-service/tests/integration/testutils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestHistory_RecordsFetchedObservations(t *testing.T) {
+	_, script, cfg := newFakeWeather(t, bundledFixtures, "weatherapi", "openweather")
+	cfg.HistoryRetentionDays = 1
+
+	weatherHandler := setupWeatherHandlerWithHistory(t, cfg, testutils.NewInMemoryCache(), testutils.NewInMemoryCache(), newHistoryStore(), testutils.NewInMemoryMetrics())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := weatherHandler.GetWeather(ctx, &weather.GetWeatherRequest{Location: &weather.GetWeatherRequest_City{City: "Kyiv"}})
+	require.NoError(t, err)
+	_, err = weatherHandler.GetWeather(ctx, &weather.GetWeatherRequest{Location: &weather.GetWeatherRequest_City{City: "Kyiv"}, Units: string(models.UnitsImperial)})
+	require.NoError(t, err)
+
+	script.Add(fakeweather.Failure{Provider: fakeweather.WeatherAPI, Kind: fakeweather.FailureServerError, Times: 1})
+	_, err = weatherHandler.GetWeather(ctx, &weather.GetWeatherRequest{Location: &weather.GetWeatherRequest_City{City: "Kyiv"}})
+	require.NoError(t, err)
+
+	resp, err := weatherHandler.GetHistory(ctx, &weather.GetHistoryRequest{City: "Kyiv"})
+	require.NoError(t, err)
+	require.Len(t, resp.Points, 3)
+
+	assert.Equal(t, providers.WeatherAPIProviderName, resp.Points[0].Provider)
+	assert.Equal(t, fakeWeatherAPITemperature, resp.Points[0].Temperature)
+	assert.Equal(t, "Partly cloudy", resp.Points[0].Description)
+	assert.Equal(t, int32(1), resp.Points[0].Samples)
+
+	assert.Equal(t, providers.WeatherAPIProviderName, resp.Points[1].Provider)
+	assert.Equal(t, fakeWeatherAPITemperature, resp.Points[1].Temperature, "observations are stored in metric units")
+
+	assert.Equal(t, providers.OpenWeatherProviderName, resp.Points[2].Provider)
+	assert.Equal(t, fakeOpenWeatherTemperature, resp.Points[2].Temperature)
+
+	imperial, err := weatherHandler.GetHistory(ctx, &weather.GetHistoryRequest{City: "Kyiv", Units: string(models.UnitsImperial)})
+	require.NoError(t, err)
+	require.Len(t, imperial.Points, 3)
+	assert.Equal(t, models.UnitsImperial.FromCelsius(fakeWeatherAPITemperature), imperial.Points[0].Temperature)
+}
+
+func TestHistory_SkipsCachedAnswers(t *testing.T) {
+	fake, _, cfg := newFakeWeather(t, bundledFixtures, "cache", "weatherapi")
+	cfg.HistoryRetentionDays = 1
+	cfg.WeatherAPICacheTTL = 600
+
+	weatherHandler := setupWeatherHandlerWithHistory(t, cfg, testutils.NewInMemoryCache(), testutils.NewInMemoryCache(), newHistoryStore(), testutils.NewInMemoryMetrics())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	for range 3 {
+		_, err := weatherHandler.GetWeather(ctx, &weather.GetWeatherRequest{Location: &weather.GetWeatherRequest_City{City: "Kyiv"}})
+		require.NoError(t, err)
+	}
+
+	resp, err := weatherHandler.GetHistory(ctx, &weather.GetHistoryRequest{City: "Kyiv"})
+	require.NoError(t, err)
+	assert.Len(t, resp.Points, 1)
+	assert.Equal(t, 1, fake.Calls(fakeweather.WeatherAPI, "current"))
+}
+
+func TestHistory_DisabledRetentionRecordsNothing(t *testing.T) {
+	_, _, cfg := newFakeWeather(t, bundledFixtures, "weatherapi")
+
+	weatherHandler := setupWeatherHandlerWithHistory(t, cfg, testutils.NewInMemoryCache(), testutils.NewInMemoryCache(), newHistoryStore(), testutils.NewInMemoryMetrics())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := weatherHandler.GetWeather(ctx, &weather.GetWeatherRequest{Location: &weather.GetWeatherRequest_City{City: "Kyiv"}})
+	require.NoError(t, err)
+
+	resp, err := weatherHandler.GetHistory(ctx, &weather.GetHistoryRequest{City: "Kyiv"})
+	require.NoError(t, err)
+	assert.Empty(t, resp.Points)
+}
+
+func TestHistory_Aggregation(t *testing.T) {
+	historyStore := newHistoryStore()
+	cfg := newChainConfig("http://localhost", "http://localhost", "weatherapi")
+	weatherHandler := setupWeatherHandlerWithHistory(t, cfg, testutils.NewInMemoryCache(), testutils.NewInMemoryCache(), historyStore, testutils.NewInMemoryMetrics())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	location, err := weatherHandler.ResolveCity(ctx, &weather.ResolveCityRequest{City: "Kyiv"})
+	require.NoError(t, err)
+	locationID := location.Location.Id
+
+	yesterday := time.Now().UTC().Truncate(24 * time.Hour).Add(-24 * time.Hour)
+	observations := []models.Observation{
+		{Provider: "weatherapi", ObservedAt: yesterday.Add(9 * time.Hour), Temperature: 10, Humidity: 80, Description: "Rain", WindSpeed: 10, Pressure: 1000},
+		{Provider: "openweather", ObservedAt: yesterday.Add(9*time.Hour + 30*time.Minute), Temperature: 12, Humidity: 70, Description: "Cloudy", WindSpeed: 20, Pressure: 1010},
+		{Provider: "weatherapi", ObservedAt: yesterday.Add(15 * time.Hour), Temperature: 17, Humidity: 60, Description: "Cloudy", WindSpeed: 30, Pressure: 1020},
+		{Provider: "weatherapi", ObservedAt: yesterday.Add(33 * time.Hour), Temperature: 20, Humidity: 50, Description: "Sunny", WindSpeed: 5, Pressure: 1015},
+	}
+	for _, observation := range observations {
+		observation.LocationID = locationID
+		require.NoError(t, historyStore.Append(ctx, observation))
+	}
+
+	from := yesterday.Unix()
+	to := yesterday.Add(48 * time.Hour).Unix()
+
+	t.Run("hourly", func(t *testing.T) {
+		resp, err := weatherHandler.GetHistory(ctx, &weather.GetHistoryRequest{City: "Kyiv", From: from, To: to, Aggregation: "hourly"})
+		require.NoError(t, err)
+		require.Len(t, resp.Points, 3)
+
+		first := resp.Points[0]
+		assert.Equal(t, yesterday.Add(9*time.Hour).Unix(), first.Timestamp)
+		assert.Empty(t, first.Provider)
+		assert.Equal(t, 11.0, first.Temperature)
+		assert.Equal(t, 10.0, first.MinTemperature)
+		assert.Equal(t, 12.0, first.MaxTemperature)
+		assert.Equal(t, int32(75), first.Humidity)
+		assert.Equal(t, 15.0, first.WindSpeed)
+		assert.Equal(t, 1005.0, first.Pressure)
+		assert.Equal(t, int32(2), first.Samples)
+
+		assert.Equal(t, providers.WeatherAPIProviderName, resp.Points[1].Provider)
+		assert.Equal(t, int32(1), resp.Points[1].Samples)
+	})
+
+	t.Run("daily", func(t *testing.T) {
+		resp, err := weatherHandler.GetHistory(ctx, &weather.GetHistoryRequest{City: "Kyiv", From: from, To: to, Aggregation: "daily"})
+		require.NoError(t, err)
+		require.Len(t, resp.Points, 2)
+
+		first := resp.Points[0]
+		assert.Equal(t, yesterday.Unix(), first.Timestamp)
+		assert.Equal(t, 13.0, first.Temperature)
+		assert.Equal(t, 10.0, first.MinTemperature)
+		assert.Equal(t, 17.0, first.MaxTemperature)
+		assert.Equal(t, "Cloudy", first.Description)
+		assert.Equal(t, int32(3), first.Samples)
+
+		second := resp.Points[1]
+		assert.Equal(t, yesterday.Add(24*time.Hour).Unix(), second.Timestamp)
+		assert.Equal(t, 20.0, second.Temperature)
+		assert.Equal(t, "Sunny", second.Description)
+	})
+
+	t.Run("range", func(t *testing.T) {
+		resp, err := weatherHandler.GetHistory(ctx, &weather.GetHistoryRequest{City: "Kyiv", From: from, To: yesterday.Add(12 * time.Hour).Unix()})
+		require.NoError(t, err)
+		assert.Len(t, resp.Points, 2)
+	})
+}
+
+func TestHistory_InvalidRequest(t *testing.T) {
+	cfg := newChainConfig("http://localhost", "http://localhost", "weatherapi")
+	weatherHandler := setupWeatherHandlerFromConfig(t, cfg, testutils.NewInMemoryCache(), testutils.NewInMemoryMetrics())
+
+	now := time.Now()
+	tests := []struct {
+		name string
+		req  *weather.GetHistoryRequest
+		code codes.Code
+	}{
+		{
+			name: "empty city",
+			req:  &weather.GetHistoryRequest{},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "unknown aggregation",
+			req:  &weather.GetHistoryRequest{City: "Kyiv", Aggregation: "weekly"},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "unknown units",
+			req:  &weather.GetHistoryRequest{City: "Kyiv", Units: "kelvin"},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "reversed range",
+			req:  &weather.GetHistoryRequest{City: "Kyiv", From: now.Unix(), To: now.Add(-time.Hour).Unix()},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "range too long",
+			req:  &weather.GetHistoryRequest{City: "Kyiv", From: now.Add(-40 * 24 * time.Hour).Unix(), To: now.Unix()},
+			code: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := weatherHandler.GetHistory(context.Background(), tt.req)
+			require.Error(t, err)
+			assert.Equal(t, tt.code, status.Code(err))
+		})
+	}
+}
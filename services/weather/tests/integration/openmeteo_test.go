@@ -0,0 +1,212 @@
+package integration
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+	"weather-forecast/pkg/proto/weather"
+	"weather-service/internal/config"
+	"weather-service/internal/infrastructure/clients/openmeteo"
+	"weather-service/tests/integration/testutils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	openMeteoForecastPath   = "/v1/forecast"
+	openMeteoGeocodingPath  = "/v1/search"
+	partlyCloudyWeatherCode = 2
+)
+
+type OpenMeteoMockServer struct {
+	*httptest.Server
+	forecastStatus int
+	forecastBody   interface{}
+	geocoding      openmeteo.OpenMeteoGeocodingResponse
+	forecastCalls  atomic.Int32
+	geocodingCalls atomic.Int32
+	lastQuery      atomic.Value
+}
+
+func newOpenMeteoMockServer(t *testing.T, forecastStatus int, forecastBody interface{}, geocoding openmeteo.OpenMeteoGeocodingResponse) *OpenMeteoMockServer {
+	t.Helper()
+
+	mock := &OpenMeteoMockServer{
+		forecastStatus: forecastStatus,
+		forecastBody:   forecastBody,
+		geocoding:      geocoding,
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(openMeteoForecastPath, func(w http.ResponseWriter, r *http.Request) {
+		mock.forecastCalls.Add(1)
+		mock.lastQuery.Store(r.URL.Query())
+
+		w.WriteHeader(mock.forecastStatus)
+		require.NoError(t, json.NewEncoder(w).Encode(mock.forecastBody))
+	})
+	mux.HandleFunc(openMeteoGeocodingPath, func(w http.ResponseWriter, r *http.Request) {
+		mock.geocodingCalls.Add(1)
+
+		w.WriteHeader(http.StatusOK)
+		require.NoError(t, json.NewEncoder(w).Encode(mock.geocoding))
+	})
+
+	mock.Server = httptest.NewServer(mux)
+	t.Cleanup(mock.Close)
+
+	return mock
+}
+
+func testOpenMeteoWeatherResponse() openmeteo.OpenMeteoWeatherResponse {
+	return openmeteo.OpenMeteoWeatherResponse{
+		Current: openmeteo.OpenMeteoCurrentResponse{
+			Temperature:         testWeather.Temperature,
+			RelativeHumidity:    testWeather.Humidity,
+			ApparentTemperature: testWeather.FeelsLike,
+			WeatherCode:         partlyCloudyWeatherCode,
+			WindSpeed:           testWeather.WindSpeed,
+			WindDirection:       testWeather.WindDirection,
+			SurfacePressure:     testWeather.Pressure,
+			CloudCover:          testWeather.CloudCover,
+			Visibility:          testWeather.Visibility * 1000,
+		},
+	}
+}
+
+func newOpenMeteoChainConfig(openMeteoURLMock string, chain ...string) *config.Config {
+	cfg := newChainConfig("http://localhost", "http://localhost", chain...)
+	cfg.OpenMeteoURL = openMeteoURLMock + openMeteoForecastPath
+	cfg.OpenMeteoGeocodingURL = openMeteoURLMock + openMeteoGeocodingPath
+	return cfg
+}
+
+func TestOpenMeteo_KnownCityUsesCoordinates(t *testing.T) {
+	openMeteoServerMock := newOpenMeteoMockServer(t, http.StatusOK, testOpenMeteoWeatherResponse(), openmeteo.OpenMeteoGeocodingResponse{})
+
+	cfg := newOpenMeteoChainConfig(openMeteoServerMock.URL, "openmeteo")
+	weatherHandler := setupWeatherHandlerFromConfig(t, cfg, testutils.NewInMemoryCache(), testutils.NewInMemoryMetrics())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := weatherHandler.GetWeather(ctx, &weather.GetWeatherRequest{Location: &weather.GetWeatherRequest_City{City: "Kyiv"}})
+	require.NoError(t, err)
+	assertWeatherResponse(t, resp, testWeather)
+
+	location := resolveTestCity(t, "Kyiv")
+	query := openMeteoServerMock.lastQuery.Load().(url.Values)
+	assert.Equal(t, []string{formatCoordinate(location.Coordinates.Latitude)}, query["latitude"])
+	assert.Equal(t, []string{formatCoordinate(location.Coordinates.Longitude)}, query["longitude"])
+	assert.Equal(t, int32(0), openMeteoServerMock.geocodingCalls.Load())
+}
+
+func TestOpenMeteo_GeocodesUnknownCityOnce(t *testing.T) {
+	geocoding := openmeteo.OpenMeteoGeocodingResponse{
+		Results: []openmeteo.OpenMeteoGeocodingResult{
+			{Name: "Springfield", Latitude: 39.80, Longitude: -89.64, Country: "United States"},
+		},
+	}
+	openMeteoServerMock := newOpenMeteoMockServer(t, http.StatusOK, testOpenMeteoWeatherResponse(), geocoding)
+
+	cfg := newOpenMeteoChainConfig(openMeteoServerMock.URL, "openmeteo")
+	weatherHandler := setupWeatherHandlerFromConfig(t, cfg, testutils.NewInMemoryCache(), testutils.NewInMemoryMetrics())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	for _, city := range []string{"Springfield", "springfield"} {
+		resp, err := weatherHandler.GetWeather(ctx, &weather.GetWeatherRequest{Location: &weather.GetWeatherRequest_City{City: city}})
+		require.NoError(t, err)
+		assertWeatherResponse(t, resp, testWeather)
+	}
+
+	query := openMeteoServerMock.lastQuery.Load().(url.Values)
+	assert.Equal(t, []string{"39.8"}, query["latitude"])
+	assert.Equal(t, []string{"-89.64"}, query["longitude"])
+	assert.Equal(t, int32(1), openMeteoServerMock.geocodingCalls.Load())
+	assert.Equal(t, int32(2), openMeteoServerMock.forecastCalls.Load())
+}
+
+func TestOpenMeteo_CityNotFound(t *testing.T) {
+	openMeteoServerMock := newOpenMeteoMockServer(t, http.StatusOK, testOpenMeteoWeatherResponse(), openmeteo.OpenMeteoGeocodingResponse{})
+
+	cfg := newOpenMeteoChainConfig(openMeteoServerMock.URL, "openmeteo")
+	weatherHandler := setupWeatherHandlerFromConfig(t, cfg, testutils.NewInMemoryCache(), testutils.NewInMemoryMetrics())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := weatherHandler.GetWeather(ctx, &weather.GetWeatherRequest{Location: &weather.GetWeatherRequest_City{City: "Atlantis"}})
+	require.Error(t, err)
+	assert.Nil(t, resp)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	assert.Equal(t, int32(0), openMeteoServerMock.forecastCalls.Load())
+}
+
+func TestOpenMeteo_ErrorFallsBackToNextProvider(t *testing.T) {
+	errorResponse := openmeteo.OpenMeteoErrorResponse{Error: true, Reason: "Cannot initialize WeatherVariable from invalid String value"}
+	openMeteoServerMock := newOpenMeteoMockServer(t, http.StatusBadRequest, errorResponse, openmeteo.OpenMeteoGeocodingResponse{})
+
+	t.Run("Last Provider", func(t *testing.T) {
+		cfg := newOpenMeteoChainConfig(openMeteoServerMock.URL, "openmeteo")
+		weatherHandler := setupWeatherHandlerFromConfig(t, cfg, testutils.NewInMemoryCache(), testutils.NewInMemoryMetrics())
+
+		resp, err := weatherHandler.GetWeather(context.Background(), &weather.GetWeatherRequest{Location: &weather.GetWeatherRequest_City{City: "Kyiv"}})
+		require.Error(t, err)
+		assert.Nil(t, resp)
+		assert.Equal(t, codes.Internal, status.Code(err))
+	})
+
+	t.Run("First Provider", func(t *testing.T) {
+		weatherAPIServerMock := setupWeatherAPIMock(t, testWeatherAPISuccessResponse(), http.StatusOK, "Kyiv")
+
+		cfg := newOpenMeteoChainConfig(openMeteoServerMock.URL, "openmeteo", "weatherapi")
+		cfg.WeatherAPIURL = weatherAPIServerMock.URL
+		weatherHandler := setupWeatherHandlerFromConfig(t, cfg, testutils.NewInMemoryCache(), testutils.NewInMemoryMetrics())
+
+		resp, err := weatherHandler.GetWeather(context.Background(), &weather.GetWeatherRequest{Location: &weather.GetWeatherRequest_City{City: "Kyiv"}})
+		require.NoError(t, err)
+		assertWeatherResponse(t, resp, testWeather)
+	})
+}
+
+func TestOpenMeteo_Forecast(t *testing.T) {
+	forecastResponse := openmeteo.OpenMeteoForecastResponse{
+		Daily: openmeteo.OpenMeteoDailyResponse{
+			Time:                        []string{testForecast[0].Date, testForecast[1].Date},
+			WeatherCode:                 []int{61, 0},
+			TemperatureMax:              []float64{testForecast[0].MaxTemperature, testForecast[1].MaxTemperature},
+			TemperatureMin:              []float64{testForecast[0].MinTemperature, testForecast[1].MinTemperature},
+			PrecipitationProbabilityMax: []int{int(testForecast[0].PrecipitationChance), int(testForecast[1].PrecipitationChance)},
+		},
+	}
+	openMeteoServerMock := newOpenMeteoMockServer(t, http.StatusOK, forecastResponse, openmeteo.OpenMeteoGeocodingResponse{})
+
+	cfg := newOpenMeteoChainConfig(openMeteoServerMock.URL, "openmeteo")
+	weatherHandler := setupWeatherHandlerFromConfig(t, cfg, testutils.NewInMemoryCache(), testutils.NewInMemoryMetrics())
+
+	resp, err := weatherHandler.GetForecast(context.Background(), &weather.GetForecastRequest{City: "Kyiv", Days: 2})
+	require.NoError(t, err)
+	require.Len(t, resp.Days, 2)
+
+	for i, day := range resp.Days {
+		assert.Equal(t, testForecast[i].Date, day.Date)
+		assert.Equal(t, testForecast[i].MinTemperature, day.MinTemperature)
+		assert.Equal(t, testForecast[i].MaxTemperature, day.MaxTemperature)
+		assert.Equal(t, testForecast[i].PrecipitationChance, day.PrecipitationChance)
+	}
+	assert.Equal(t, "Slight rain", resp.Days[0].Condition)
+	assert.Equal(t, "Clear sky", resp.Days[1].Condition)
+
+	query := openMeteoServerMock.lastQuery.Load().(url.Values)
+	assert.Equal(t, []string{"2"}, query["forecast_days"])
+}
@@ -0,0 +1,124 @@
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+	"weather-forecast/pkg/proto/weather"
+	"weather-service/internal/domain/usecases"
+	"weather-service/tests/fakeweather"
+	"weather-service/tests/integration/testutils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestPrefetchWeather_WarmsMissingCities(t *testing.T) {
+	fake, _, cfg := newFakeWeather(t, bundledFixtures, "cache", "openweather")
+	cfg.OpenWeatherCacheTTL = 600
+	cfg.AirQualityCacheTTL = 600
+
+	weatherHandler := setupWeatherHandlerFromConfig(t, cfg, testutils.NewInMemoryCache(), testutils.NewInMemoryMetrics())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := weatherHandler.GetWeather(ctx, &weather.GetWeatherRequest{Location: &weather.GetWeatherRequest_City{City: "Kyiv"}})
+	require.NoError(t, err)
+	require.Equal(t, 1, fake.Calls(fakeweather.OpenWeather, "weather"))
+
+	resp, err := weatherHandler.PrefetchWeather(ctx, &weather.PrefetchWeatherRequest{
+		Cities:           []string{"Kyiv", "Lviv"},
+		AirQualityCities: []string{"Kyiv"},
+		FreshUntil:       time.Now().Add(5 * time.Minute).Unix(),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), resp.Warmed)
+	assert.Empty(t, resp.Failed)
+	assert.Equal(t, 2, fake.Calls(fakeweather.OpenWeather, "weather"), "cities fresh until the broadcast are not fetched again")
+	assert.Equal(t, 1, fake.Calls(fakeweather.OpenWeather, "air_pollution"))
+
+	_, err = weatherHandler.GetWeatherBatch(ctx, &weather.GetWeatherBatchRequest{Cities: []string{"Kyiv", "Lviv"}})
+	require.NoError(t, err)
+	_, err = weatherHandler.GetAirQuality(ctx, &weather.GetAirQualityRequest{City: "Kyiv"})
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, fake.Calls(fakeweather.OpenWeather, "weather"), "prefetched weather is served from cache")
+	assert.Equal(t, 1, fake.Calls(fakeweather.OpenWeather, "air_pollution"), "prefetched air quality is served from cache")
+}
+
+func TestPrefetchWeather_RefreshesEntriesExpiringBeforeBroadcast(t *testing.T) {
+	fake, _, cfg := newFakeWeather(t, bundledFixtures, "cache", "openweather")
+	cfg.OpenWeatherCacheTTL = 600
+
+	weatherHandler := setupWeatherHandlerFromConfig(t, cfg, testutils.NewInMemoryCache(), testutils.NewInMemoryMetrics())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := weatherHandler.GetWeather(ctx, &weather.GetWeatherRequest{Location: &weather.GetWeatherRequest_City{City: "Kyiv"}})
+	require.NoError(t, err)
+
+	resp, err := weatherHandler.PrefetchWeather(ctx, &weather.PrefetchWeatherRequest{
+		Cities:     []string{"Kyiv"},
+		FreshUntil: time.Now().Add(15 * time.Minute).Unix(),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), resp.Warmed)
+	assert.Equal(t, 2, fake.Calls(fakeweather.OpenWeather, "weather"), "an entry expiring before the broadcast is fetched again")
+}
+
+func TestPrefetchWeather_ReportsFailedCities(t *testing.T) {
+	fake, script, cfg := newFakeWeather(t, bundledFixtures, "cache", "openweather")
+	cfg.OpenWeatherCacheTTL = 600
+
+	weatherHandler := setupWeatherHandlerFromConfig(t, cfg, testutils.NewInMemoryCache(), testutils.NewInMemoryMetrics())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := weatherHandler.GetWeather(ctx, &weather.GetWeatherRequest{Location: &weather.GetWeatherRequest_City{City: "Kyiv"}})
+	require.NoError(t, err)
+
+	script.Add(fakeweather.Failure{Provider: fakeweather.OpenWeather, Kind: fakeweather.FailureServerError, Times: 1})
+	resp, err := weatherHandler.PrefetchWeather(ctx, &weather.PrefetchWeatherRequest{
+		Cities:     []string{"Kyiv"},
+		FreshUntil: time.Now().Add(15 * time.Minute).Unix(),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int32(0), resp.Warmed)
+	assert.Equal(t, []string{"Kyiv"}, resp.Failed)
+
+	_, err = weatherHandler.GetWeather(ctx, &weather.GetWeatherRequest{Location: &weather.GetWeatherRequest_City{City: "Kyiv"}})
+	require.NoError(t, err)
+	assert.Equal(t, 2, fake.Calls(fakeweather.OpenWeather, "weather"), "a failed refresh keeps the cached entry")
+}
+
+func TestPrefetchWeather_InvalidRequest(t *testing.T) {
+	cfg := newChainConfig("http://localhost", "http://localhost", "weatherapi")
+	weatherHandler := setupWeatherHandlerFromConfig(t, cfg, testutils.NewInMemoryCache(), testutils.NewInMemoryMetrics())
+
+	tooMany := make([]string, usecases.MaxBatchSize+1)
+	for i := range tooMany {
+		tooMany[i] = "Kyiv"
+	}
+
+	tests := []struct {
+		name string
+		req  *weather.PrefetchWeatherRequest
+	}{
+		{name: "empty", req: &weather.PrefetchWeatherRequest{}},
+		{name: "too many cities", req: &weather.PrefetchWeatherRequest{Cities: tooMany}},
+		{name: "too many air quality cities", req: &weather.PrefetchWeatherRequest{AirQualityCities: tooMany}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := weatherHandler.PrefetchWeather(context.Background(), tt.req)
+			require.Error(t, err)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+	}
+}
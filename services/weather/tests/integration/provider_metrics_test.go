@@ -0,0 +1,120 @@
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+	"weather-forecast/pkg/proto/weather"
+	"weather-service/internal/config"
+	"weather-service/internal/infrastructure/providers"
+	"weather-service/tests/fakeweather"
+	"weather-service/tests/integration/testutils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestProviderMetrics_Fallback(t *testing.T) {
+	_, script, cfg := newFakeWeather(t, bundledFixtures, "weatherapi", "openweather")
+	script.Add(fakeweather.Failure{Provider: fakeweather.WeatherAPI, Kind: fakeweather.FailureServerError})
+
+	metrics := testutils.NewInMemoryMetrics()
+	weatherHandler := setupWeatherHandlerFromConfig(t, cfg, testutils.NewInMemoryCache(), metrics)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := weatherHandler.GetWeather(ctx, &weather.GetWeatherRequest{Location: &weather.GetWeatherRequest_City{City: "Kyiv"}})
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, metrics.ProviderCalls(providers.WeatherAPIProviderName, providers.OutcomeError))
+	assert.Equal(t, 1, metrics.ProviderCalls(providers.OpenWeatherProviderName, providers.OutcomeSuccess))
+	assert.Len(t, metrics.ProviderLatencies(providers.WeatherAPIProviderName), 1)
+	assert.Len(t, metrics.ProviderLatencies(providers.OpenWeatherProviderName), 1)
+
+	assert.Equal(t, 1, metrics.ServedBy(providers.OpenWeatherProviderName, providers.WeatherOperation))
+	assert.Zero(t, metrics.ServedBy(providers.WeatherAPIProviderName, providers.WeatherOperation))
+}
+
+func TestProviderMetrics_NotFound(t *testing.T) {
+	_, script, cfg := newFakeWeather(t, bundledFixtures, "weatherapi", "openweather")
+	script.Add(fakeweather.Failure{Kind: fakeweather.FailureNotFound})
+
+	metrics := testutils.NewInMemoryMetrics()
+	weatherHandler := setupWeatherHandlerFromConfig(t, cfg, testutils.NewInMemoryCache(), metrics)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := weatherHandler.GetForecast(ctx, &weather.GetForecastRequest{City: "Kyiv", Days: 3})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	assert.Equal(t, 1, metrics.ProviderCalls(providers.WeatherAPIProviderName, providers.OutcomeNotFound))
+	assert.Equal(t, 1, metrics.ProviderCalls(providers.OpenWeatherProviderName, providers.OutcomeNotFound))
+	assert.Zero(t, metrics.ServedBy(providers.OpenWeatherProviderName, providers.ForecastOperation))
+}
+
+func TestProviderMetrics_Timeout(t *testing.T) {
+	_, script, cfg := newFakeWeather(t, bundledFixtures, "weatherapi", "openweather")
+	script.Add(fakeweather.Failure{Provider: fakeweather.WeatherAPI, Kind: fakeweather.FailureSlow, DelayMs: 1500})
+
+	metrics := testutils.NewInMemoryMetrics()
+	weatherHandler := setupWeatherHandlerFromConfig(t, cfg, testutils.NewInMemoryCache(), metrics)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := weatherHandler.GetWeather(ctx, &weather.GetWeatherRequest{Location: &weather.GetWeatherRequest_City{City: "Kyiv"}})
+	require.NoError(t, err)
+	assert.Equal(t, fakeOpenWeatherTemperature, resp.Temperature)
+
+	assert.Equal(t, 1, metrics.ProviderCalls(providers.WeatherAPIProviderName, providers.OutcomeTimeout))
+	latencies := metrics.ProviderLatencies(providers.WeatherAPIProviderName)
+	require.Len(t, latencies, 1)
+	assert.GreaterOrEqual(t, latencies[0], time.Duration(cfg.WeatherAPITimeout)*time.Second)
+	assert.Equal(t, 1, metrics.ServedBy(providers.OpenWeatherProviderName, providers.WeatherOperation))
+}
+
+func TestProviderMetrics_ServedByCache(t *testing.T) {
+	fake, _, cfg := newFakeWeather(t, bundledFixtures, "cache", "weatherapi")
+	cfg.WeatherAPICacheTTL = 600
+
+	metrics := testutils.NewInMemoryMetrics()
+	weatherHandler := setupWeatherHandlerFromConfig(t, cfg, testutils.NewInMemoryCache(), metrics)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	for range 3 {
+		_, err := weatherHandler.GetWeather(ctx, &weather.GetWeatherRequest{Location: &weather.GetWeatherRequest_City{City: "Kyiv"}})
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, 1, fake.Calls(fakeweather.WeatherAPI, "current"))
+	assert.Equal(t, 1, metrics.ServedBy(providers.WeatherAPIProviderName, providers.WeatherOperation))
+	assert.Equal(t, 2, metrics.ServedBy(providers.CacheProviderName, providers.WeatherOperation))
+}
+
+func TestProviderMetrics_HedgeLoserCanceled(t *testing.T) {
+	_, script, cfg := newFakeWeather(t, bundledFixtures, "weatherapi", "openweather")
+	cfg.ProviderStrategy = config.HedgedStrategy
+	cfg.HedgeDelayMs = testHedgeDelayMs
+	script.Add(fakeweather.Failure{Provider: fakeweather.WeatherAPI, Kind: fakeweather.FailureSlow, DelayMs: 500})
+
+	metrics := testutils.NewInMemoryMetrics()
+	weatherHandler := setupWeatherHandlerFromConfig(t, cfg, testutils.NewInMemoryCache(), metrics)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := weatherHandler.GetWeather(ctx, &weather.GetWeatherRequest{Location: &weather.GetWeatherRequest_City{City: "Kyiv"}})
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, metrics.ServedBy(providers.OpenWeatherProviderName, providers.WeatherOperation))
+	assert.Eventually(t, func() bool {
+		return metrics.ProviderCalls(providers.WeatherAPIProviderName, providers.OutcomeCanceled) == 1
+	}, time.Second, 10*time.Millisecond)
+	assert.Zero(t, metrics.ProviderCalls(providers.WeatherAPIProviderName, providers.OutcomeError))
+}
@@ -0,0 +1,143 @@
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+	"weather-forecast/pkg/proto/weather"
+	"weather-service/internal/infrastructure/providers"
+	"weather-service/internal/presentation/server/handlers"
+	"weather-service/tests/fakeweather"
+	"weather-service/tests/integration/testutils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	fakeWeatherAPITemperature  = 18.4
+	fakeOpenWeatherTemperature = 18.1
+)
+
+func TestQuota_SkipsProviderOutOfBudget(t *testing.T) {
+	fake, _, cfg := newFakeWeather(t, bundledFixtures, "weatherapi", "openweather")
+	cfg.WeatherAPIMonthlyQuota = 3
+
+	metrics := testutils.NewInMemoryMetrics()
+	weatherHandler := setupWeatherHandlerWithQuota(t, cfg, testutils.NewInMemoryCache(), testutils.NewInMemoryCache(), metrics)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	for range 3 {
+		resp, err := weatherHandler.GetWeather(ctx, &weather.GetWeatherRequest{Location: &weather.GetWeatherRequest_City{City: "Kyiv"}})
+		require.NoError(t, err)
+		assert.Equal(t, fakeWeatherAPITemperature, resp.Temperature)
+	}
+
+	resp, err := weatherHandler.GetWeather(ctx, &weather.GetWeatherRequest{Location: &weather.GetWeatherRequest_City{City: "Kyiv"}})
+	require.NoError(t, err)
+	assert.Equal(t, fakeOpenWeatherTemperature, resp.Temperature)
+
+	assert.Equal(t, 3, fake.Calls(fakeweather.WeatherAPI, "current"))
+	assert.Equal(t, 1, fake.Calls(fakeweather.OpenWeather, "weather"))
+
+	remaining, ok := metrics.QuotaRemaining(providers.WeatherAPIProviderName, providers.QuotaMonthWindow)
+	require.True(t, ok)
+	assert.Equal(t, 0, remaining)
+	assert.Equal(t, providers.CircuitClosed, metrics.CircuitState(providers.WeatherAPIProviderName))
+}
+
+func TestQuota_KeepsReserve(t *testing.T) {
+	fake, _, cfg := newFakeWeather(t, bundledFixtures, "weatherapi", "openweather")
+	cfg.WeatherAPIMonthlyQuota = 20
+	cfg.ProviderQuotaReservePercent = 10
+
+	metrics := testutils.NewInMemoryMetrics()
+	weatherHandler := setupWeatherHandlerWithQuota(t, cfg, testutils.NewInMemoryCache(), testutils.NewInMemoryCache(), metrics)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	for range 20 {
+		_, err := weatherHandler.GetWeather(ctx, &weather.GetWeatherRequest{Location: &weather.GetWeatherRequest_City{City: "Kyiv"}})
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, 18, fake.Calls(fakeweather.WeatherAPI, "current"))
+	assert.Equal(t, 2, fake.Calls(fakeweather.OpenWeather, "weather"))
+
+	remaining, ok := metrics.QuotaRemaining(providers.WeatherAPIProviderName, providers.QuotaMonthWindow)
+	require.True(t, ok)
+	assert.Equal(t, 2, remaining)
+}
+
+func TestQuota_SharedAcrossReplicas(t *testing.T) {
+	fake, _, cfg := newFakeWeather(t, bundledFixtures, "weatherapi", "openweather")
+	cfg.WeatherAPIMonthlyQuota = 2
+
+	quota := testutils.NewInMemoryCache()
+	firstReplica := setupWeatherHandlerWithQuota(t, cfg, testutils.NewInMemoryCache(), quota, testutils.NewInMemoryMetrics())
+	secondReplica := setupWeatherHandlerWithQuota(t, cfg, testutils.NewInMemoryCache(), quota, testutils.NewInMemoryMetrics())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	for _, handler := range []*handlers.WeatherHandler{firstReplica, secondReplica, firstReplica} {
+		_, err := handler.GetWeather(ctx, &weather.GetWeatherRequest{Location: &weather.GetWeatherRequest_City{City: "Kyiv"}})
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, 2, fake.Calls(fakeweather.WeatherAPI, "current"))
+	assert.Equal(t, 1, fake.Calls(fakeweather.OpenWeather, "weather"))
+}
+
+func TestQuota_CountedPerAPIKey(t *testing.T) {
+	fake, _, cfg := newFakeWeather(t, bundledFixtures, "weatherapi")
+	cfg.WeatherAPIMonthlyQuota = 1
+
+	otherKeyCfg := *cfg
+	otherKeyCfg.WeatherAPIKey = "otherAPIKey"
+
+	quota := testutils.NewInMemoryCache()
+	firstHandler := setupWeatherHandlerWithQuota(t, cfg, testutils.NewInMemoryCache(), quota, testutils.NewInMemoryMetrics())
+	secondHandler := setupWeatherHandlerWithQuota(t, &otherKeyCfg, testutils.NewInMemoryCache(), quota, testutils.NewInMemoryMetrics())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := firstHandler.GetWeather(ctx, &weather.GetWeatherRequest{Location: &weather.GetWeatherRequest_City{City: "Kyiv"}})
+	require.NoError(t, err)
+	_, err = secondHandler.GetWeather(ctx, &weather.GetWeatherRequest{Location: &weather.GetWeatherRequest_City{City: "Kyiv"}})
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, fake.Calls(fakeweather.WeatherAPI, "current"))
+}
+
+func TestQuota_AllProvidersExhausted(t *testing.T) {
+	fake, _, cfg := newFakeWeather(t, bundledFixtures, "weatherapi")
+	cfg.WeatherAPIMonthlyQuota = 1
+
+	metrics := testutils.NewInMemoryMetrics()
+	weatherHandler := setupWeatherHandlerWithQuota(t, cfg, testutils.NewInMemoryCache(), testutils.NewInMemoryCache(), metrics)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := weatherHandler.GetWeather(ctx, &weather.GetWeatherRequest{Location: &weather.GetWeatherRequest_City{City: "Kyiv"}})
+	require.NoError(t, err)
+
+	for range 2 {
+		resp, err := weatherHandler.GetWeather(ctx, &weather.GetWeatherRequest{Location: &weather.GetWeatherRequest_City{City: "Kyiv"}})
+		assert.Nil(t, resp)
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+	}
+
+	assert.Equal(t, 1, fake.Calls(fakeweather.WeatherAPI, "current"))
+
+	remaining, ok := metrics.QuotaRemaining(providers.WeatherAPIProviderName, providers.QuotaMonthWindow)
+	require.True(t, ok)
+	assert.Equal(t, 0, remaining)
+}
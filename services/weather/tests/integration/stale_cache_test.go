@@ -0,0 +1,160 @@
+package integration
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+	"weather-forecast/pkg/proto/weather"
+	stub_logger "weather-forecast/pkg/stubs/logger"
+	"weather-service/internal/config"
+	"weather-service/internal/domain/usecases"
+	"weather-service/internal/infrastructure/clients/weatherapi"
+	"weather-service/internal/infrastructure/locations"
+	"weather-service/internal/infrastructure/providers"
+	"weather-service/internal/presentation/server/handlers"
+	"weather-service/tests/integration/testutils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type SwitchableMockServer struct {
+	*httptest.Server
+	failing atomic.Bool
+	calls   atomic.Int32
+}
+
+func newSwitchableMockServer(t *testing.T, responseBody interface{}) *SwitchableMockServer {
+	t.Helper()
+
+	mock := &SwitchableMockServer{}
+	mock.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mock.calls.Add(1)
+
+		if mock.failing.Load() {
+			w.WriteHeader(http.StatusBadRequest)
+			require.NoError(t, json.NewEncoder(w).Encode(weatherAPIInternalErrorResponse))
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+		require.NoError(t, json.NewEncoder(w).Encode(responseBody))
+	}))
+	t.Cleanup(mock.Close)
+
+	return mock
+}
+
+func setupWeatherHandlerWithTTL(cacher providers.Cacher, metrics providers.MetricsRecorder, ttl providers.CacheTTL, weatherAPIURLMock string) *handlers.WeatherHandler {
+	stubLogger := stub_logger.New()
+	cfg := &config.Config{
+		WeatherAPIURL:         weatherAPIURLMock,
+		WeatherAPIForecastURL: weatherAPIURLMock,
+		WeatherAPIKey:         testAPIKey,
+	}
+
+	weatherAPIClient := weatherapi.NewClient(cfg, &http.Client{}, stubLogger)
+	weatherAPIProvider := providers.NewWeatherAPIProvider(weatherAPIClient, stubLogger)
+	weatherAPILink := providers.NewWeatherLink(providers.NewCacheDecorator(providers.WeatherAPIProviderName, weatherAPIProvider, cacher, metrics, ttl, stubLogger))
+
+	cacheProviderLink := providers.NewCacheWeather(cacher, metrics, stubLogger)
+	cacheProviderLink.SetNext(weatherAPILink)
+
+	cityResolver, _ := locations.NewResolver(stubLogger)
+
+	weatherService := usecases.NewWeatherService(cacheProviderLink, cityResolver, stubLogger)
+	return newWeatherHandler(weatherService)
+}
+
+func TestGetWeather_ServesStaleWhileRevalidating(t *testing.T) {
+	metrics := testutils.NewInMemoryMetrics()
+	weatherAPIServerMock := newSwitchableMockServer(t, testWeatherAPISuccessResponse())
+
+	ttl := providers.CacheTTL{
+		Weather:          50 * time.Millisecond,
+		RevalidateWindow: time.Minute,
+	}
+	weatherHandler := setupWeatherHandlerWithTTL(testutils.NewInMemoryCache(), metrics, ttl, weatherAPIServerMock.URL)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := weatherHandler.GetWeather(ctx, &weather.GetWeatherRequest{Location: &weather.GetWeatherRequest_City{City: "Kyiv"}})
+	require.NoError(t, err)
+	require.Equal(t, int32(1), weatherAPIServerMock.calls.Load())
+
+	time.Sleep(100 * time.Millisecond)
+
+	resp, err := weatherHandler.GetWeather(ctx, &weather.GetWeatherRequest{Location: &weather.GetWeatherRequest_City{City: "Kyiv"}})
+	require.NoError(t, err)
+	assertWeatherResponse(t, resp, testWeather)
+	assert.False(t, resp.Stale)
+
+	assert.Eventually(t, func() bool {
+		return weatherAPIServerMock.calls.Load() == 2
+	}, time.Second, 10*time.Millisecond)
+
+	staleHits, lastKnownGood := metrics.StaleStats()
+	assert.Equal(t, 1, staleHits)
+	assert.Equal(t, 0, lastKnownGood)
+
+	resp, err = weatherHandler.GetWeather(ctx, &weather.GetWeatherRequest{Location: &weather.GetWeatherRequest_City{City: "Kyiv"}})
+	require.NoError(t, err)
+	assertWeatherResponse(t, resp, testWeather)
+	assert.Equal(t, int32(2), weatherAPIServerMock.calls.Load())
+}
+
+func TestGetWeather_LastKnownGood(t *testing.T) {
+	metrics := testutils.NewInMemoryMetrics()
+	weatherAPIServerMock := newSwitchableMockServer(t, testWeatherAPISuccessResponse())
+
+	ttl := providers.CacheTTL{
+		Weather:       50 * time.Millisecond,
+		LastKnownGood: time.Minute,
+	}
+	weatherHandler := setupWeatherHandlerWithTTL(testutils.NewInMemoryCache(), metrics, ttl, weatherAPIServerMock.URL)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := weatherHandler.GetWeather(ctx, &weather.GetWeatherRequest{Location: &weather.GetWeatherRequest_City{City: "Kyiv"}})
+	require.NoError(t, err)
+
+	time.Sleep(100 * time.Millisecond)
+	weatherAPIServerMock.failing.Store(true)
+
+	resp, err := weatherHandler.GetWeather(ctx, &weather.GetWeatherRequest{Location: &weather.GetWeatherRequest_City{City: "Kyiv"}})
+	require.NoError(t, err)
+	assertWeatherResponse(t, resp, testWeather)
+	assert.True(t, resp.Stale)
+	assert.Equal(t, int32(2), weatherAPIServerMock.calls.Load())
+
+	staleHits, lastKnownGood := metrics.StaleStats()
+	assert.Equal(t, 0, staleHits)
+	assert.Equal(t, 1, lastKnownGood)
+}
+
+func TestGetWeather_LastKnownGoodExpired(t *testing.T) {
+	metrics := testutils.NewInMemoryMetrics()
+	weatherAPIServerMock := newSwitchableMockServer(t, testWeatherAPISuccessResponse())
+
+	ttl := providers.CacheTTL{Weather: 50 * time.Millisecond}
+	weatherHandler := setupWeatherHandlerWithTTL(testutils.NewInMemoryCache(), metrics, ttl, weatherAPIServerMock.URL)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := weatherHandler.GetWeather(ctx, &weather.GetWeatherRequest{Location: &weather.GetWeatherRequest_City{City: "Kyiv"}})
+	require.NoError(t, err)
+
+	time.Sleep(100 * time.Millisecond)
+	weatherAPIServerMock.failing.Store(true)
+
+	resp, err := weatherHandler.GetWeather(ctx, &weather.GetWeatherRequest{Location: &weather.GetWeatherRequest_City{City: "Kyiv"}})
+	require.Error(t, err)
+	assert.Nil(t, resp)
+}
@@ -0,0 +1,197 @@
+package testutils
+
+import (
+	"context"
+	"encoding/json"
+	"path"
+	"slices"
+	"sync"
+	"time"
+	infraerrors "weather-service/internal/infrastructure/errors"
+)
+
+type (
+	inMemoryCacheEntry struct {
+		data      []byte
+		expiresAt time.Time
+	}
+
+	inMemoryTimelineEntry struct {
+		at   time.Time
+		data []byte
+	}
+
+	InMemoryCache struct {
+		entries   map[string]inMemoryCacheEntry
+		timelines map[string][]inMemoryTimelineEntry
+		mu        *sync.Mutex
+	}
+)
+
+func NewInMemoryCache() *InMemoryCache {
+	return &InMemoryCache{
+		entries:   make(map[string]inMemoryCacheEntry),
+		timelines: make(map[string][]inMemoryTimelineEntry),
+		mu:        &sync.Mutex{},
+	}
+}
+
+func (c *InMemoryCache) Set(ctx context.Context, key string, value interface{}, expiration time.Duration) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return infraerrors.ErrInternal
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[key] = inMemoryCacheEntry{
+		data:      data,
+		expiresAt: time.Now().Add(expiration),
+	}
+
+	return nil
+}
+
+func (c *InMemoryCache) Get(ctx context.Context, key string, value interface{}) error {
+	c.mu.Lock()
+	entry, ok := c.entries[key]
+	c.mu.Unlock()
+
+	if !ok || time.Now().After(entry.expiresAt) {
+		return infraerrors.ErrCacheMiss
+	}
+
+	if err := json.Unmarshal(entry.data, value); err != nil {
+		return infraerrors.ErrInternal
+	}
+
+	return nil
+}
+
+func (c *InMemoryCache) Size() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.entries)
+}
+
+func (c *InMemoryCache) SetRaw(ctx context.Context, key string, data []byte, expiration time.Duration) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[key] = inMemoryCacheEntry{
+		data:      data,
+		expiresAt: time.Now().Add(expiration),
+	}
+
+	return nil
+}
+
+func (c *InMemoryCache) GetRaw(ctx context.Context, key string) ([]byte, error) {
+	c.mu.Lock()
+	entry, ok := c.entries[key]
+	c.mu.Unlock()
+
+	if !ok || time.Now().After(entry.expiresAt) {
+		return nil, infraerrors.ErrCacheMiss
+	}
+
+	return entry.data, nil
+}
+
+func (c *InMemoryCache) Keys(ctx context.Context, pattern string) ([]string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var keys []string
+	for key, entry := range c.entries {
+		if time.Now().After(entry.expiresAt) {
+			continue
+		}
+		if matched, _ := path.Match(pattern, key); matched {
+			keys = append(keys, key)
+		}
+	}
+
+	return keys, nil
+}
+
+func (c *InMemoryCache) Delete(ctx context.Context, keys ...string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, key := range keys {
+		delete(c.entries, key)
+	}
+
+	return nil
+}
+
+func (c *InMemoryCache) Increment(ctx context.Context, key string, expiration time.Duration) (int64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var count int64
+	entry, ok := c.entries[key]
+	if ok && time.Now().Before(entry.expiresAt) {
+		if err := json.Unmarshal(entry.data, &count); err != nil {
+			return 0, infraerrors.ErrInternal
+		}
+	} else {
+		entry.expiresAt = time.Now().Add(expiration)
+	}
+
+	count++
+	entry.data, _ = json.Marshal(count)
+	c.entries[key] = entry
+
+	return count, nil
+}
+
+func (c *InMemoryCache) Decrement(ctx context.Context, key string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok {
+		return nil
+	}
+
+	var count int64
+	if err := json.Unmarshal(entry.data, &count); err != nil {
+		return infraerrors.ErrInternal
+	}
+	entry.data, _ = json.Marshal(count - 1)
+	c.entries[key] = entry
+
+	return nil
+}
+
+func (c *InMemoryCache) AddToTimeline(ctx context.Context, key string, at time.Time, data []byte, retention time.Duration) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	cutoff := time.Now().Add(-retention)
+	timeline := slices.DeleteFunc(c.timelines[key], func(entry inMemoryTimelineEntry) bool {
+		return entry.at.Before(cutoff)
+	})
+	timeline = append(timeline, inMemoryTimelineEntry{at: at, data: data})
+	slices.SortStableFunc(timeline, func(a, b inMemoryTimelineEntry) int {
+		return a.at.Compare(b.at)
+	})
+	c.timelines[key] = timeline
+
+	return nil
+}
+
+func (c *InMemoryCache) ReadTimeline(ctx context.Context, key string, from, to time.Time) ([][]byte, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var data [][]byte
+	for _, entry := range c.timelines[key] {
+		if !entry.at.Before(from) && !entry.at.After(to) {
+			data = append(data, entry.data)
+		}
+	}
+
+	return data, nil
+}
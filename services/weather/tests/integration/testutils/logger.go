@@ -0,0 +1,43 @@
+package testutils
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"weather-forecast/pkg/logger"
+)
+
+type RecordingLogger struct {
+	lines []string
+	mu    *sync.Mutex
+}
+
+func NewRecordingLogger() *RecordingLogger {
+	return &RecordingLogger{mu: &sync.Mutex{}}
+}
+
+func (l *RecordingLogger) Debugf(format string, args ...interface{}) { l.record(format, args...) }
+func (l *RecordingLogger) Infof(format string, args ...interface{})  { l.record(format, args...) }
+func (l *RecordingLogger) Warnf(format string, args ...interface{})  { l.record(format, args...) }
+func (l *RecordingLogger) Fatalf(format string, args ...interface{}) { l.record(format, args...) }
+func (l *RecordingLogger) Errorf(format string, args ...interface{}) { l.record(format, args...) }
+
+func (l *RecordingLogger) WithField(key string, value interface{}) logger.Logger {
+	return l
+}
+
+func (l *RecordingLogger) WithContext(ctx context.Context) logger.Logger {
+	return l
+}
+
+func (l *RecordingLogger) Lines() []string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return append([]string(nil), l.lines...)
+}
+
+func (l *RecordingLogger) record(format string, args ...interface{}) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.lines = append(l.lines, fmt.Sprintf(format, args...))
+}
@@ -1,20 +1,46 @@
 package testutils
 
-import "sync"
+import (
+	"sync"
+	"time"
+	"weather-service/internal/infrastructure/providers"
+)
 
 type InMemoryMetrics struct {
-	cacheHit   int
-	cacheMiss  int
-	cacheError int
-	mu         *sync.Mutex
+	cacheHit      int
+	cacheMiss     int
+	cacheError    int
+	cacheStale    int
+	lastKnownGood int
+	circuitStates map[string]providers.CircuitState
+	hedgeFired    int
+	hedgeWins     map[string]int
+	spreads       []float64
+	disputed      int
+	coalesced     int
+	tierHits      map[string]int
+	tierMisses    map[string]int
+	quota         map[string]int
+	outcomes      map[string]int
+	latencies     map[string][]time.Duration
+	servedBy      map[string]int
+	mu            *sync.Mutex
 }
 
 func NewInMemoryMetrics() *InMemoryMetrics {
 	return &InMemoryMetrics{
-		cacheHit:   0,
-		cacheMiss:  0,
-		cacheError: 0,
-		mu:         &sync.Mutex{},
+		cacheHit:      0,
+		cacheMiss:     0,
+		cacheError:    0,
+		circuitStates: make(map[string]providers.CircuitState),
+		hedgeWins:     make(map[string]int),
+		tierHits:      make(map[string]int),
+		tierMisses:    make(map[string]int),
+		quota:         make(map[string]int),
+		outcomes:      make(map[string]int),
+		latencies:     make(map[string][]time.Duration),
+		servedBy:      make(map[string]int),
+		mu:            &sync.Mutex{},
 	}
 }
 
@@ -37,12 +63,32 @@ func (m *InMemoryMetrics) RecordCacheError() {
 	m.cacheError++
 }
 
+func (m *InMemoryMetrics) RecordCacheStaleHit() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.cacheStale++
+}
+
+func (m *InMemoryMetrics) RecordLastKnownGood() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.lastKnownGood++
+}
+
+func (m *InMemoryMetrics) StaleStats() (int, int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.cacheStale, m.lastKnownGood
+}
+
 func (m *InMemoryMetrics) Clear() {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 	m.cacheHit = 0
 	m.cacheMiss = 0
 	m.cacheError = 0
+	m.cacheStale = 0
+	m.lastKnownGood = 0
 }
 
 func (m *InMemoryMetrics) Stats() (int, int, int) {
@@ -50,3 +96,126 @@ func (m *InMemoryMetrics) Stats() (int, int, int) {
 	defer m.mu.Unlock()
 	return m.cacheHit, m.cacheMiss, m.cacheError
 }
+
+func (m *InMemoryMetrics) RecordCircuitState(provider string, state providers.CircuitState) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.circuitStates[provider] = state
+}
+
+func (m *InMemoryMetrics) CircuitState(provider string) providers.CircuitState {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.circuitStates[provider]
+}
+
+func (m *InMemoryMetrics) RecordHedgeFired() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.hedgeFired++
+}
+
+func (m *InMemoryMetrics) RecordHedgeWin(provider string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.hedgeWins[provider]++
+}
+
+func (m *InMemoryMetrics) HedgeStats() (int, map[string]int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	wins := make(map[string]int, len(m.hedgeWins))
+	for provider, count := range m.hedgeWins {
+		wins[provider] = count
+	}
+	return m.hedgeFired, wins
+}
+
+func (m *InMemoryMetrics) RecordConsensus(spread float64, disputed bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.spreads = append(m.spreads, spread)
+	if disputed {
+		m.disputed++
+	}
+}
+
+func (m *InMemoryMetrics) ConsensusStats() ([]float64, int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]float64(nil), m.spreads...), m.disputed
+}
+
+func (m *InMemoryMetrics) RecordCoalesced() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.coalesced++
+}
+
+func (m *InMemoryMetrics) Coalesced() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.coalesced
+}
+
+func (m *InMemoryMetrics) RecordTierHit(tier string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.tierHits[tier]++
+}
+
+func (m *InMemoryMetrics) RecordTierMiss(tier string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.tierMisses[tier]++
+}
+
+func (m *InMemoryMetrics) TierStats(tier string) (int, int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.tierHits[tier], m.tierMisses[tier]
+}
+
+func (m *InMemoryMetrics) RecordQuotaRemaining(provider, window string, remaining int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.quota[provider+"/"+window] = remaining
+}
+
+func (m *InMemoryMetrics) QuotaRemaining(provider, window string) (int, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	remaining, ok := m.quota[provider+"/"+window]
+	return remaining, ok
+}
+
+func (m *InMemoryMetrics) RecordProviderCall(provider, outcome string, duration time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.outcomes[provider+"/"+outcome]++
+	m.latencies[provider] = append(m.latencies[provider], duration)
+}
+
+func (m *InMemoryMetrics) ProviderCalls(provider, outcome string) int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.outcomes[provider+"/"+outcome]
+}
+
+func (m *InMemoryMetrics) ProviderLatencies(provider string) []time.Duration {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]time.Duration(nil), m.latencies[provider]...)
+}
+
+func (m *InMemoryMetrics) RecordServedBy(provider, operation string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.servedBy[provider+"/"+operation]++
+}
+
+func (m *InMemoryMetrics) ServedBy(provider, operation string) int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.servedBy[provider+"/"+operation]
+}
@@ -0,0 +1,60 @@
+package testutils
+
+import (
+	"context"
+	"sync"
+)
+
+type InMemoryPubSub struct {
+	subscribers map[string][]chan string
+	mu          *sync.Mutex
+}
+
+func NewInMemoryPubSub() *InMemoryPubSub {
+	return &InMemoryPubSub{
+		subscribers: make(map[string][]chan string),
+		mu:          &sync.Mutex{},
+	}
+}
+
+func (p *InMemoryPubSub) Publish(ctx context.Context, channel string, message string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for _, subscriber := range p.subscribers[channel] {
+		subscriber <- message
+	}
+
+	return nil
+}
+
+func (p *InMemoryPubSub) Subscribe(ctx context.Context, channel string) <-chan string {
+	messages := make(chan string, 16)
+
+	p.mu.Lock()
+	p.subscribers[channel] = append(p.subscribers[channel], messages)
+	p.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		subscribers := p.subscribers[channel]
+		for i, subscriber := range subscribers {
+			if subscriber == messages {
+				p.subscribers[channel] = append(subscribers[:i], subscribers[i+1:]...)
+				break
+			}
+		}
+		close(messages)
+	}()
+
+	return messages
+}
+
+func (p *InMemoryPubSub) Subscribers(channel string) int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return len(p.subscribers[channel])
+}
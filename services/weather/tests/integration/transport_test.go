@@ -0,0 +1,255 @@
+package integration
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+	"weather-forecast/pkg/proto/weather"
+	"weather-service/internal/infrastructure/providers"
+	"weather-service/internal/infrastructure/roundtrip"
+	"weather-service/tests/fakeweather"
+	"weather-service/tests/integration/testutils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testRetrySettings = roundtrip.RetrySettings{
+	MaxRetries: 2,
+	BaseDelay:  time.Millisecond,
+	MaxDelay:   5 * time.Millisecond,
+}
+
+func newScriptedServer(t *testing.T, respond func(call int32, w http.ResponseWriter)) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+
+	calls := &atomic.Int32{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		respond(calls.Add(1), w)
+	}))
+	t.Cleanup(server.Close)
+
+	return server, calls
+}
+
+func newStackClient(retry roundtrip.RetrySettings, logSettings roundtrip.LogSettings, log *testutils.RecordingLogger) *http.Client {
+	return &http.Client{Transport: roundtrip.NewStack(http.DefaultTransport, retry, logSettings, log)}
+}
+
+func TestTransport_RedactsSecrets(t *testing.T) {
+	server, _ := newScriptedServer(t, func(call int32, w http.ResponseWriter) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	log := testutils.NewRecordingLogger()
+	client := newStackClient(roundtrip.RetrySettings{}, roundtrip.LogSettings{}, log)
+
+	resp, err := client.Get(server.URL + "/data/2.5/weather?q=Kyiv&appid=secretOpenWeatherKey&key=secretWeatherAPIKey")
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+
+	lines := strings.Join(log.Lines(), "\n")
+	assert.NotContains(t, lines, "secretOpenWeatherKey")
+	assert.NotContains(t, lines, "secretWeatherAPIKey")
+	assert.Contains(t, lines, "q=Kyiv")
+
+	_, err = client.Get("http://127.0.0.1:1/current.json?key=secretWeatherAPIKey")
+	require.Error(t, err)
+	assert.NotContains(t, roundtrip.RedactError(err).Error(), "secretWeatherAPIKey")
+	assert.NotContains(t, strings.Join(log.Lines(), "\n"), "secretWeatherAPIKey")
+}
+
+func TestTransport_RedactURL(t *testing.T) {
+	u, err := url.Parse("https://api.weatherapi.com/v1/current.json?key=secret&q=Kyiv&lang=en")
+	require.NoError(t, err)
+
+	redacted := roundtrip.RedactURL(u)
+	assert.NotContains(t, redacted, "secret")
+	assert.Contains(t, redacted, "key=REDACTED")
+	assert.Contains(t, redacted, "q=Kyiv")
+	assert.Equal(t, "key=secret&q=Kyiv&lang=en", u.RawQuery)
+}
+
+func TestTransport_TruncatesAndSamplesBodies(t *testing.T) {
+	body := strings.Repeat("a", 100)
+	server, _ := newScriptedServer(t, func(call int32, w http.ResponseWriter) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = io.WriteString(w, body)
+	})
+
+	log := testutils.NewRecordingLogger()
+	client := newStackClient(roundtrip.RetrySettings{}, roundtrip.LogSettings{MaxBodyBytes: 10, BodySampleRate: 2}, log)
+
+	for range 4 {
+		resp, err := client.Get(server.URL)
+		require.NoError(t, err)
+		received, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+		assert.Equal(t, body, string(received))
+	}
+
+	var bodyLines []string
+	for _, line := range log.Lines() {
+		if strings.Contains(line, "Response:") {
+			bodyLines = append(bodyLines, line)
+		}
+	}
+	require.Len(t, bodyLines, 2)
+	assert.Contains(t, bodyLines[0], strings.Repeat("a", 10)+"...(truncated)")
+	assert.NotContains(t, bodyLines[0], strings.Repeat("a", 11))
+}
+
+func TestTransport_RetriesServerErrors(t *testing.T) {
+	server, calls := newScriptedServer(t, func(call int32, w http.ResponseWriter) {
+		if call < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+
+	client := newStackClient(testRetrySettings, roundtrip.LogSettings{}, testutils.NewRecordingLogger())
+
+	resp, err := client.Get(server.URL)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, int32(3), calls.Load())
+}
+
+func TestTransport_GivesUpAfterMaxRetries(t *testing.T) {
+	server, calls := newScriptedServer(t, func(call int32, w http.ResponseWriter) {
+		w.WriteHeader(http.StatusBadGateway)
+	})
+
+	client := newStackClient(testRetrySettings, roundtrip.LogSettings{}, testutils.NewRecordingLogger())
+
+	resp, err := client.Get(server.URL)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	assert.Equal(t, http.StatusBadGateway, resp.StatusCode)
+	assert.Equal(t, int32(3), calls.Load())
+}
+
+func TestTransport_DoesNotRetry(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		method string
+	}{
+		{name: "client error", status: http.StatusBadRequest, method: http.MethodGet},
+		{name: "not idempotent", status: http.StatusServiceUnavailable, method: http.MethodPost},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, calls := newScriptedServer(t, func(call int32, w http.ResponseWriter) {
+				w.WriteHeader(tt.status)
+			})
+
+			client := newStackClient(testRetrySettings, roundtrip.LogSettings{}, testutils.NewRecordingLogger())
+
+			req, err := http.NewRequest(tt.method, server.URL, nil)
+			require.NoError(t, err)
+			resp, err := client.Do(req)
+			require.NoError(t, err)
+			require.NoError(t, resp.Body.Close())
+			assert.Equal(t, tt.status, resp.StatusCode)
+			assert.Equal(t, int32(1), calls.Load())
+		})
+	}
+}
+
+func TestTransport_RetriesAttemptTimeout(t *testing.T) {
+	server, calls := newScriptedServer(t, func(call int32, w http.ResponseWriter) {
+		if call == 1 {
+			time.Sleep(200 * time.Millisecond)
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = io.WriteString(w, "ok")
+	})
+
+	retry := testRetrySettings
+	retry.AttemptTimeout = 50 * time.Millisecond
+	client := newStackClient(retry, roundtrip.LogSettings{}, testutils.NewRecordingLogger())
+
+	resp, err := client.Get(server.URL)
+	require.NoError(t, err)
+	received, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	assert.Equal(t, "ok", string(received))
+	assert.Equal(t, int32(2), calls.Load())
+}
+
+func TestTransport_ProviderRetriedBeforeFallback(t *testing.T) {
+	fake, script, cfg := newFakeWeather(t, bundledFixtures, "weatherapi", "openweather")
+	cfg.ProviderMaxRetries = 1
+	cfg.ProviderRetryBaseDelayMs = 1
+	cfg.ProviderRetryMaxDelayMs = 5
+	script.Add(fakeweather.Failure{Provider: fakeweather.WeatherAPI, Kind: fakeweather.FailureServerError, Times: 1})
+
+	weatherHandler := setupWeatherHandlerFromConfig(t, cfg, testutils.NewInMemoryCache(), testutils.NewInMemoryMetrics())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := weatherHandler.GetWeather(ctx, &weather.GetWeatherRequest{Location: &weather.GetWeatherRequest_City{City: "Kyiv"}})
+	require.NoError(t, err)
+	assert.Equal(t, fakeWeatherAPITemperature, resp.Temperature)
+	assert.Equal(t, 2, fake.Calls(fakeweather.WeatherAPI, "current"))
+	assert.Zero(t, fake.Calls(fakeweather.OpenWeather, "weather"))
+}
+
+func TestTransport_RetriesCountedAgainstQuota(t *testing.T) {
+	fake, script, cfg := newFakeWeather(t, bundledFixtures, "weatherapi", "openweather")
+	cfg.ProviderMaxRetries = 2
+	cfg.ProviderRetryBaseDelayMs = 1
+	cfg.ProviderRetryMaxDelayMs = 5
+	cfg.WeatherAPIMinuteQuota = 2
+	script.Add(fakeweather.Failure{Provider: fakeweather.WeatherAPI, Kind: fakeweather.FailureServerError, Times: 3})
+
+	metrics := testutils.NewInMemoryMetrics()
+	weatherHandler := setupWeatherHandlerWithQuota(t, cfg, testutils.NewInMemoryCache(), testutils.NewInMemoryCache(), metrics)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := weatherHandler.GetWeather(ctx, &weather.GetWeatherRequest{Location: &weather.GetWeatherRequest_City{City: "Kyiv"}})
+	require.NoError(t, err)
+	assert.Equal(t, fakeOpenWeatherTemperature, resp.Temperature)
+	assert.Equal(t, 2, fake.Calls(fakeweather.WeatherAPI, "current"), "the retry beyond the quota is not sent")
+
+	remaining, ok := metrics.QuotaRemaining(providers.WeatherAPIProviderName, providers.QuotaMinuteWindow)
+	require.True(t, ok)
+	assert.Equal(t, 0, remaining)
+}
+
+func TestTransport_CallTimeoutBoundsRetries(t *testing.T) {
+	fake, script, cfg := newFakeWeather(t, bundledFixtures, "weatherapi", "openweather")
+	cfg.ProviderMaxRetries = 3
+	cfg.ProviderRetryBaseDelayMs = 1
+	cfg.ProviderRetryMaxDelayMs = 5
+	cfg.WeatherAPITimeout = 1
+	cfg.ProviderCallTimeout = 1
+	script.Add(fakeweather.Failure{Provider: fakeweather.WeatherAPI, Kind: fakeweather.FailureSlow, DelayMs: 3000, Times: 4})
+
+	weatherHandler := setupWeatherHandlerFromConfig(t, cfg, testutils.NewInMemoryCache(), testutils.NewInMemoryMetrics())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	start := time.Now()
+	resp, err := weatherHandler.GetWeather(ctx, &weather.GetWeatherRequest{Location: &weather.GetWeatherRequest_City{City: "Kyiv"}})
+	require.NoError(t, err)
+	assert.Equal(t, fakeOpenWeatherTemperature, resp.Temperature)
+	assert.Less(t, time.Since(start), 2*time.Second, "retries stop at the call timeout")
+	assert.Equal(t, 1, fake.Calls(fakeweather.WeatherAPI, "current"))
+}
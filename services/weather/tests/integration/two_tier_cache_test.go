@@ -0,0 +1,173 @@
+package integration
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+	"weather-forecast/pkg/proto/weather"
+	stub_logger "weather-forecast/pkg/stubs/logger"
+	"weather-service/internal/domain/models"
+	"weather-service/internal/infrastructure/cache"
+	infraerrors "weather-service/internal/infrastructure/errors"
+	"weather-service/tests/integration/testutils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type countingRemoteCache struct {
+	*testutils.InMemoryCache
+	gets atomic.Int32
+}
+
+func (c *countingRemoteCache) GetRaw(ctx context.Context, key string) ([]byte, error) {
+	c.gets.Add(1)
+	return c.InMemoryCache.GetRaw(ctx, key)
+}
+
+func newTwoTierCache(remote cache.RemoteCache, bus cache.InvalidationBus, metrics cache.TierMetricsRecorder, capacity int, ttl time.Duration) *cache.TwoTier {
+	return cache.NewTwoTier(cache.NewLRU(capacity), remote, bus, metrics, ttl, stub_logger.New())
+}
+
+func TestTwoTierCache_ServesHotCityFromMemory(t *testing.T) {
+	metrics := testutils.NewInMemoryMetrics()
+	remote := &countingRemoteCache{InMemoryCache: testutils.NewInMemoryCache()}
+	twoTier := newTwoTierCache(remote, testutils.NewInMemoryPubSub(), metrics, 10, time.Minute)
+
+	weatherAPIServerMock := setupWeatherAPIMock(t, testWeatherAPISuccessResponse(), http.StatusOK, "Kyiv")
+	weatherHandler := setupWeatherHandlerWithCache(twoTier, metrics, weatherAPIServerMock.URL, "http://localhost")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	for range 3 {
+		response, err := weatherHandler.GetWeather(ctx, &weather.GetWeatherRequest{Location: &weather.GetWeatherRequest_City{City: "Kyiv"}})
+		require.NoError(t, err)
+		assertWeatherResponse(t, response, testWeather)
+	}
+
+	assert.Equal(t, 1, weatherAPIServerMock.CallCount())
+	assert.Equal(t, int32(1), remote.gets.Load())
+
+	memoryHits, memoryMisses := metrics.TierStats(cache.MemoryTier)
+	assert.Equal(t, 2, memoryHits)
+	assert.Equal(t, 1, memoryMisses)
+
+	redisHits, redisMisses := metrics.TierStats(cache.RedisTier)
+	assert.Equal(t, 0, redisHits)
+	assert.Equal(t, 1, redisMisses)
+}
+
+func TestTwoTierCache_FillsMemoryFromRedis(t *testing.T) {
+	metrics := testutils.NewInMemoryMetrics()
+	remote := &countingRemoteCache{InMemoryCache: testutils.NewInMemoryCache()}
+	bus := testutils.NewInMemoryPubSub()
+	writer := newTwoTierCache(remote, bus, testutils.NewInMemoryMetrics(), 10, time.Minute)
+	reader := newTwoTierCache(remote, bus, metrics, 10, time.Minute)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	require.NoError(t, writer.Set(ctx, "weather:kyiv", testWeather, time.Minute))
+
+	for range 2 {
+		var cached models.Weather
+		require.NoError(t, reader.Get(ctx, "weather:kyiv", &cached))
+		assert.Equal(t, testWeather.Temperature, cached.Temperature)
+	}
+
+	assert.Equal(t, int32(1), remote.gets.Load())
+
+	memoryHits, memoryMisses := metrics.TierStats(cache.MemoryTier)
+	assert.Equal(t, 1, memoryHits)
+	assert.Equal(t, 1, memoryMisses)
+
+	redisHits, _ := metrics.TierStats(cache.RedisTier)
+	assert.Equal(t, 1, redisHits)
+}
+
+func TestTwoTierCache_InvalidatesOtherReplicas(t *testing.T) {
+	remote := testutils.NewInMemoryCache()
+	bus := testutils.NewInMemoryPubSub()
+	first := newTwoTierCache(remote, bus, testutils.NewInMemoryMetrics(), 10, time.Minute)
+	second := newTwoTierCache(remote, bus, testutils.NewInMemoryMetrics(), 10, time.Minute)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	go first.Run(ctx)
+	go second.Run(ctx)
+	require.Eventually(t, func() bool {
+		return bus.Subscribers(cache.InvalidationChannel) == 2
+	}, time.Second, 10*time.Millisecond)
+
+	require.NoError(t, first.Set(ctx, "weather:kyiv", models.Weather{Temperature: 10}, time.Minute))
+
+	var cached models.Weather
+	require.NoError(t, second.Get(ctx, "weather:kyiv", &cached))
+	assert.Equal(t, 10.0, cached.Temperature)
+
+	require.NoError(t, first.Set(ctx, "weather:kyiv", models.Weather{Temperature: 20}, time.Minute))
+
+	assert.Eventually(t, func() bool {
+		var cached models.Weather
+		return second.Get(ctx, "weather:kyiv", &cached) == nil && cached.Temperature == 20
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestTwoTierCache_MemoryEntriesExpire(t *testing.T) {
+	metrics := testutils.NewInMemoryMetrics()
+	twoTier := newTwoTierCache(testutils.NewInMemoryCache(), testutils.NewInMemoryPubSub(), metrics, 10, 50*time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	require.NoError(t, twoTier.Set(ctx, "weather:kyiv", models.Weather{Temperature: 10}, time.Minute))
+	time.Sleep(100 * time.Millisecond)
+
+	var cached models.Weather
+	require.NoError(t, twoTier.Get(ctx, "weather:kyiv", &cached))
+
+	memoryHits, memoryMisses := metrics.TierStats(cache.MemoryTier)
+	assert.Equal(t, 0, memoryHits)
+	assert.Equal(t, 1, memoryMisses)
+
+	redisHits, _ := metrics.TierStats(cache.RedisTier)
+	assert.Equal(t, 1, redisHits)
+}
+
+func TestTwoTierCache_MissInBothTiers(t *testing.T) {
+	metrics := testutils.NewInMemoryMetrics()
+	twoTier := newTwoTierCache(testutils.NewInMemoryCache(), testutils.NewInMemoryPubSub(), metrics, 10, time.Minute)
+
+	var cached models.Weather
+	err := twoTier.Get(context.Background(), "weather:kyiv", &cached)
+	require.ErrorIs(t, err, infraerrors.ErrCacheMiss)
+
+	_, memoryMisses := metrics.TierStats(cache.MemoryTier)
+	_, redisMisses := metrics.TierStats(cache.RedisTier)
+	assert.Equal(t, 1, memoryMisses)
+	assert.Equal(t, 1, redisMisses)
+}
+
+func TestLRU_EvictsLeastRecentlyUsed(t *testing.T) {
+	lru := cache.NewLRU(2)
+
+	lru.Set("kyiv", []byte("1"), time.Minute)
+	lru.Set("lviv", []byte("2"), time.Minute)
+
+	_, ok := lru.Get("kyiv")
+	require.True(t, ok)
+
+	lru.Set("odesa", []byte("3"), time.Minute)
+
+	assert.Equal(t, 2, lru.Len())
+	_, ok = lru.Get("lviv")
+	assert.False(t, ok)
+	_, ok = lru.Get("kyiv")
+	assert.True(t, ok)
+	_, ok = lru.Get("odesa")
+	assert.True(t, ok)
+}
@@ -0,0 +1,116 @@
+package integration
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+	"weather-forecast/pkg/proto/weather"
+	"weather-service/tests/integration/testutils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGetWeather_ImperialUnitsFromWeatherAPI(t *testing.T) {
+	city := "Kyiv"
+
+	query := weatherAPIQuery(t, city)
+	query.Set("lang", "uk")
+	weatherAPIServerMock := newMockServer(t, testWeatherAPISuccessResponse(), http.StatusOK, query.Encode(), true)
+	openWeatherServerMock := newMockServer(t, nil, 0, "", false)
+
+	weatherHandler := setupWeatherHandler(weatherAPIServerMock.URL, openWeatherServerMock.URL)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := weatherHandler.GetWeather(ctx, &weather.GetWeatherRequest{Location: &weather.GetWeatherRequest_City{City: city}, Units: "imperial", Lang: "UK"})
+	require.NoError(t, err)
+
+	assert.Equal(t, 72.5, resp.Temperature)
+	assert.Equal(t, 8.9, resp.WindSpeed)
+	assert.Equal(t, 73.6, resp.FeelsLike)
+	assert.Equal(t, 72.5, resp.HeatIndex)
+	assert.Equal(t, 59.7, resp.DewPoint)
+	assert.Equal(t, testWeather.Pressure, resp.Pressure)
+	assert.Equal(t, testWeather.Visibility, resp.Visibility)
+}
+
+func TestGetWeather_UnitsPassedToOpenWeather(t *testing.T) {
+	city := "Kyiv"
+
+	weatherAPIQuery := weatherAPIQuery(t, city)
+	weatherAPIQuery.Set("lang", "de")
+	weatherAPIServerMock := newMockServer(t, weatherAPIInternalErrorResponse, http.StatusBadRequest, weatherAPIQuery.Encode(), true)
+
+	query := openWeatherQuery(t, city)
+	query.Set("units", "standard")
+	query.Set("lang", "de")
+	openWeatherServerMock := newMockServer(t, testOpenWeatherSuccessResponse(), http.StatusOK, query.Encode(), true)
+
+	weatherHandler := setupWeatherHandler(weatherAPIServerMock.URL, openWeatherServerMock.URL)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := weatherHandler.GetWeather(ctx, &weather.GetWeatherRequest{Location: &weather.GetWeatherRequest_City{City: city}, Units: "standard", Lang: "de"})
+	require.NoError(t, err)
+
+	assert.Equal(t, testWeather.Temperature, resp.Temperature)
+	assert.Equal(t, float64(4), resp.WindSpeed)
+}
+
+func TestGetWeather_UnitsInCacheKey(t *testing.T) {
+	metrics := testutils.NewInMemoryMetrics()
+	weatherAPIServerMock := newDelayedMockServer(t, testWeatherAPISuccessResponse(), http.StatusOK, 0)
+
+	cfg := newChainConfig(weatherAPIServerMock.URL, "http://localhost", "cache", "weatherapi")
+	cfg.WeatherAPICacheTTL = 60
+	weatherHandler := setupWeatherHandlerFromConfig(t, cfg, testutils.NewInMemoryCache(), metrics)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	metric, err := weatherHandler.GetWeather(ctx, &weather.GetWeatherRequest{Location: &weather.GetWeatherRequest_City{City: "Kyiv"}})
+	require.NoError(t, err)
+	assert.Equal(t, testWeather.Temperature, metric.Temperature)
+
+	imperial, err := weatherHandler.GetWeather(ctx, &weather.GetWeatherRequest{Location: &weather.GetWeatherRequest_City{City: "Kyiv"}, Units: "imperial"})
+	require.NoError(t, err)
+	assert.Equal(t, 72.5, imperial.Temperature)
+	assert.Equal(t, int32(2), weatherAPIServerMock.calls.Load())
+
+	cached, err := weatherHandler.GetWeather(ctx, &weather.GetWeatherRequest{Location: &weather.GetWeatherRequest_City{City: "Kyiv"}, Units: "metric", Lang: "en"})
+	require.NoError(t, err)
+	assert.Equal(t, testWeather.Temperature, cached.Temperature)
+	assert.Equal(t, int32(2), weatherAPIServerMock.calls.Load())
+}
+
+func TestGetWeather_InvalidOptions(t *testing.T) {
+	testCases := []struct {
+		name  string
+		units string
+		lang  string
+	}{
+		{name: "Unknown Units", units: "nautical"},
+		{name: "Invalid Lang", lang: "en&appid=x"},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			weatherHandler := setupWeatherHandler("http://localhost", "http://localhost")
+
+			resp, err := weatherHandler.GetWeather(context.Background(), &weather.GetWeatherRequest{
+				Location: &weather.GetWeatherRequest_City{City: "Kyiv"},
+				Units:    testCase.units,
+				Lang:     testCase.lang,
+			})
+			require.Error(t, err)
+			assert.Nil(t, resp)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+	}
+}
@@ -22,9 +22,18 @@ import (
 
 var (
 	testWeather = models.Weather{
-		Temperature: 22.5,
-		Humidity:    64,
-		Description: "Partly cloudy",
+		Temperature:   22.5,
+		Humidity:      64,
+		Description:   "Partly cloudy",
+		WindSpeed:     14.4,
+		WindDirection: 200,
+		Pressure:      1015,
+		CloudCover:    50,
+		Visibility:    10,
+		FeelsLike:     23.1,
+		HeatIndex:     22.5,
+		WindChill:     22.5,
+		DewPoint:      15.4,
 	}
 )
 
@@ -37,7 +46,13 @@ func TestGetWeather_Success(t *testing.T) {
 			Condition: weatherapi.WeatherConditionResponse{
 				Text: testWeather.Description,
 			},
-			Humidity: testWeather.Humidity,
+			Humidity:   testWeather.Humidity,
+			WindKph:    testWeather.WindSpeed,
+			WindDegree: testWeather.WindDirection,
+			PressureMb: testWeather.Pressure,
+			Cloud:      testWeather.CloudCover,
+			VisKm:      testWeather.Visibility,
+			FeelsLikeC: testWeather.FeelsLike,
 		},
 	}
 
@@ -48,7 +63,7 @@ func TestGetWeather_Success(t *testing.T) {
 	weatherHandler := setupWeatherHandler(weatherAPIServerMock.URL, openWeatherAPIServcerMock.URL)
 
 	requestBody := &weather.GetWeatherRequest{
-		City: city,
+		Location: &weather.GetWeatherRequest_City{City: city},
 	}
 
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
@@ -71,7 +86,17 @@ func TestGetWeather_WeatherAPI_Failed(t *testing.T) {
 		Main: openweather.OpenWeatherMainResponse{
 			Temperature: testWeather.Temperature,
 			Humidity:    testWeather.Humidity,
+			FeelsLike:   testWeather.FeelsLike,
+			Pressure:    testWeather.Pressure,
 		},
+		Wind: openweather.OpenWeatherWindResponse{
+			Speed:  4,
+			Degree: testWeather.WindDirection,
+		},
+		Clouds: openweather.OpenWeatherCloudsResponse{
+			All: testWeather.CloudCover,
+		},
+		Visibility: 10000,
 	}
 
 	weatherAPIErrorResponseBody := weatherapi.WeatherErrorResponse{
@@ -88,7 +113,7 @@ func TestGetWeather_WeatherAPI_Failed(t *testing.T) {
 	weatherHandler := setupWeatherHandler(weatherAPIServerMock.URL, openWeatherAPIServcerMock.URL)
 
 	requestBody := &weather.GetWeatherRequest{
-		City: city,
+		Location: &weather.GetWeatherRequest_City{City: city},
 	}
 
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
@@ -115,7 +140,13 @@ func TestGetWeather_RedisCacheFlow(t *testing.T) {
 			Condition: weatherapi.WeatherConditionResponse{
 				Text: testWeather.Description,
 			},
-			Humidity: testWeather.Humidity,
+			Humidity:   testWeather.Humidity,
+			WindKph:    testWeather.WindSpeed,
+			WindDegree: testWeather.WindDirection,
+			PressureMb: testWeather.Pressure,
+			Cloud:      testWeather.CloudCover,
+			VisKm:      testWeather.Visibility,
+			FeelsLikeC: testWeather.FeelsLike,
 		},
 	}
 
@@ -123,7 +154,7 @@ func TestGetWeather_RedisCacheFlow(t *testing.T) {
 	openWeatherAPIServerMock := setupOpenWeatherMock(t, nil, 0, "", false)
 	weatherHandler := setupWeatherHandlerWithCache(redisCache, metrics, weatherAPIServerMock.URL, openWeatherAPIServerMock.URL)
 
-	requestBody := &weather.GetWeatherRequest{City: city}
+	requestBody := &weather.GetWeatherRequest{Location: &weather.GetWeatherRequest_City{City: city}}
 
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
@@ -238,7 +269,7 @@ func TestGetWeather_ErrorScenarios(t *testing.T) {
 			}
 
 			requestBody := &weather.GetWeatherRequest{
-				City: testCase.city,
+				Location: &weather.GetWeatherRequest_City{City: testCase.city},
 			}
 
 			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
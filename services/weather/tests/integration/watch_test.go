@@ -0,0 +1,192 @@
+package integration
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+	"weather-forecast/pkg/proto/weather"
+	stub_logger "weather-forecast/pkg/stubs/logger"
+	"weather-service/internal/domain/usecases"
+	"weather-service/internal/infrastructure/locations"
+	"weather-service/internal/infrastructure/providers"
+	"weather-service/internal/presentation/server/handlers"
+	"weather-service/tests/integration/testutils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type (
+	TemperatureMockServer struct {
+		*httptest.Server
+		temperature atomic.Int64
+		calls       atomic.Int32
+	}
+
+	fakeWatchStream struct {
+		grpc.ServerStream
+		ctx     context.Context
+		updates chan *weather.WeatherUpdate
+	}
+)
+
+func (s *fakeWatchStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeWatchStream) Send(update *weather.WeatherUpdate) error {
+	s.updates <- update
+	return nil
+}
+
+func newTemperatureMockServer(t *testing.T, temperature int64) *TemperatureMockServer {
+	t.Helper()
+
+	mock := &TemperatureMockServer{}
+	mock.temperature.Store(temperature)
+	mock.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mock.calls.Add(1)
+
+		response := testWeatherAPISuccessResponse()
+		response.Current.TempC = float64(mock.temperature.Load())
+
+		w.WriteHeader(http.StatusOK)
+		require.NoError(t, json.NewEncoder(w).Encode(response))
+	}))
+	t.Cleanup(mock.Close)
+
+	return mock
+}
+
+func setupWatchHandler(t *testing.T, ctx context.Context, weatherAPIURLMock string) *handlers.WeatherHandler {
+	t.Helper()
+	stubLogger := stub_logger.New()
+
+	cfg := newChainConfig(weatherAPIURLMock, "http://localhost", "weatherapi")
+	weatherChain, err := providers.NewChainBuilder(cfg, testutils.NewInMemoryCache(), testutils.NewInMemoryCache(), newHistoryStore(), testutils.NewInMemoryMetrics(), http.DefaultTransport, stubLogger).Build()
+	require.NoError(t, err)
+
+	cityResolver, err := locations.NewResolver(stubLogger)
+	require.NoError(t, err)
+
+	weatherService := usecases.NewWeatherService(weatherChain, cityResolver, stubLogger)
+	weatherWatcher := usecases.NewWeatherWatcher(weatherService, testWatchInterval, stubLogger)
+	go weatherWatcher.Run(ctx)
+
+	historyService := usecases.NewHistoryService(newHistoryStore(), weatherService, stubLogger)
+	citySearchService := usecases.NewCitySearchService(cityResolver, nil, stubLogger)
+	return handlers.NewWeatherHandler(weatherService, weatherWatcher, historyService, citySearchService, stubLogger)
+}
+
+func startWatch(t *testing.T, handler *handlers.WeatherHandler, ctx context.Context, cities ...string) (*fakeWatchStream, chan error) {
+	t.Helper()
+
+	stream := &fakeWatchStream{
+		ctx:     ctx,
+		updates: make(chan *weather.WeatherUpdate, 16),
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- handler.WatchWeather(&weather.WatchWeatherRequest{Cities: cities}, stream)
+	}()
+
+	return stream, done
+}
+
+func receiveUpdate(t *testing.T, stream *fakeWatchStream) *weather.WeatherUpdate {
+	t.Helper()
+
+	select {
+	case update := <-stream.updates:
+		return update
+	case <-time.After(2 * time.Second):
+		require.FailNow(t, "no weather update received")
+		return nil
+	}
+}
+
+func TestWatchWeather_PushesChanges(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	weatherAPIServerMock := newTemperatureMockServer(t, 10)
+	weatherHandler := setupWatchHandler(t, ctx, weatherAPIServerMock.URL)
+
+	streamCtx, stopStream := context.WithCancel(ctx)
+	stream, done := startWatch(t, weatherHandler, streamCtx, "Kyiv")
+
+	update := receiveUpdate(t, stream)
+	assert.Equal(t, "Kyiv", update.City)
+	assert.Equal(t, "kyiv-ua", update.LocationId)
+	assert.Equal(t, float64(10), update.Weather.Temperature)
+
+	time.Sleep(3 * testWatchInterval)
+	assert.Empty(t, stream.updates)
+
+	weatherAPIServerMock.temperature.Store(20)
+
+	update = receiveUpdate(t, stream)
+	assert.Equal(t, float64(20), update.Weather.Temperature)
+
+	stopStream()
+	require.NoError(t, <-done)
+}
+
+func TestWatchWeather_SharedRefreshLoop(t *testing.T) {
+	const watchers = 5
+	const watchWindow = 10 * testWatchInterval
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	weatherAPIServerMock := newTemperatureMockServer(t, 10)
+	weatherHandler := setupWatchHandler(t, ctx, weatherAPIServerMock.URL)
+
+	streamCtx, stopStreams := context.WithCancel(ctx)
+	defer stopStreams()
+
+	for range watchers {
+		stream, _ := startWatch(t, weatherHandler, streamCtx, "Kyiv", "Kiev")
+		update := receiveUpdate(t, stream)
+		assert.Equal(t, "kyiv-ua", update.LocationId)
+	}
+
+	callsBefore := weatherAPIServerMock.calls.Load()
+	time.Sleep(watchWindow)
+	calls := weatherAPIServerMock.calls.Load() - callsBefore
+
+	assert.LessOrEqual(t, calls, int32(watchWindow/testWatchInterval)+2)
+}
+
+func TestWatchWeather_InvalidRequest(t *testing.T) {
+	testCases := []struct {
+		name   string
+		cities []string
+	}{
+		{name: "No Cities", cities: nil},
+		{name: "Empty City", cities: []string{"Kyiv", " "}},
+		{name: "Too Many Cities", cities: make([]string, usecases.MaxWatchedCities+1)},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			weatherHandler := setupWatchHandler(t, ctx, "http://localhost")
+
+			_, done := startWatch(t, weatherHandler, ctx, testCase.cities...)
+			err := <-done
+			require.Error(t, err)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+	}
+}
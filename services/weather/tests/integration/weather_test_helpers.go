@@ -1,21 +1,28 @@
 package integration
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"sync/atomic"
 	"testing"
+	"time"
 	"weather-forecast/pkg/proto/weather"
 	"weather-service/internal/config"
 	"weather-service/internal/domain/models"
 	"weather-service/internal/domain/usecases"
 	"weather-service/internal/infrastructure/clients/openweather"
 	"weather-service/internal/infrastructure/clients/weatherapi"
+	"weather-service/internal/infrastructure/history"
+	"weather-service/internal/infrastructure/locations"
 	"weather-service/internal/infrastructure/providers"
 
 	stub_logger "weather-forecast/pkg/stubs/logger"
 	"weather-service/internal/presentation/server/handlers"
+	"weather-service/tests/integration/testutils"
 
 	"github.com/stretchr/testify/require"
 	"gotest.tools/assert"
@@ -25,16 +32,21 @@ const (
 	testAPIKey = "testAPIKey"
 )
 
-type (
-	Cacher interface {
-		providers.CacheReader
-		providers.CacheWriter
-	}
+const testWatchInterval = 50 * time.Millisecond
+
+const testHistoryRetention = 7 * 24 * time.Hour
 
+var testCacheTTL = providers.CacheTTL{
+	Weather:  10 * time.Minute,
+	Forecast: time.Hour,
+}
+
+type (
 	MockServer struct {
 		*httptest.Server
 		shouldBeCalled bool
 		wasCalled      bool
+		calls          atomic.Int32
 	}
 )
 
@@ -47,6 +59,7 @@ func newMockServer(t *testing.T, responseBody interface{}, statusCode int, expec
 
 	mock.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		mock.wasCalled = true
+		mock.calls.Add(1)
 
 		if expectedQuery != "" {
 			assert.Equal(t, "/", r.URL.Path)
@@ -73,30 +86,104 @@ func newMockServer(t *testing.T, responseBody interface{}, statusCode int, expec
 	return mock
 }
 
+func resolveTestCity(t *testing.T, city string) *models.Location {
+	t.Helper()
+
+	resolver, err := locations.NewResolver(stub_logger.New())
+	require.NoError(t, err)
+
+	location, err := resolver.Resolve(context.Background(), city)
+	require.NoError(t, err)
+
+	return location
+}
+
+func weatherAPIQuery(t *testing.T, city string) url.Values {
+	t.Helper()
+
+	location := resolveTestCity(t, city)
+	query := url.Values{}
+	query.Set("key", testAPIKey)
+	if location.Coordinates != nil {
+		query.Set("q", formatCoordinate(location.Coordinates.Latitude)+","+formatCoordinate(location.Coordinates.Longitude))
+	} else {
+		query.Set("q", location.Name)
+	}
+
+	return query
+}
+
+func openWeatherQuery(t *testing.T, city string) url.Values {
+	t.Helper()
+
+	location := resolveTestCity(t, city)
+	query := url.Values{}
+	query.Set("appid", testAPIKey)
+	query.Set("units", "metric")
+	if location.Coordinates != nil {
+		query.Set("lat", formatCoordinate(location.Coordinates.Latitude))
+		query.Set("lon", formatCoordinate(location.Coordinates.Longitude))
+	} else {
+		query.Set("q", location.Name)
+	}
+
+	return query
+}
+
+func formatCoordinate(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
+
+func (m *MockServer) CallCount() int {
+	return int(m.calls.Load())
+}
+
 func setupWeatherAPIMock(t *testing.T, responseBody interface{}, statusCode int, city string) *MockServer {
 	t.Helper()
-	expectedQuery := fmt.Sprintf("key=%s&q=%s", testAPIKey, city)
-	return newMockServer(t, responseBody, statusCode, expectedQuery, true)
+	query := weatherAPIQuery(t, city)
+	query.Set("lang", models.DefaultLang)
+	return newMockServer(t, responseBody, statusCode, query.Encode(), true)
 }
 
 func setupOpenWeatherMock(t *testing.T, responseBody interface{}, statusCode int, city string, shouldBeCalled bool) *MockServer {
 	t.Helper()
 	expectedQuery := ""
 	if shouldBeCalled {
-		expectedQuery = fmt.Sprintf("appid=%s&q=%s&units=metric", testAPIKey, city)
+		query := openWeatherQuery(t, city)
+		query.Set("lang", models.DefaultLang)
+		expectedQuery = query.Encode()
 	}
 	return newMockServer(t, responseBody, statusCode, expectedQuery, shouldBeCalled)
 }
 
+func newWeatherHandler(weatherService *usecases.WeatherService) *handlers.WeatherHandler {
+	return newWeatherHandlerWithHistory(weatherService, newHistoryStore(), nil)
+}
+
+func newWeatherHandlerWithHistory(weatherService *usecases.WeatherService, historyStore *history.Store, cityGeocoder usecases.CityIndex) *handlers.WeatherHandler {
+	stubLogger := stub_logger.New()
+	weatherWatcher := usecases.NewWeatherWatcher(weatherService, testWatchInterval, stubLogger)
+	historyService := usecases.NewHistoryService(historyStore, weatherService, stubLogger)
+	cityResolver, _ := locations.NewResolver(stubLogger)
+	citySearchService := usecases.NewCitySearchService(cityResolver, cityGeocoder, stubLogger)
+	return handlers.NewWeatherHandler(weatherService, weatherWatcher, historyService, citySearchService, stubLogger)
+}
+
+func newHistoryStore() *history.Store {
+	return history.NewStore(testutils.NewInMemoryCache(), testHistoryRetention, stub_logger.New())
+}
+
 func setupWeatherHandler(weatherAPIURLMock, openWeatherURLMock string) *handlers.WeatherHandler {
 	stubLogger := stub_logger.New()
 	client := &http.Client{}
 
 	cfg := &config.Config{
-		WeatherAPIURL:  weatherAPIURLMock,
-		WeatherAPIKey:  testAPIKey,
-		OpenWeatherURL: openWeatherURLMock,
-		OpenWeatherKey: testAPIKey,
+		WeatherAPIURL:          weatherAPIURLMock,
+		WeatherAPIForecastURL:  weatherAPIURLMock,
+		WeatherAPIKey:          testAPIKey,
+		OpenWeatherURL:         openWeatherURLMock,
+		OpenWeatherForecastURL: openWeatherURLMock,
+		OpenWeatherKey:         testAPIKey,
 	}
 	weatherAPIClient := weatherapi.NewClient(cfg, client, stubLogger)
 	weatherAPIProvider := providers.NewWeatherAPIProvider(weatherAPIClient, stubLogger)
@@ -108,46 +195,167 @@ func setupWeatherHandler(weatherAPIURLMock, openWeatherURLMock string) *handlers
 
 	weatherAPILink.SetNext(openWeatherLink)
 
-	weatherService := usecases.NewWeatherService(weatherAPILink, stubLogger)
-	weatherHandler := handlers.NewWeatherHandler(weatherService, stubLogger)
+	cityResolver, _ := locations.NewResolver(stubLogger)
+
+	weatherService := usecases.NewWeatherService(weatherAPILink, cityResolver, stubLogger)
+	weatherHandler := newWeatherHandler(weatherService)
 	return weatherHandler
 }
 
-func setupWeatherHandlerWithCache(cacher Cacher, metrics providers.MetricsRecorder, weatherAPIURLMock, openWeatherURLMock string) *handlers.WeatherHandler {
+func setupWeatherAPIForecastMock(t *testing.T, responseBody interface{}, statusCode int, city string, days int) *MockServer {
+	t.Helper()
+	query := weatherAPIQuery(t, city)
+	query.Set("days", strconv.Itoa(days))
+	return newMockServer(t, responseBody, statusCode, query.Encode(), true)
+}
+
+func setupOpenWeatherForecastMock(t *testing.T, responseBody interface{}, statusCode int, city string, days int, shouldBeCalled bool) *MockServer {
+	t.Helper()
+	expectedQuery := ""
+	if shouldBeCalled {
+		query := openWeatherQuery(t, city)
+		query.Set("cnt", strconv.Itoa(days*8))
+		expectedQuery = query.Encode()
+	}
+	return newMockServer(t, responseBody, statusCode, expectedQuery, shouldBeCalled)
+}
+
+func setupWeatherHandlerWithCache(cacher providers.Cacher, metrics providers.MetricsRecorder, weatherAPIURLMock, openWeatherURLMock string) *handlers.WeatherHandler {
 	stubLogger := stub_logger.New()
 	client := &http.Client{}
 	cfg := &config.Config{
-		WeatherAPIURL:  weatherAPIURLMock,
-		WeatherAPIKey:  testAPIKey,
-		OpenWeatherURL: openWeatherURLMock,
-		OpenWeatherKey: testAPIKey,
+		WeatherAPIURL:          weatherAPIURLMock,
+		WeatherAPIForecastURL:  weatherAPIURLMock,
+		WeatherAPIKey:          testAPIKey,
+		OpenWeatherURL:         openWeatherURLMock,
+		OpenWeatherForecastURL: openWeatherURLMock,
+		OpenWeatherKey:         testAPIKey,
 	}
 
 	weatherAPIClient := weatherapi.NewClient(cfg, client, stubLogger)
 	weatherAPIProvider := providers.NewWeatherAPIProvider(weatherAPIClient, stubLogger)
-	cacheableWeatherAPIProvider := providers.NewCacheDecorator(weatherAPIProvider, cacher, metrics, stubLogger)
+	cacheableWeatherAPIProvider := providers.NewCacheDecorator(providers.WeatherAPIProviderName, weatherAPIProvider, cacher, metrics, testCacheTTL, stubLogger)
 	weatherAPILink := providers.NewWeatherLink(cacheableWeatherAPIProvider)
 
 	openWeatherClient := openweather.NewClient(cfg, client, stubLogger)
 	openWeatherProvider := providers.NewOpenWeatherProvider(openWeatherClient, stubLogger)
-	cacheableOpenWeatherProvider := providers.NewCacheDecorator(openWeatherProvider, cacher, metrics, stubLogger)
+	cacheableOpenWeatherProvider := providers.NewCacheDecorator(providers.OpenWeatherProviderName, openWeatherProvider, cacher, metrics, testCacheTTL, stubLogger)
 	openWeatherLink := providers.NewWeatherLink(cacheableOpenWeatherProvider)
 
-	cacheProvider := providers.NewCacheWeather(cacher, metrics, stubLogger)
-	cacheProviderLink := providers.NewWeatherLink(cacheProvider)
+	cacheProviderLink := providers.NewCacheWeather(cacher, metrics, stubLogger)
 
 	cacheProviderLink.SetNext(weatherAPILink)
 	weatherAPILink.SetNext(openWeatherLink)
 
-	weatherService := usecases.NewWeatherService(cacheProviderLink, stubLogger)
-	weatherHandler := handlers.NewWeatherHandler(weatherService, stubLogger)
+	cityResolver, _ := locations.NewResolver(stubLogger)
+
+	weatherService := usecases.NewWeatherService(cacheProviderLink, cityResolver, stubLogger)
+	weatherHandler := newWeatherHandler(weatherService)
+	return weatherHandler
+}
+
+func setupWeatherHandlerWithCircuitBreaker(metrics providers.CircuitStateRecorder, settings providers.CircuitBreakerSettings, weatherAPIURLMock, openWeatherURLMock string) *handlers.WeatherHandler {
+	stubLogger := stub_logger.New()
+	client := &http.Client{Timeout: time.Second}
+	cfg := &config.Config{
+		WeatherAPIURL:          weatherAPIURLMock,
+		WeatherAPIForecastURL:  weatherAPIURLMock,
+		WeatherAPIKey:          testAPIKey,
+		OpenWeatherURL:         openWeatherURLMock,
+		OpenWeatherForecastURL: openWeatherURLMock,
+		OpenWeatherKey:         testAPIKey,
+	}
+
+	weatherAPIClient := weatherapi.NewClient(cfg, client, stubLogger)
+	weatherAPIProvider := providers.NewWeatherAPIProvider(weatherAPIClient, stubLogger)
+	weatherAPILink := providers.NewCircuitBreakerLink("weatherapi", weatherAPIProvider, settings, metrics, stubLogger)
+
+	openWeatherClient := openweather.NewClient(cfg, client, stubLogger)
+	openWeatherProvider := providers.NewOpenWeatherProvider(openWeatherClient, stubLogger)
+	openWeatherLink := providers.NewCircuitBreakerLink("openweather", openWeatherProvider, settings, metrics, stubLogger)
+
+	weatherAPILink.SetNext(openWeatherLink)
+
+	cityResolver, _ := locations.NewResolver(stubLogger)
+
+	weatherService := usecases.NewWeatherService(weatherAPILink, cityResolver, stubLogger)
+	weatherHandler := newWeatherHandler(weatherService)
 	return weatherHandler
 }
 
+func setupWeatherHandlerFromConfig(t *testing.T, cfg *config.Config, cacher providers.Cacher, metrics providers.ChainMetricsRecorder) *handlers.WeatherHandler {
+	t.Helper()
+	return setupWeatherHandlerWithQuota(t, cfg, cacher, testutils.NewInMemoryCache(), metrics)
+}
+
+func setupWeatherHandlerWithQuota(t *testing.T, cfg *config.Config, cacher providers.Cacher, quota providers.QuotaCounter, metrics providers.ChainMetricsRecorder) *handlers.WeatherHandler {
+	t.Helper()
+	return setupWeatherHandlerWithHistory(t, cfg, cacher, quota, newHistoryStore(), metrics)
+}
+
+func setupWeatherHandlerWithHistory(t *testing.T, cfg *config.Config, cacher providers.Cacher, quota providers.QuotaCounter, historyStore *history.Store, metrics providers.ChainMetricsRecorder) *handlers.WeatherHandler {
+	t.Helper()
+	stubLogger := stub_logger.New()
+
+	weatherChain, err := providers.NewChainBuilder(cfg, cacher, quota, historyStore, metrics, http.DefaultTransport, stubLogger).Build()
+	require.NoError(t, err)
+
+	cityResolver, err := locations.NewResolver(stubLogger)
+	require.NoError(t, err)
+
+	weatherService := usecases.NewWeatherService(weatherChain, cityResolver, stubLogger)
+	return newWeatherHandlerWithHistory(weatherService, historyStore, nil)
+}
+
+func newChainConfig(weatherAPIURLMock, openWeatherURLMock string, chain ...string) *config.Config {
+	return &config.Config{
+		ProviderChain:                  chain,
+		ProviderStrategy:               config.SequentialStrategy,
+		WeatherAPIURL:                  weatherAPIURLMock,
+		WeatherAPIForecastURL:          weatherAPIURLMock,
+		WeatherAPIAlertsURL:            weatherAPIURLMock,
+		WeatherAPIKey:                  testAPIKey,
+		WeatherAPITimeout:              1,
+		OpenWeatherURL:                 openWeatherURLMock,
+		OpenWeatherForecastURL:         openWeatherURLMock,
+		OpenWeatherAlertsURL:           openWeatherURLMock,
+		OpenWeatherAirQualityURL:       openWeatherURLMock,
+		OpenWeatherKey:                 testAPIKey,
+		OpenWeatherTimeout:             1,
+		OpenMeteoTimeout:               1,
+		ProviderCallTimeout:            5,
+		CircuitBreakerFailureThreshold: 5,
+		CircuitBreakerSuccessThreshold: 1,
+		CircuitBreakerCoolDown:         30,
+	}
+}
+
 func assertWeatherResponse(t *testing.T, response *weather.GetWeatherResponse, expectedWeather models.Weather) {
 	t.Helper()
 
 	assert.Equal(t, expectedWeather.Temperature, response.Temperature)
 	assert.Equal(t, expectedWeather.Humidity, int(response.Humidity))
 	assert.Equal(t, expectedWeather.Description, response.Description)
+	assert.Equal(t, expectedWeather.WindSpeed, response.WindSpeed)
+	assert.Equal(t, expectedWeather.WindDirection, int(response.WindDirection))
+	assert.Equal(t, expectedWeather.Pressure, response.Pressure)
+	assert.Equal(t, expectedWeather.CloudCover, int(response.CloudCover))
+	assert.Equal(t, expectedWeather.Visibility, response.Visibility)
+	assert.Equal(t, expectedWeather.FeelsLike, response.FeelsLike)
+	assert.Equal(t, expectedWeather.HeatIndex, response.HeatIndex)
+	assert.Equal(t, expectedWeather.WindChill, response.WindChill)
+	assert.Equal(t, expectedWeather.DewPoint, response.DewPoint)
+}
+
+func assertForecastResponse(t *testing.T, response *weather.GetForecastResponse, expectedDays []*weather.DailyForecast) {
+	t.Helper()
+
+	require.Len(t, response.Days, len(expectedDays))
+	for i, expectedDay := range expectedDays {
+		assert.Equal(t, expectedDay.Date, response.Days[i].Date)
+		assert.Equal(t, expectedDay.MinTemperature, response.Days[i].MinTemperature)
+		assert.Equal(t, expectedDay.MaxTemperature, response.Days[i].MaxTemperature)
+		assert.Equal(t, expectedDay.PrecipitationChance, response.Days[i].PrecipitationChance)
+		assert.Equal(t, expectedDay.Condition, response.Days[i].Condition)
+	}
 }